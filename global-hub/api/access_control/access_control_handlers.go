@@ -7,7 +7,6 @@
 package access_control
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -41,6 +40,7 @@ type GrantConsentRequest struct {
 	RequestedFields    []string `json:"requested_fields"`
 	Purpose            string   `json:"purpose"`
 	BiometricSignature string   `json:"biometric_signature"` // Base64-encoded
+	GuardianDID        string   `json:"guardian_did,omitempty"` // Set when a guardian grants for a ward (signature is the guardian's)
 }
 
 // GrantConsentResponse represents a consent grant response
@@ -68,7 +68,7 @@ func (ach *AccessControlHandlers) HandleGrantConsent(w http.ResponseWriter, r *h
 	}
 
 	// Get professional to determine role
-	professional, err := ach.registry.GetProfessionalByDID(context.Background(), req.ProfessionalDID)
+	professional, err := ach.registry.GetProfessionalByDID(r.Context(), req.ProfessionalDID)
 	if err != nil {
 		json.NewEncoder(w).Encode(GrantConsentResponse{
 			Success: false,
@@ -77,16 +77,30 @@ func (ach *AccessControlHandlers) HandleGrantConsent(w http.ResponseWriter, r *h
 		return
 	}
 
-	// Grant consent
-	consent, err := ach.metadataController.GrantConsent(
-		context.Background(),
-		req.CitizenDID,
-		req.ProfessionalDID,
-		professional.Role,
-		req.RequestedFields,
-		req.Purpose,
-		[]byte(req.BiometricSignature),
-	)
+	// Grant consent (delegated if a guardian is acting for the citizen)
+	var consent *AccessConsent
+	if req.GuardianDID != "" {
+		consent, err = ach.metadataController.GrantDelegatedConsent(
+			r.Context(),
+			req.GuardianDID,
+			req.CitizenDID,
+			req.ProfessionalDID,
+			professional.Role,
+			req.RequestedFields,
+			req.Purpose,
+			[]byte(req.BiometricSignature),
+		)
+	} else {
+		consent, err = ach.metadataController.GrantConsent(
+			r.Context(),
+			req.CitizenDID,
+			req.ProfessionalDID,
+			professional.Role,
+			req.RequestedFields,
+			req.Purpose,
+			[]byte(req.BiometricSignature),
+		)
+	}
 
 	if err != nil {
 		json.NewEncoder(w).Encode(GrantConsentResponse{
@@ -103,12 +117,120 @@ func (ach *AccessControlHandlers) HandleGrantConsent(w http.ResponseWriter, r *h
 	})
 }
 
+// ConsentCheckRequest represents a consent pre-check request
+type ConsentCheckRequest struct {
+	CitizenDID      string   `json:"citizen_did"`
+	ProfessionalDID string   `json:"professional_did"`
+	RequestedFields []string `json:"requested_fields"`
+}
+
+// ConsentCheckResponse lists which requested fields are currently consented
+type ConsentCheckResponse struct {
+	Success         bool     `json:"success"`
+	ConsentedFields []string `json:"consented_fields"`
+	MissingFields   []string `json:"missing_fields"`
+	ConsentRequired bool     `json:"consent_required"` // True if any requested field lacks consent
+	Error           string   `json:"error,omitempty"`
+}
+
+// HandleCheckConsent handles POST /v1/access-control/consent/check
+func (ach *AccessControlHandlers) HandleCheckConsent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req ConsentCheckRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		json.NewEncoder(w).Encode(ConsentCheckResponse{
+			Success: false,
+			Error:   fmt.Sprintf("Invalid request: %v", err),
+		})
+		return
+	}
+
+	consented, err := ach.metadataController.HasValidConsent(
+		r.Context(),
+		req.CitizenDID,
+		req.ProfessionalDID,
+		req.RequestedFields,
+	)
+	if err != nil {
+		json.NewEncoder(w).Encode(ConsentCheckResponse{
+			Success: false,
+			Error:   fmt.Sprintf("Failed to check consent: %v", err),
+		})
+		return
+	}
+
+	missing := []string{}
+	for _, field := range req.RequestedFields {
+		if !contains(consented, field) && !contains(missing, field) {
+			missing = append(missing, field)
+		}
+	}
+
+	json.NewEncoder(w).Encode(ConsentCheckResponse{
+		Success:         true,
+		ConsentedFields: consented,
+		MissingFields:   missing,
+		ConsentRequired: len(missing) > 0,
+	})
+}
+
+// FieldExposureRequest asks which consents expose one of a citizen's fields
+type FieldExposureRequest struct {
+	CitizenDID string `json:"citizen_did"`
+	Field      string `json:"field"`
+}
+
+// FieldExposureResponse lists the professionals currently granted the field
+type FieldExposureResponse struct {
+	Success   bool             `json:"success"`
+	Field     string           `json:"field,omitempty"`
+	Exposures []*FieldExposure `json:"exposures"`
+	Error     string           `json:"error,omitempty"`
+}
+
+// HandleFieldExposure handles POST /v1/access-control/consent/exposure
+func (ach *AccessControlHandlers) HandleFieldExposure(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req FieldExposureRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		json.NewEncoder(w).Encode(FieldExposureResponse{
+			Success: false,
+			Error:   fmt.Sprintf("Invalid request: %v", err),
+		})
+		return
+	}
+
+	exposures, err := ach.metadataController.GetFieldExposure(r.Context(), req.CitizenDID, req.Field)
+	if err != nil {
+		json.NewEncoder(w).Encode(FieldExposureResponse{
+			Success: false,
+			Error:   fmt.Sprintf("Failed to get field exposure: %v", err),
+		})
+		return
+	}
+
+	json.NewEncoder(w).Encode(FieldExposureResponse{
+		Success:   true,
+		Field:     req.Field,
+		Exposures: exposures,
+	})
+}
+
 // HireProfessionalRequest represents a consultation hire request
 type HireProfessionalRequest struct {
 	CitizenDID      string `json:"citizen_did"`
 	ProfessionalDID string `json:"professional_did"`
 	ServiceType     string `json:"service_type"`
 	Description     string `json:"description"`
+	IdempotencyKey  string `json:"idempotency_key,omitempty"` // Retries with the same key return the original contract
 }
 
 // HireProfessionalResponse represents a consultation hire response
@@ -137,7 +259,7 @@ func (ach *AccessControlHandlers) HandleHireProfessional(w http.ResponseWriter,
 	}
 
 	// Get professional
-	professional, err := ach.registry.GetProfessionalByDID(context.Background(), req.ProfessionalDID)
+	professional, err := ach.registry.GetProfessionalByDID(r.Context(), req.ProfessionalDID)
 	if err != nil {
 		json.NewEncoder(w).Encode(HireProfessionalResponse{
 			Success: false,
@@ -148,12 +270,13 @@ func (ach *AccessControlHandlers) HandleHireProfessional(w http.ResponseWriter,
 
 	// Create consultation contract
 	contract, err := ach.consultationContract.HireProfessional(
-		context.Background(),
+		r.Context(),
 		req.CitizenDID,
 		req.ProfessionalDID,
 		professional,
 		req.ServiceType,
 		req.Description,
+		req.IdempotencyKey,
 	)
 
 	if err != nil {
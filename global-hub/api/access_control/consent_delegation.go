@@ -0,0 +1,251 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Guardian Consent Delegation
+//
+// Records guardian -> ward relationships (legal guardians of minors and
+// holders of power of attorney for incapacitated citizens) so a guardian's
+// biometric can authorize metadata consent on the ward's behalf, limited to
+// the fields the legal instrument covers.
+
+package access_control
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DelegationType represents the legal basis of a delegation
+type DelegationType string
+
+const (
+	DelegationGuardian        DelegationType = "guardian"          // Legal guardian of a minor
+	DelegationPowerOfAttorney DelegationType = "power_of_attorney" // Attorney for an incapacitated citizen
+)
+
+// ConsentDelegation represents a guardian's legal authority to consent for a ward
+type ConsentDelegation struct {
+	DelegationID      string         `json:"delegation_id"`
+	GuardianDID       string         `json:"guardian_did"`
+	WardDID           string         `json:"ward_did"`
+	Type              DelegationType `json:"type"`
+	AllowedFields     []string       `json:"allowed_fields"`      // Fields the legal instrument covers
+	LegalDocumentHash string         `json:"legal_document_hash"` // Hash of court order / power of attorney
+	GuardianSignature []byte         `json:"guardian_signature"`  // Guardian's signature over DelegationMessage
+	WardSignature     []byte         `json:"ward_signature"`      // Ward's signature over DelegationMessage
+	RegisteredAt      time.Time      `json:"registered_at"`
+	ExpiresAt         time.Time      `json:"expires_at"` // e.g., ward's 18th birthday
+	RevokedAt         *time.Time     `json:"revoked_at,omitempty"`
+	IsActive          bool           `json:"is_active"`
+}
+
+// IsValid checks if the delegation is still in force
+func (cd *ConsentDelegation) IsValid() bool {
+	if !cd.IsActive || cd.RevokedAt != nil {
+		return false
+	}
+	return time.Now().Before(cd.ExpiresAt)
+}
+
+// DIDKeyResolver resolves the public key registered for a DID
+type DIDKeyResolver interface {
+	// ResolveDIDKey returns the DID's current Ed25519 verification key
+	ResolveDIDKey(ctx context.Context, did string) (ed25519.PublicKey, error)
+}
+
+// DelegationRegistry stores guardian -> ward delegations
+type DelegationRegistry struct {
+	delegations map[string]*ConsentDelegation // delegationID -> delegation
+	keys        DIDKeyResolver                // Verifies registration signatures
+	mu          sync.RWMutex
+}
+
+// NewDelegationRegistry creates a new delegation registry
+func NewDelegationRegistry(keys DIDKeyResolver) *DelegationRegistry {
+	return &DelegationRegistry{
+		delegations: make(map[string]*ConsentDelegation),
+		keys:        keys,
+	}
+}
+
+// DelegationMessage is the payload guardian and ward both sign to register a delegation
+func DelegationMessage(
+	guardianDID string,
+	wardDID string,
+	delegationType DelegationType,
+	allowedFields []string,
+	legalDocumentHash string,
+	expiresAt time.Time,
+) []byte {
+	return []byte(fmt.Sprintf("sovrn-delegation|%s|%s|%s|%s|%s|%d",
+		guardianDID, wardDID, delegationType, strings.Join(allowedFields, ","), legalDocumentHash, expiresAt.Unix()))
+}
+
+// verifySignature checks a signature over message against the DID's registered key
+func (dr *DelegationRegistry) verifySignature(ctx context.Context, did string, message []byte, signature []byte) error {
+	return verifyDIDSignature(ctx, dr.keys, did, message, signature)
+}
+
+// verifyDIDSignature checks an Ed25519 signature over message against the key registered for did
+func verifyDIDSignature(ctx context.Context, keys DIDKeyResolver, did string, message []byte, signature []byte) error {
+	if keys == nil {
+		return fmt.Errorf("no DID key resolver configured")
+	}
+
+	publicKey, err := keys.ResolveDIDKey(ctx, did)
+	if err != nil {
+		return fmt.Errorf("failed to resolve key for %s: %w", did, err)
+	}
+
+	if len(publicKey) != ed25519.PublicKeySize || !ed25519.Verify(publicKey, message, signature) {
+		return fmt.Errorf("invalid signature for %s", did)
+	}
+
+	return nil
+}
+
+// RegisterDelegation records a guardian's authority to consent for a ward
+//
+// DELEGATION LOGIC:
+// 1. Guardian and ward must be different citizens
+// 2. Legal document hash is required
+// 3. Delegation is bounded to the fields the legal instrument covers
+// 4. Guardian and ward must both sign DelegationMessage with their DID keys
+// 5. Only one active delegation per guardian/ward pair
+func (dr *DelegationRegistry) RegisterDelegation(
+	ctx context.Context,
+	guardianDID string,
+	wardDID string,
+	delegationType DelegationType,
+	allowedFields []string,
+	legalDocumentHash string,
+	guardianSignature []byte,
+	wardSignature []byte,
+	expiresAt time.Time,
+) (*ConsentDelegation, error) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+
+	if guardianDID == "" || wardDID == "" {
+		return nil, fmt.Errorf("guardian and ward DIDs required")
+	}
+
+	if guardianDID == wardDID {
+		return nil, fmt.Errorf("citizen cannot be their own guardian")
+	}
+
+	if delegationType != DelegationGuardian && delegationType != DelegationPowerOfAttorney {
+		return nil, fmt.Errorf("invalid delegation type: %s", delegationType)
+	}
+
+	if legalDocumentHash == "" {
+		return nil, fmt.Errorf("legal document hash required for delegation")
+	}
+
+	if len(allowedFields) == 0 {
+		return nil, fmt.Errorf("delegation must cover at least one field")
+	}
+
+	if !expiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("delegation expiry must be in the future")
+	}
+
+	message := DelegationMessage(guardianDID, wardDID, delegationType, allowedFields, legalDocumentHash, expiresAt)
+	if err := dr.verifySignature(ctx, guardianDID, message, guardianSignature); err != nil {
+		return nil, fmt.Errorf("guardian signature rejected: %w", err)
+	}
+	if err := dr.verifySignature(ctx, wardDID, message, wardSignature); err != nil {
+		return nil, fmt.Errorf("ward signature rejected: %w", err)
+	}
+
+	if existing := dr.findLocked(guardianDID, wardDID); existing != nil {
+		return nil, fmt.Errorf("active delegation already exists: %s", existing.DelegationID)
+	}
+
+	delegation := &ConsentDelegation{
+		DelegationID:      uuid.New().String(),
+		GuardianDID:       guardianDID,
+		WardDID:           wardDID,
+		Type:              delegationType,
+		AllowedFields:     allowedFields,
+		LegalDocumentHash: legalDocumentHash,
+		GuardianSignature: guardianSignature,
+		WardSignature:     wardSignature,
+		RegisteredAt:      time.Now(),
+		ExpiresAt:         expiresAt,
+		IsActive:          true,
+	}
+
+	dr.delegations[delegation.DelegationID] = delegation
+
+	return delegation, nil
+}
+
+// RevokeDelegation revokes a delegation (e.g., ward reaches majority, court order lifted)
+// Consents granted under the delegation stop being valid with it.
+func (dr *DelegationRegistry) RevokeDelegation(ctx context.Context, delegationID string) error {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+
+	delegation, exists := dr.delegations[delegationID]
+	if !exists {
+		return fmt.Errorf("delegation not found: %s", delegationID)
+	}
+
+	now := time.Now()
+	delegation.RevokedAt = &now
+	delegation.IsActive = false
+
+	return nil
+}
+
+// GetActiveDelegation returns the valid delegation from guardian to ward
+func (dr *DelegationRegistry) GetActiveDelegation(ctx context.Context, guardianDID string, wardDID string) (*ConsentDelegation, error) {
+	dr.mu.RLock()
+	defer dr.mu.RUnlock()
+
+	delegation := dr.findLocked(guardianDID, wardDID)
+	if delegation == nil {
+		return nil, fmt.Errorf("%s is not a registered guardian of %s", guardianDID, wardDID)
+	}
+
+	return delegation, nil
+}
+
+// IsDelegationActive reports whether a delegation exists and is still in force
+func (dr *DelegationRegistry) IsDelegationActive(delegationID string) bool {
+	dr.mu.RLock()
+	defer dr.mu.RUnlock()
+
+	delegation, exists := dr.delegations[delegationID]
+	return exists && delegation.IsValid()
+}
+
+// GetWardDelegations returns all delegations (including revoked) for a ward
+func (dr *DelegationRegistry) GetWardDelegations(ctx context.Context, wardDID string) []*ConsentDelegation {
+	dr.mu.RLock()
+	defer dr.mu.RUnlock()
+
+	delegations := make([]*ConsentDelegation, 0)
+	for _, delegation := range dr.delegations {
+		if delegation.WardDID == wardDID {
+			delegations = append(delegations, delegation)
+		}
+	}
+
+	return delegations
+}
+
+// findLocked returns the valid delegation for a guardian/ward pair (caller holds lock)
+func (dr *DelegationRegistry) findLocked(guardianDID string, wardDID string) *ConsentDelegation {
+	for _, delegation := range dr.delegations {
+		if delegation.GuardianDID == guardianDID && delegation.WardDID == wardDID && delegation.IsValid() {
+			return delegation
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Consultation Archival
+//
+// Terminal contracts (settled completions, cancellations, refunds and
+// resolutions) are moved out of the hot contract map once they are older
+// than a threshold. Archived contracts are no longer returned by listings but
+// remain fetchable through the archive. Active and disputed contracts are
+// never archived.
+
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ContractArchive stores contracts removed from the active set
+type ContractArchive interface {
+	// Store adds contracts to the archive
+	Store(ctx context.Context, contracts []*ConsultationContract) error
+
+	// Get returns an archived contract
+	Get(ctx context.Context, contractID string) (*ConsultationContract, error)
+
+	// ListByParty returns archived contracts where did is the citizen or the professional
+	ListByParty(ctx context.Context, did string) ([]*ConsultationContract, error)
+}
+
+// SetContractArchive replaces the archive store (default: in-memory)
+func (csc *ConsultationSmartContract) SetContractArchive(archive ContractArchive) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.archive = archive
+}
+
+// ArchiveOldContracts moves terminal contracts whose last activity is older than olderThan to the archive
+// Returns the IDs of the archived contracts.
+//
+// ARCHIVAL LOGIC:
+// 1. Cancelled, refunded and resolved contracts qualify
+// 2. Completed contracts qualify once no escrow or holdback is left and the dispute window closed
+// 3. Last activity is the resolution, completion or creation time, in that order
+// 4. Qualifying contracts are stored in the archive first, then removed from the active map
+func (csc *ConsultationSmartContract) ArchiveOldContracts(ctx context.Context, olderThan time.Duration) ([]string, error) {
+	if olderThan < 0 {
+		return nil, fmt.Errorf("archive threshold cannot be negative")
+	}
+
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	now := time.Now()
+	cutoff := now.Add(-olderThan)
+
+	candidates := make([]*ConsultationContract, 0)
+	for _, contract := range csc.contracts {
+		if !contract.isArchivable(now) {
+			continue
+		}
+		if contract.lastActivity().After(cutoff) {
+			continue
+		}
+		candidates = append(candidates, contract)
+	}
+
+	if len(candidates) == 0 {
+		return []string{}, nil
+	}
+
+	if err := csc.archive.Store(ctx, candidates); err != nil {
+		return nil, fmt.Errorf("failed to archive contracts: %w", err)
+	}
+
+	archived := make([]string, 0, len(candidates))
+	for _, contract := range candidates {
+		delete(csc.contracts, contract.ContractID)
+		archived = append(archived, contract.ContractID)
+	}
+
+	fmt.Printf("✅ Archived %d consultation contracts older than %s\n", len(archived), olderThan)
+
+	return archived, nil
+}
+
+// GetArchivedContract retrieves a contract from the archive
+func (csc *ConsultationSmartContract) GetArchivedContract(ctx context.Context, contractID string) (*ConsultationContract, error) {
+	csc.mu.RLock()
+	archive := csc.archive
+	csc.mu.RUnlock()
+
+	return archive.Get(ctx, contractID)
+}
+
+// GetArchivedContracts retrieves archived contracts for a citizen or professional
+func (csc *ConsultationSmartContract) GetArchivedContracts(ctx context.Context, did string) ([]*ConsultationContract, error) {
+	csc.mu.RLock()
+	archive := csc.archive
+	csc.mu.RUnlock()
+
+	return archive.ListByParty(ctx, did)
+}
+
+// isArchivable reports whether the contract is in a terminal state with nothing left to settle
+func (c *ConsultationContract) isArchivable(now time.Time) bool {
+	switch c.Status {
+	case StatusCancelled, StatusRefunded, StatusResolved:
+		return true
+	case StatusCompleted:
+		if c.EscrowBalance != 0 || c.HoldbackBalance != 0 {
+			return false
+		}
+		// Completed contracts can still be disputed while the window is open
+		return c.ReleaseDeadline == nil || !now.Before(*c.ReleaseDeadline)
+	default:
+		return false
+	}
+}
+
+// lastActivity returns the most recent lifecycle timestamp of the contract
+func (c *ConsultationContract) lastActivity() time.Time {
+	if c.Resolution != nil {
+		return c.Resolution.ResolvedAt
+	}
+	if c.CompletedAt != nil {
+		return *c.CompletedAt
+	}
+	return c.CreatedAt
+}
+
+// MemoryContractArchive keeps archived contracts in memory
+type MemoryContractArchive struct {
+	contracts map[string]*ConsultationContract // contractID -> contract
+	mu        sync.RWMutex
+}
+
+// NewMemoryContractArchive creates an in-memory contract archive
+func NewMemoryContractArchive() *MemoryContractArchive {
+	return &MemoryContractArchive{
+		contracts: make(map[string]*ConsultationContract),
+	}
+}
+
+// Store adds contracts to the archive
+func (ma *MemoryContractArchive) Store(ctx context.Context, contracts []*ConsultationContract) error {
+	ma.mu.Lock()
+	defer ma.mu.Unlock()
+
+	for _, contract := range contracts {
+		ma.contracts[contract.ContractID] = contract
+	}
+
+	return nil
+}
+
+// Get returns an archived contract
+func (ma *MemoryContractArchive) Get(ctx context.Context, contractID string) (*ConsultationContract, error) {
+	ma.mu.RLock()
+	defer ma.mu.RUnlock()
+
+	contract, exists := ma.contracts[contractID]
+	if !exists {
+		return nil, fmt.Errorf("archived contract not found: %s", contractID)
+	}
+
+	return contract, nil
+}
+
+// ListByParty returns archived contracts where did is the citizen or the professional
+func (ma *MemoryContractArchive) ListByParty(ctx context.Context, did string) ([]*ConsultationContract, error) {
+	ma.mu.RLock()
+	defer ma.mu.RUnlock()
+
+	contracts := make([]*ConsultationContract, 0)
+	for _, contract := range ma.contracts {
+		if contract.CitizenDID == did || contract.ProfessionalDID == did {
+			contracts = append(contracts, contract)
+		}
+	}
+
+	return contracts, nil
+}
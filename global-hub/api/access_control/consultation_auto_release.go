@@ -0,0 +1,91 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Escrow Auto-Release
+//
+// Delivered escrow is held until the citizen confirms. If the citizen never
+// does, a background sweep releases it once the auto-release delay has
+// elapsed and the dispute window (if open) has closed, and tells the citizen
+// their silence was taken as acceptance.
+
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// EscrowReleaseNotifier notifies citizens when their unconfirmed escrow is released
+type EscrowReleaseNotifier interface {
+	// NotifyEscrowAutoReleased tells the citizen the professional was paid without their confirmation
+	NotifyEscrowAutoReleased(ctx context.Context, citizenDID string, contractID string, professionalDID string, amount int64, releasedAt time.Time) error
+}
+
+// SetEscrowReleaseNotifier sets the notifier used to alert citizens of auto-released escrow
+func (csc *ConsultationSmartContract) SetEscrowReleaseNotifier(notifier EscrowReleaseNotifier) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.releaseNotifier = notifier
+}
+
+// SetAutoReleaseDelay changes how long delivered escrow waits for citizen action
+// A zero delay releases as soon as the dispute window closes. Applies to
+// services delivered after the call; already scheduled releases keep their time.
+func (csc *ConsultationSmartContract) SetAutoReleaseDelay(delay time.Duration) error {
+	if delay < 0 {
+		return fmt.Errorf("auto-release delay cannot be negative")
+	}
+
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.autoReleaseDelay = delay
+
+	return nil
+}
+
+// ReleaseMaturedEscrows releases held escrow for delivered contracts the citizen never confirmed
+//
+// SWEEP LOGIC:
+// 1. Only completed, non-multisig contracts with escrow still held (disputed contracts are skipped)
+// 2. The auto-release delay must have elapsed since delivery
+// 3. An open dispute window blocks release until it closes
+// 4. The citizen is notified of the release (if a notifier is set)
+// Returns the IDs of the contracts that were released.
+func (csc *ConsultationSmartContract) ReleaseMaturedEscrows(ctx context.Context) ([]string, error) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	now := time.Now()
+	released := make([]string, 0)
+
+	for _, contract := range csc.contracts {
+		if contract.Multisig != nil || contract.Status != StatusCompleted || contract.EscrowBalance == 0 {
+			continue
+		}
+
+		if contract.AutoReleaseAt == nil || now.Before(*contract.AutoReleaseAt) {
+			continue
+		}
+
+		if contract.ReleaseDeadline != nil && now.Before(*contract.ReleaseDeadline) {
+			continue
+		}
+
+		amount := contract.EscrowBalance
+		if err := csc.releaseEscrowLocked(ctx, contract); err != nil {
+			return released, fmt.Errorf("failed to release contract %s: %w", contract.ContractID, err)
+		}
+
+		released = append(released, contract.ContractID)
+
+		if csc.releaseNotifier != nil {
+			if err := csc.releaseNotifier.NotifyEscrowAutoReleased(ctx, contract.CitizenDID, contract.ContractID, contract.ProfessionalDID, amount, now); err != nil {
+				fmt.Printf("⚠️  Failed to send auto-release notification to %s: %v\n", contract.CitizenDID, err)
+				// Escrow already released; continue the sweep
+			}
+		}
+	}
+
+	return released, nil
+}
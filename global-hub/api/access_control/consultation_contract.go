@@ -13,6 +13,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/sovrn-protocol/sovrn/chain/shared"
+	"github.com/sovrn-protocol/sovrn/hub/api/audit"
+	"github.com/sovrn-protocol/sovrn/hub/api/pricing"
 )
 
 // ConsultationStatus represents the status of a consultation
@@ -21,12 +25,32 @@ type ConsultationStatus string
 const (
 	StatusPending    ConsultationStatus = "pending"     // Payment in escrow, awaiting service
 	StatusInProgress ConsultationStatus = "in_progress" // Professional working on consultation
-	StatusCompleted  ConsultationStatus = "completed"   // Service delivered, payment released
+	StatusCompleted  ConsultationStatus = "completed"   // Service delivered, payment released on confirmation or auto-release
 	StatusDisputed   ConsultationStatus = "disputed"    // Dispute raised by citizen
-	StatusCancelled  ConsultationStatus = "cancelled"   // Cancelled before completion
+	StatusCancelled  ConsultationStatus = "cancelled"   // Cancelled before completion (no longer set; cancellations refund)
 	StatusRefunded   ConsultationStatus = "refunded"    // Payment refunded to citizen
+	StatusResolved   ConsultationStatus = "resolved"    // Dispute settled by an arbiter (full or partial refund)
 )
 
+// ConsultationTransitions defines the legal consultation status changes
+// Pending contracts are cancelled with a full refund (CancelContract); disputes
+// are only closed by an arbiter's ruling (ResolveDispute), full or partial.
+var ConsultationTransitions = shared.NewStateMachine("consultation", map[string][]string{
+	string(StatusPending):    {string(StatusInProgress), string(StatusRefunded)},
+	string(StatusInProgress): {string(StatusCompleted)},
+	string(StatusCompleted):  {string(StatusDisputed)},
+	string(StatusDisputed):   {string(StatusResolved)},
+})
+
+// transitionTo moves the contract to a new status if the transition is legal
+func (c *ConsultationContract) transitionTo(status ConsultationStatus) error {
+	if err := ConsultationTransitions.ValidateTransition(string(c.Status), string(status)); err != nil {
+		return fmt.Errorf("invalid status: %w", err)
+	}
+	c.Status = status
+	return nil
+}
+
 // ConsultationContract represents a smart contract for professional consultation
 type ConsultationContract struct {
 	ContractID       string             `json:"contract_id"`
@@ -35,7 +59,8 @@ type ConsultationContract struct {
 	ProfessionalRole ProfessionalRole   `json:"professional_role"`
 	ServiceType      string             `json:"service_type"`      // e.g., "Legal advice", "Financial audit"
 	Description      string             `json:"description"`       // Consultation details
-	Fee              int64              `json:"fee"`               // uSOV (default: 50 SOV = 50,000,000 uSOV)
+	Fee              int64              `json:"fee"`               // uSOV (from the professional's tier, or an override)
+	TierName         string             `json:"tier_name,omitempty"` // Professional tier the fee was priced at
 	EscrowBalance    int64              `json:"escrow_balance"`    // Current balance in escrow
 	Status           ConsultationStatus `json:"status"`
 	CreatedAt        time.Time          `json:"created_at"`
@@ -44,6 +69,28 @@ type ConsultationContract struct {
 	DeliveryProof    string             `json:"delivery_proof,omitempty"` // Hash of delivered document/signature
 	CitizenSignature []byte             `json:"citizen_signature,omitempty"` // Citizen's acceptance signature
 	DisputeReason    string             `json:"dispute_reason,omitempty"`
+	DisputeEvidence  []DisputeEvidence  `json:"dispute_evidence,omitempty"` // Append-only evidence from both parties
+	ConsentID        string             `json:"consent_id,omitempty"` // Linked metadata consent (packaged hires)
+
+	// Optional N-of-M confirmation requirement (escrow held until met or window passes)
+	Multisig         *ConsultationMultisig `json:"multisig,omitempty"`
+	ReleaseDeadline  *time.Time            `json:"release_deadline,omitempty"` // Dispute window end; escrow claimable after
+
+	// Escrow auto-release if the citizen never confirms (blocked while the dispute window is open)
+	AutoReleaseAt *time.Time `json:"auto_release_at,omitempty"`
+
+	// Optional payout holdback (withheld share released by ReleaseHoldback unless disputed)
+	HoldbackBalance   int64      `json:"holdback_balance,omitempty"`
+	HoldbackReleaseAt *time.Time `json:"holdback_release_at,omitempty"`
+
+	// Arbiter's settlement of a dispute (set by ResolveDispute)
+	Resolution *DisputeResolution `json:"resolution,omitempty"`
+
+	// Total paid out to the professional so far (escrow, holdback and resolution payouts)
+	ReleasedAmount int64 `json:"released_amount"`
+
+	// Citizen's rating after delivery (set by RateConsultation)
+	Rating *ConsultationRating `json:"rating,omitempty"`
 }
 
 // ConsultationResult represents the result of a consultation action
@@ -57,22 +104,121 @@ type ConsultationResult struct {
 
 // WalletManager interface for wallet operations
 type WalletManager interface {
-	DebitRegular(ctx context.Context, userID string, amount int64, purpose string) (string, error)
-	CreditRegular(ctx context.Context, userID string, amount int64, purpose string) (string, error)
+	DebitRegular(ctx context.Context, userID string, amount int64, purpose string, reference string) (string, error)
+	CreditRegular(ctx context.Context, userID string, amount int64, purpose string, reference string) (string, error)
 }
 
+// DefaultConsultationFee is the escrowed consultation fee (50 SOV in uSOV)
+const DefaultConsultationFee = pricing.DefaultConsultationFee
+
 // ConsultationSmartContract manages consultation contracts with escrow
 type ConsultationSmartContract struct {
-	contracts     map[string]*ConsultationContract
-	walletManager WalletManager
-	mu            sync.RWMutex
+	contracts           map[string]*ConsultationContract
+	walletManager       WalletManager
+	signatureVerifier   SignatureVerifier // Verifies citizens' acceptance signatures against their DID keys
+	auditLog            *audit.EscrowAuditLog
+	accessController    *MetadataAccessController // Optional, for contracts packaged with record access
+	pricing             *pricing.PricingService // Fallback fee for professionals without a matching tier
+	holdbackBasisPoints int64           // Share of each payout withheld (0 = release everything on delivery)
+	holdbackPeriod      time.Duration   // How long the withheld share is held
+	arbiters            map[string]bool // DIDs authorized to resolve disputes
+	feeOperators        map[string]bool // DIDs authorized to override any professional's fee
+	feeOverrides        map[string]int64 // professionalDID|citizenDID -> quoted fee for the next hire
+	autoReleaseDelay    time.Duration   // Delay after delivery before unconfirmed escrow is released
+	archive             ContractArchive // Terminal contracts moved out by ArchiveOldContracts
+	mu                  sync.RWMutex
+
+	// Dispute window for held escrow (claimable once it closes)
+	disputeWindowTrigger DisputeWindowTrigger // Event that opens the window
+	disputeWindow        time.Duration
+
+	// Hire idempotency keys (citizenDID|key -> contract created with it)
+	idempotencyKeys   map[string]hireIdempotencyEntry
+	idempotencyWindow time.Duration
+
+	// Optional notifications to citizens whose unconfirmed escrow was auto-released
+	releaseNotifier EscrowReleaseNotifier
+
+	// Set while the Start sweeper is running
+	sweeping bool
 }
 
+// DefaultAutoReleaseDelay is how long delivered escrow waits for citizen confirmation
+const DefaultAutoReleaseDelay = 72 * time.Hour
+
 // NewConsultationSmartContract creates a new consultation smart contract manager
-func NewConsultationSmartContract(walletManager WalletManager) *ConsultationSmartContract {
+// Escrow is held after delivery until the citizen confirms or autoReleaseDelay
+// elapses (negative values fall back to DefaultAutoReleaseDelay). Confirmations
+// are only accepted if signatureVerifier validates the acceptance signature.
+func NewConsultationSmartContract(walletManager WalletManager, signatureVerifier SignatureVerifier, autoReleaseDelay time.Duration) *ConsultationSmartContract {
+	if autoReleaseDelay < 0 {
+		autoReleaseDelay = DefaultAutoReleaseDelay
+	}
+
 	return &ConsultationSmartContract{
-		contracts:     make(map[string]*ConsultationContract),
-		walletManager: walletManager,
+		contracts:         make(map[string]*ConsultationContract),
+		walletManager:     walletManager,
+		signatureVerifier: signatureVerifier,
+		pricing:           pricing.NewPricingService(),
+		arbiters:          make(map[string]bool),
+		feeOperators:      make(map[string]bool),
+		feeOverrides:      make(map[string]int64),
+		autoReleaseDelay:  autoReleaseDelay,
+		archive:           NewMemoryContractArchive(),
+
+		disputeWindowTrigger: DisputeWindowFromDelivery,
+		disputeWindow:        MultisigDisputeWindow,
+
+		idempotencyKeys:   make(map[string]hireIdempotencyEntry),
+		idempotencyWindow: DefaultIdempotencyWindow,
+	}
+}
+
+// SetConsultationFee sets the fallback fee (uSOV) for professionals without a matching tier
+// The fee is written to the pricing service, so a shared schedule sees the change.
+func (csc *ConsultationSmartContract) SetConsultationFee(fee int64) error {
+	if fee <= 0 {
+		return fmt.Errorf("consultation fee must be positive")
+	}
+
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	return csc.pricing.SetFee(pricing.CategoryConsultation, pricing.ConsultationDefault, fee)
+}
+
+// SetPricingService shares the hub-wide fee schedule the fallback consultation fee is resolved from
+func (csc *ConsultationSmartContract) SetPricingService(pricingService *pricing.PricingService) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.pricing = pricingService
+}
+
+// SetAccessController enables hiring packaged with metadata access consent
+func (csc *ConsultationSmartContract) SetAccessController(accessController *MetadataAccessController) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.accessController = accessController
+}
+
+// SetAuditLog attaches a hash-chained audit log that records every escrow movement
+func (csc *ConsultationSmartContract) SetAuditLog(auditLog *audit.EscrowAuditLog) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.auditLog = auditLog
+}
+
+// recordEscrowAudit appends an escrow movement to the audit log (if configured)
+func (csc *ConsultationSmartContract) recordEscrowAudit(action audit.EscrowAction, contractID string, party string, amount int64, txID string) {
+	if csc.auditLog == nil {
+		return
+	}
+
+	if _, err := csc.auditLog.Record(audit.SourceConsultation, action, contractID, party, amount, txID); err != nil {
+		fmt.Printf("Warning: failed to record escrow audit event: %v\n", err)
 	}
 }
 
@@ -83,7 +229,7 @@ func NewConsultationSmartContract(walletManager WalletManager) *ConsultationSmar
 // 2. 50 SOV deducted from citizen's wallet
 // 3. Payment held in escrow (contract balance)
 // 4. Professional delivers service
-// 5. Citizen confirms delivery
+// 5. Citizen confirms delivery (or the auto-release delay elapses)
 // 6. Payment released to professional
 //
 // PARAMETERS:
@@ -92,6 +238,7 @@ func NewConsultationSmartContract(walletManager WalletManager) *ConsultationSmar
 // - professional: Certified professional details
 // - serviceType: Type of service requested
 // - description: Detailed description of consultation needs
+// - idempotencyKey: Optional client key; a retry with the same key returns the original contract without a second debit
 func (csc *ConsultationSmartContract) HireProfessional(
 	ctx context.Context,
 	citizenDID string,
@@ -99,34 +246,128 @@ func (csc *ConsultationSmartContract) HireProfessional(
 	professional *CertifiedProfessional,
 	serviceType string,
 	description string,
+	idempotencyKey string,
 ) (*ConsultationContract, error) {
 	csc.mu.Lock()
 	defer csc.mu.Unlock()
 
+	if idempotencyKey == "" {
+		return csc.hireProfessionalLocked(ctx, citizenDID, professionalDID, professional, serviceType, description)
+	}
+
+	now := time.Now()
+	existing, err := csc.idempotentHireLocked(ctx, citizenDID, professionalDID, idempotencyKey, now)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	contract, err := csc.hireProfessionalLocked(ctx, citizenDID, professionalDID, professional, serviceType, description)
+	if err != nil {
+		return nil, err
+	}
+
+	csc.recordIdempotentHireLocked(contract, idempotencyKey, now)
+
+	return contract, nil
+}
+
+// HireProfessionalWithAccess hires a professional and grants the linked metadata consent atomically
+//
+// PACKAGE LOGIC:
+// 1. Lock the consultation fee in escrow (same as HireProfessional)
+// 2. Grant consent for the requested fields, scoped to the professional's role
+// 3. If the consent fails, refund the escrow and discard the contract
+// 4. Neither the contract nor the consent exists without the other
+func (csc *ConsultationSmartContract) HireProfessionalWithAccess(
+	ctx context.Context,
+	citizenDID string,
+	professionalDID string,
+	professional *CertifiedProfessional,
+	serviceType string,
+	description string,
+	requestedFields []string,
+	biometricSignature []byte,
+) (*ConsultationContract, *AccessConsent, error) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	if csc.accessController == nil {
+		return nil, nil, fmt.Errorf("metadata access not enabled for consultations")
+	}
+
+	contract, err := csc.hireProfessionalLocked(ctx, citizenDID, professionalDID, professional, serviceType, description)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	consent, err := csc.accessController.GrantConsent(ctx, citizenDID, professionalDID, professional.Role, requestedFields, description, biometricSignature)
+	if err != nil {
+		// Roll back the escrow lock
+		if rollbackErr := csc.rollbackHireLocked(ctx, contract); rollbackErr != nil {
+			return nil, nil, fmt.Errorf("failed to grant consent: %v (rollback failed: %w)", err, rollbackErr)
+		}
+		return nil, nil, fmt.Errorf("failed to grant consent: %w", err)
+	}
+
+	contract.ConsentID = consent.ConsentID
+
+	return contract, consent, nil
+}
+
+// rollbackHireLocked refunds a just-created contract's escrow and removes it (caller holds csc.mu)
+func (csc *ConsultationSmartContract) rollbackHireLocked(ctx context.Context, contract *ConsultationContract) error {
+	txID, err := csc.walletManager.CreditRegular(ctx, contract.CitizenDID, contract.EscrowBalance, "consultation_refund", contract.ContractID)
+	if err != nil {
+		return fmt.Errorf("failed to refund citizen: %w", err)
+	}
+
+	csc.recordEscrowAudit(audit.EscrowActionRefund, contract.ContractID, contract.CitizenDID, contract.EscrowBalance, txID)
+
+	delete(csc.contracts, contract.ContractID)
+
+	return nil
+}
+
+// hireProfessionalLocked creates a contract and locks the fee in escrow (caller holds csc.mu)
+func (csc *ConsultationSmartContract) hireProfessionalLocked(
+	ctx context.Context,
+	citizenDID string,
+	professionalDID string,
+	professional *CertifiedProfessional,
+	serviceType string,
+	description string,
+) (*ConsultationContract, error) {
 	// 1. Validate professional's license
 	if !professional.IsLicenseValid() {
 		return nil, fmt.Errorf("professional license expired or inactive")
 	}
 
-	// 2. Calculate fee (default: 50 SOV)
-	const DefaultConsultationFee = 50_000_000 // 50 SOV in uSOV
-	fee := DefaultConsultationFee
+	// 2. Calculate fee (professional-set override, tier fee, or the fallback fee)
+	fee, tierName, err := csc.resolveFeeLocked(professional, citizenDID)
+	if err != nil {
+		return nil, err
+	}
 
-	// 3. Debit citizen's wallet (payment goes to escrow)
-	txID, err := csc.walletManager.DebitRegular(ctx, citizenDID, fee, "consultation_escrow")
+	// 3. Debit citizen's wallet (payment goes to escrow, referenced by the new contract ID)
+	contractID := uuid.New().String()
+	txID, err := csc.walletManager.DebitRegular(ctx, citizenDID, fee, "consultation_escrow", contractID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to debit citizen wallet: %w", err)
 	}
 
 	// 4. Create consultation contract
 	contract := &ConsultationContract{
-		ContractID:       uuid.New().String(),
+		ContractID:       contractID,
 		CitizenDID:       citizenDID,
 		ProfessionalDID:  professionalDID,
 		ProfessionalRole: professional.Role,
 		ServiceType:      serviceType,
 		Description:      description,
 		Fee:              fee,
+		TierName:         tierName,
 		EscrowBalance:    fee, // Full payment in escrow
 		Status:           StatusPending,
 		CreatedAt:        time.Now(),
@@ -134,6 +375,12 @@ func (csc *ConsultationSmartContract) HireProfessional(
 
 	csc.contracts[contract.ContractID] = contract
 
+	// The override was quoted for this hire only
+	delete(csc.feeOverrides, feeOverrideKey(professional.DID, citizenDID))
+
+	// 5. Audit escrow lock
+	csc.recordEscrowAudit(audit.EscrowActionLock, contract.ContractID, citizenDID, fee, txID)
+
 	fmt.Printf("✅ Consultation Contract Created\n")
 	fmt.Printf("   Contract ID: %s\n", contract.ContractID)
 	fmt.Printf("   Citizen: %s\n", citizenDID)
@@ -144,6 +391,34 @@ func (csc *ConsultationSmartContract) HireProfessional(
 	return contract, nil
 }
 
+// resolveFeeLocked prices a contract for a citizen (caller holds csc.mu)
+// An override set with SetFeeOverride wins; otherwise the professional's tier fee applies.
+func (csc *ConsultationSmartContract) resolveFeeLocked(professional *CertifiedProfessional, citizenDID string) (int64, string, error) {
+	fee, tierName, err := csc.tierFeeLocked(professional)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if override, exists := csc.feeOverrides[feeOverrideKey(professional.DID, citizenDID)]; exists && override > fee {
+		fee = override
+	}
+
+	return fee, tierName, nil
+}
+
+// tierFeeLocked returns the professional's tier fee, or the fallback fee without a matching tier (caller holds csc.mu)
+func (csc *ConsultationSmartContract) tierFeeLocked(professional *CertifiedProfessional) (int64, string, error) {
+	if tier, err := professional.GetTier(); err == nil {
+		return tier.ConsultationFee, tier.TierName, nil
+	}
+
+	fee, err := csc.pricing.ConsultationFee(pricing.ConsultationDefault)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to resolve consultation fee: %w", err)
+	}
+	return fee, "", nil
+}
+
 // StartConsultation marks the consultation as in progress
 func (csc *ConsultationSmartContract) StartConsultation(
 	ctx context.Context,
@@ -163,14 +438,12 @@ func (csc *ConsultationSmartContract) StartConsultation(
 		return nil, fmt.Errorf("unauthorized: only assigned professional can start consultation")
 	}
 
-	// Validate status
-	if contract.Status != StatusPending {
-		return nil, fmt.Errorf("invalid status: contract must be pending")
+	// Update status (only pending contracts can start)
+	if err := contract.transitionTo(StatusInProgress); err != nil {
+		return nil, err
 	}
 
-	// Update status
 	now := time.Now()
-	contract.Status = StatusInProgress
 	contract.StartedAt = &now
 
 	return &ConsultationResult{
@@ -188,7 +461,7 @@ func (csc *ConsultationSmartContract) StartConsultation(
 // 1. Professional uploads digital advice/signature
 // 2. Delivery proof (document hash) recorded
 // 3. Status changed to completed
-// 4. Awaiting citizen confirmation
+// 4. Escrow held awaiting citizen confirmation or auto-release
 func (csc *ConsultationSmartContract) DeliverService(
 	ctx context.Context,
 	contractID string,
@@ -209,7 +482,7 @@ func (csc *ConsultationSmartContract) DeliverService(
 	}
 
 	// Validate status
-	if contract.Status != StatusInProgress {
+	if !ConsultationTransitions.CanTransition(string(contract.Status), string(StatusCompleted)) {
 		return nil, fmt.Errorf("invalid status: contract must be in progress")
 	}
 
@@ -219,36 +492,51 @@ func (csc *ConsultationSmartContract) DeliverService(
 	}
 
 	// Update contract
+	if err := contract.transitionTo(StatusCompleted); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
-	contract.Status = StatusCompleted
 	contract.CompletedAt = &now
 	contract.DeliveryProof = deliveryProof
 
-	// AUTONOMOUS PAYMENT RELEASE: Release escrow to professional
-	_, err := csc.walletManager.CreditRegular(ctx, professionalDID, contract.EscrowBalance, "consultation_payment")
-	if err != nil {
-		return nil, fmt.Errorf("failed to release payment: %w", err)
+	// MULTISIG: Hold escrow until enough signers confirm or the dispute window passes
+	if contract.Multisig != nil {
+		csc.openDisputeWindowLocked(contract, DisputeWindowFromDelivery, now)
+
+		return &ConsultationResult{
+			ContractID:    contractID,
+			Status:        StatusCompleted,
+			Message:       fmt.Sprintf("Service delivered - escrow held pending %d of %d confirmations", contract.Multisig.Threshold, len(contract.Multisig.Signers)),
+			EscrowBalance: contract.EscrowBalance,
+			Timestamp:     time.Now(),
+		}, nil
 	}
 
-	// Clear escrow balance
-	contract.EscrowBalance = 0
+	// ESCROW HOLD: Released on citizen confirmation, or by ReleaseMaturedEscrows after the delay
+	csc.openDisputeWindowLocked(contract, DisputeWindowFromDelivery, now)
+	autoReleaseAt := now.Add(csc.autoReleaseDelay)
+	contract.AutoReleaseAt = &autoReleaseAt
 
-	fmt.Printf("✅ Service Delivered & Payment Released\n")
+	fmt.Printf("✅ Service Delivered - Escrow Held\n")
 	fmt.Printf("   Contract ID: %s\n", contractID)
 	fmt.Printf("   Professional: %s\n", professionalDID)
-	fmt.Printf("   Payment: %.6f SOV\n", float64(contract.Fee)/1_000_000)
+	fmt.Printf("   Escrow: %.6f SOV (auto-release after %s)\n", float64(contract.EscrowBalance)/1_000_000, autoReleaseAt.Format(time.RFC3339))
 	fmt.Printf("   Delivery Proof: %s\n", deliveryProof)
 
 	return &ConsultationResult{
 		ContractID:    contractID,
 		Status:        StatusCompleted,
-		Message:       "Service delivered and payment released to professional",
-		EscrowBalance: 0,
+		Message:       fmt.Sprintf("Service delivered - escrow held until citizen confirmation or %s", autoReleaseAt.Format(time.RFC3339)),
+		EscrowBalance: contract.EscrowBalance,
 		Timestamp:     time.Now(),
 	}, nil
 }
 
-// ConfirmDelivery allows citizen to confirm service delivery (optional)
+// ConfirmDelivery allows citizen to confirm service delivery
+// For regular contracts the citizen's confirmation releases the held escrow;
+// for multisig contracts each confirming signer calls it, and escrow is released
+// once the threshold is reached.
 func (csc *ConsultationSmartContract) ConfirmDelivery(
 	ctx context.Context,
 	contractID string,
@@ -263,6 +551,10 @@ func (csc *ConsultationSmartContract) ConfirmDelivery(
 		return nil, fmt.Errorf("contract not found: %s", contractID)
 	}
 
+	if contract.Multisig != nil {
+		return csc.confirmMultisigLocked(ctx, contract, citizenDID, citizenSignature)
+	}
+
 	// Validate citizen
 	if contract.CitizenDID != citizenDID {
 		return nil, fmt.Errorf("unauthorized: only contract citizen can confirm delivery")
@@ -273,24 +565,52 @@ func (csc *ConsultationSmartContract) ConfirmDelivery(
 		return nil, fmt.Errorf("invalid status: contract must be completed")
 	}
 
-	// Record citizen's acceptance signature
+	// Verify and record citizen's acceptance signature
+	if err := csc.verifyAcceptanceLocked(contract, citizenDID, citizenSignature); err != nil {
+		return nil, err
+	}
 	contract.CitizenSignature = citizenSignature
+	csc.openDisputeWindowLocked(contract, DisputeWindowFromConfirmation, time.Now())
+
+	if contract.EscrowBalance == 0 {
+		return &ConsultationResult{
+			ContractID:    contractID,
+			Status:        StatusCompleted,
+			Message:       "Delivery confirmed by citizen",
+			EscrowBalance: 0,
+			Timestamp:     time.Now(),
+		}, nil
+	}
+
+	// Citizen acceptance releases the held escrow
+	if err := csc.releaseEscrowLocked(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	message := "Delivery confirmed by citizen - payment released to professional"
+	if contract.HoldbackBalance > 0 {
+		message = fmt.Sprintf("Delivery confirmed - payment released with %.6f SOV held back until %s", float64(contract.HoldbackBalance)/1_000_000, contract.HoldbackReleaseAt.Format(time.RFC3339))
+	}
 
 	return &ConsultationResult{
 		ContractID:    contractID,
 		Status:        StatusCompleted,
-		Message:       "Delivery confirmed by citizen",
-		EscrowBalance: contract.EscrowBalance,
+		Message:       message,
+		EscrowBalance: 0,
 		Timestamp:     time.Now(),
 	}, nil
 }
 
 // RaiseDispute allows citizen to dispute the service delivery
+// Optional evidence references are recorded with the dispute; either party can add more via SubmitDisputeEvidence.
+// Disputes close with the dispute window (ReleaseDeadline) and once nothing is left
+// to withhold (escrow released and no holdback outstanding).
 func (csc *ConsultationSmartContract) RaiseDispute(
 	ctx context.Context,
 	contractID string,
 	citizenDID string,
 	disputeReason string,
+	evidence ...EvidenceReference,
 ) (*ConsultationResult, error) {
 	csc.mu.Lock()
 	defer csc.mu.Unlock()
@@ -305,13 +625,32 @@ func (csc *ConsultationSmartContract) RaiseDispute(
 		return nil, fmt.Errorf("unauthorized: only contract citizen can raise dispute")
 	}
 
-	// Validate status (can only dispute completed contracts)
-	if contract.Status != StatusCompleted {
-		return nil, fmt.Errorf("invalid status: can only dispute completed contracts")
+	// Can only dispute completed contracts
+	if err := ConsultationTransitions.ValidateTransition(string(contract.Status), string(StatusDisputed)); err != nil {
+		return nil, fmt.Errorf("invalid status: %w", err)
+	}
+
+	now := time.Now()
+	if contract.ReleaseDeadline != nil && now.After(*contract.ReleaseDeadline) {
+		return nil, fmt.Errorf("dispute window closed at %s", contract.ReleaseDeadline.Format(time.RFC3339))
+	}
+
+	if contract.EscrowBalance == 0 && contract.HoldbackBalance == 0 {
+		return nil, fmt.Errorf("escrow already released to professional")
+	}
+
+	// Record evidence before changing status so invalid evidence leaves the contract untouched
+	if len(evidence) > 0 {
+		if _, err := contract.appendEvidence(citizenDID, evidence); err != nil {
+			return nil, fmt.Errorf("invalid dispute evidence: %w", err)
+		}
 	}
 
 	// Update status
-	contract.Status = StatusDisputed
+	if err := contract.transitionTo(StatusDisputed); err != nil {
+		return nil, err
+	}
+
 	contract.DisputeReason = disputeReason
 
 	fmt.Printf("⚠️  Dispute Raised\n")
@@ -324,7 +663,7 @@ func (csc *ConsultationSmartContract) RaiseDispute(
 		Status:        StatusDisputed,
 		Message:       fmt.Sprintf("Dispute raised: %s", disputeReason),
 		EscrowBalance: contract.EscrowBalance,
-		Timestamp:     time.Now(),
+		Timestamp:     now,
 	}, nil
 }
 
@@ -347,19 +686,23 @@ func (csc *ConsultationSmartContract) CancelContract(
 		return nil, fmt.Errorf("unauthorized: only contract citizen can cancel")
 	}
 
-	// Validate status (can only cancel pending contracts)
-	if contract.Status != StatusPending {
-		return nil, fmt.Errorf("invalid status: can only cancel pending contracts")
+	// Validate status before refunding (only pending contracts can be cancelled)
+	if err := ConsultationTransitions.ValidateTransition(string(contract.Status), string(StatusRefunded)); err != nil {
+		return nil, fmt.Errorf("invalid status: %w", err)
 	}
 
 	// Refund citizen
-	_, err := csc.walletManager.CreditRegular(ctx, citizenDID, contract.EscrowBalance, "consultation_refund")
+	txID, err := csc.walletManager.CreditRegular(ctx, citizenDID, contract.EscrowBalance, "consultation_refund", contract.ContractID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refund citizen: %w", err)
 	}
 
+	csc.recordEscrowAudit(audit.EscrowActionRefund, contractID, citizenDID, contract.EscrowBalance, txID)
+
 	// Update contract
-	contract.Status = StatusRefunded
+	if err := contract.transitionTo(StatusRefunded); err != nil {
+		return nil, err
+	}
 	contract.EscrowBalance = 0
 
 	return &ConsultationResult{
@@ -384,33 +727,22 @@ func (csc *ConsultationSmartContract) GetContract(ctx context.Context, contractI
 	return contract, nil
 }
 
-// GetCitizenContracts retrieves all contracts for a citizen
+// GetCitizenContracts retrieves all contracts for a citizen (most recent first)
 func (csc *ConsultationSmartContract) GetCitizenContracts(ctx context.Context, citizenDID string) ([]*ConsultationContract, error) {
-	csc.mu.RLock()
-	defer csc.mu.RUnlock()
-
-	var contracts []*ConsultationContract
-	for _, contract := range csc.contracts {
-		if contract.CitizenDID == citizenDID {
-			contracts = append(contracts, contract)
-		}
+	page, err := csc.ListCitizenContracts(ctx, citizenDID, ContractListOptions{})
+	if err != nil {
+		return nil, err
 	}
 
-	return contracts, nil
+	return page.Contracts, nil
 }
 
-// GetProfessionalContracts retrieves all contracts for a professional
+// GetProfessionalContracts retrieves all contracts for a professional (most recent first)
 func (csc *ConsultationSmartContract) GetProfessionalContracts(ctx context.Context, professionalDID string) ([]*ConsultationContract, error) {
-	csc.mu.RLock()
-	defer csc.mu.RUnlock()
-
-	var contracts []*ConsultationContract
-	for _, contract := range csc.contracts {
-		if contract.ProfessionalDID == professionalDID {
-			contracts = append(contracts, contract)
-		}
+	page, err := csc.ListProfessionalContracts(ctx, professionalDID, ContractListOptions{})
+	if err != nil {
+		return nil, err
 	}
 
-	return contracts, nil
+	return page.Contracts, nil
 }
-
@@ -0,0 +1,216 @@
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testWalletManager is an in-memory WalletManager keyed by DID
+type testWalletManager struct {
+	balances map[string]int64
+	txCount  int
+	mu       sync.Mutex
+}
+
+func newTestWalletManager() *testWalletManager {
+	return &testWalletManager{balances: make(map[string]int64)}
+}
+
+func (tw *testWalletManager) DebitRegular(ctx context.Context, userID string, amount int64, purpose string, reference string) (string, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	if tw.balances[userID] < amount {
+		return "", fmt.Errorf("insufficient balance: have %d uSOV, need %d uSOV", tw.balances[userID], amount)
+	}
+	tw.balances[userID] -= amount
+	tw.txCount++
+	return fmt.Sprintf("tx-%d", tw.txCount), nil
+}
+
+func (tw *testWalletManager) CreditRegular(ctx context.Context, userID string, amount int64, purpose string, reference string) (string, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	tw.balances[userID] += amount
+	tw.txCount++
+	return fmt.Sprintf("tx-%d", tw.txCount), nil
+}
+
+func (tw *testWalletManager) balance(userID string) int64 {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+
+	return tw.balances[userID]
+}
+
+// testProfessional returns a licensed lawyer at the lowest tier
+func testProfessional(t *testing.T) *CertifiedProfessional {
+	t.Helper()
+
+	tiers := GetProfessionalTiers()[RoleLawyer]
+	if len(tiers) == 0 {
+		t.Fatal("no lawyer tiers defined")
+	}
+
+	return &CertifiedProfessional{
+		ProfessionalID: "prof-1",
+		DID:            FormatProfessionalDID("ng", RoleLawyer, "prof-1"),
+		Role:           RoleLawyer,
+		LicenseExpiry:  time.Now().Add(365 * 24 * time.Hour),
+		IsActive:       true,
+		AccessLevel:    tiers[0].AccessLevel,
+	}
+}
+
+const testCitizenDID = "did:sovra:ng:citizen-1"
+
+func newTestConsultations(t *testing.T) (*ConsultationSmartContract, *testWalletManager, *CertifiedProfessional) {
+	t.Helper()
+
+	wallets := newTestWalletManager()
+	csc := NewConsultationSmartContract(wallets, NewMockSignatureVerifier(), DefaultAutoReleaseDelay)
+	return csc, wallets, testProfessional(t)
+}
+
+func TestConsultationEscrowReleasedOnConfirmation(t *testing.T) {
+	ctx := context.Background()
+	csc, wallets, professional := newTestConsultations(t)
+	wallets.balances[testCitizenDID] = 1_000_000_000
+
+	contract, err := csc.HireProfessional(ctx, testCitizenDID, professional.DID, professional, "Legal advice", "Contract review", "")
+	if err != nil {
+		t.Fatalf("HireProfessional: %v", err)
+	}
+	if contract.EscrowBalance != contract.Fee || contract.Status != StatusPending {
+		t.Fatalf("new contract escrow = %d status = %q, want %d pending", contract.EscrowBalance, contract.Status, contract.Fee)
+	}
+	if got := wallets.balance(testCitizenDID); got != 1_000_000_000-contract.Fee {
+		t.Fatalf("citizen balance after hire = %d, want %d", got, 1_000_000_000-contract.Fee)
+	}
+
+	if _, err := csc.StartConsultation(ctx, contract.ContractID, professional.DID); err != nil {
+		t.Fatalf("StartConsultation: %v", err)
+	}
+	if _, err := csc.DeliverService(ctx, contract.ContractID, professional.DID, "doc-hash"); err != nil {
+		t.Fatalf("DeliverService: %v", err)
+	}
+
+	// Delivery alone holds the escrow
+	if got := wallets.balance(professional.DID); got != 0 {
+		t.Fatalf("professional paid %d before confirmation", got)
+	}
+
+	// A confirmation signed by someone else is rejected
+	forged := NewMockSignatureVerifier().Sign("did:sovra:ng:other", AcceptanceMessage(contract.ContractID, "doc-hash"))
+	if _, err := csc.ConfirmDelivery(ctx, contract.ContractID, testCitizenDID, forged); err == nil {
+		t.Fatal("ConfirmDelivery accepted a forged signature")
+	}
+
+	signature := NewMockSignatureVerifier().Sign(testCitizenDID, AcceptanceMessage(contract.ContractID, "doc-hash"))
+	result, err := csc.ConfirmDelivery(ctx, contract.ContractID, testCitizenDID, signature)
+	if err != nil {
+		t.Fatalf("ConfirmDelivery: %v", err)
+	}
+	if result.EscrowBalance != 0 {
+		t.Errorf("escrow after confirmation = %d, want 0", result.EscrowBalance)
+	}
+	if got := wallets.balance(professional.DID); got != contract.Fee {
+		t.Errorf("professional balance = %d, want %d", got, contract.Fee)
+	}
+}
+
+func TestConsultationCancelRefundsPendingOnly(t *testing.T) {
+	ctx := context.Background()
+	csc, wallets, professional := newTestConsultations(t)
+	wallets.balances[testCitizenDID] = 1_000_000_000
+
+	pending, err := csc.HireProfessional(ctx, testCitizenDID, professional.DID, professional, "Legal advice", "Will", "")
+	if err != nil {
+		t.Fatalf("HireProfessional: %v", err)
+	}
+	started, err := csc.HireProfessional(ctx, testCitizenDID, professional.DID, professional, "Legal advice", "Lease", "")
+	if err != nil {
+		t.Fatalf("HireProfessional: %v", err)
+	}
+	if _, err := csc.StartConsultation(ctx, started.ContractID, professional.DID); err != nil {
+		t.Fatalf("StartConsultation: %v", err)
+	}
+	balance := wallets.balance(testCitizenDID)
+
+	// Only the contract citizen can cancel
+	if _, err := csc.CancelContract(ctx, pending.ContractID, "did:sovra:ng:other"); err == nil {
+		t.Fatal("CancelContract by another DID succeeded")
+	}
+
+	result, err := csc.CancelContract(ctx, pending.ContractID, testCitizenDID)
+	if err != nil {
+		t.Fatalf("CancelContract: %v", err)
+	}
+	if result.Status != StatusRefunded || result.EscrowBalance != 0 {
+		t.Errorf("cancel result status = %q escrow = %d, want refunded 0", result.Status, result.EscrowBalance)
+	}
+	if got := wallets.balance(testCitizenDID); got != balance+pending.Fee {
+		t.Errorf("citizen balance after cancel = %d, want %d", got, balance+pending.Fee)
+	}
+
+	// Started and already-refunded contracts cannot be cancelled (no second refund)
+	for _, contractID := range []string{started.ContractID, pending.ContractID} {
+		if _, err := csc.CancelContract(ctx, contractID, testCitizenDID); err == nil {
+			t.Errorf("CancelContract(%s) succeeded", contractID)
+		}
+	}
+	if got := wallets.balance(testCitizenDID); got != balance+pending.Fee {
+		t.Errorf("citizen balance after rejected cancels = %d, want %d", got, balance+pending.Fee)
+	}
+}
+
+func TestConsultationFeeOverride(t *testing.T) {
+	ctx := context.Background()
+	csc, wallets, professional := newTestConsultations(t)
+	wallets.balances[testCitizenDID] = 1_000_000_000
+
+	tier, err := professional.GetTier()
+	if err != nil {
+		t.Fatalf("GetTier: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		actor   string
+		fee     int64
+		wantErr bool
+	}{
+		{"citizen cannot override", testCitizenDID, tier.ConsultationFee * 2, true},
+		{"below tier fee", professional.DID, tier.ConsultationFee - 1, true},
+		{"professional raises fee", professional.DID, tier.ConsultationFee * 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := csc.SetFeeOverride(tt.actor, professional, testCitizenDID, tt.fee)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetFeeOverride error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	// The override prices the next hire only
+	first, err := csc.HireProfessional(ctx, testCitizenDID, professional.DID, professional, "Legal advice", "Merger", "")
+	if err != nil {
+		t.Fatalf("HireProfessional: %v", err)
+	}
+	if first.Fee != tier.ConsultationFee*2 {
+		t.Errorf("first hire fee = %d, want override %d", first.Fee, tier.ConsultationFee*2)
+	}
+
+	second, err := csc.HireProfessional(ctx, testCitizenDID, professional.DID, professional, "Legal advice", "Follow-up", "")
+	if err != nil {
+		t.Fatalf("HireProfessional: %v", err)
+	}
+	if second.Fee != tier.ConsultationFee {
+		t.Errorf("second hire fee = %d, want tier fee %d", second.Fee, tier.ConsultationFee)
+	}
+}
@@ -0,0 +1,54 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Dispute Window Trigger
+//
+// Held escrow becomes claimable once the dispute window closes. Operators
+// differ on when that window opens: at DeliverService (the professional's
+// claim of delivery) or at ConfirmDelivery (the citizen's acknowledgment).
+
+package access_control
+
+import (
+	"fmt"
+	"time"
+)
+
+// DisputeWindowTrigger selects the event that opens the dispute window
+type DisputeWindowTrigger string
+
+const (
+	// DisputeWindowFromDelivery opens the window when the professional delivers (default)
+	DisputeWindowFromDelivery DisputeWindowTrigger = "delivery"
+
+	// DisputeWindowFromConfirmation opens the window when the citizen (or first signer) confirms
+	// Escrow for unconfirmed deliveries is never claimable by the window alone.
+	DisputeWindowFromConfirmation DisputeWindowTrigger = "confirmation"
+)
+
+// SetDisputeWindow configures when the dispute window opens and how long it lasts
+func (csc *ConsultationSmartContract) SetDisputeWindow(trigger DisputeWindowTrigger, window time.Duration) error {
+	if trigger != DisputeWindowFromDelivery && trigger != DisputeWindowFromConfirmation {
+		return fmt.Errorf("invalid dispute window trigger: %s", trigger)
+	}
+	if window <= 0 {
+		return fmt.Errorf("dispute window must be positive")
+	}
+
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.disputeWindowTrigger = trigger
+	csc.disputeWindow = window
+
+	return nil
+}
+
+// openDisputeWindowLocked starts the contract's dispute window if the event matches the trigger (caller holds csc.mu)
+// The window opens once; later events of the same kind do not extend it.
+func (csc *ConsultationSmartContract) openDisputeWindowLocked(contract *ConsultationContract, event DisputeWindowTrigger, at time.Time) {
+	if event != csc.disputeWindowTrigger || contract.ReleaseDeadline != nil {
+		return
+	}
+
+	deadline := at.Add(csc.disputeWindow)
+	contract.ReleaseDeadline = &deadline
+}
@@ -0,0 +1,135 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Dispute Evidence
+//
+// Both parties to a disputed consultation can attach evidence references
+// (content hashes of delivered documents, communications) for the arbitrator.
+// Evidence is append-only: once submitted it cannot be edited or withdrawn.
+
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EvidenceParty identifies which side of the contract submitted evidence
+type EvidenceParty string
+
+const (
+	EvidencePartyCitizen      EvidenceParty = "citizen"
+	EvidencePartyProfessional EvidenceParty = "professional"
+)
+
+// EvidenceReference is a piece of evidence offered by a party
+type EvidenceReference struct {
+	ContentHash string `json:"content_hash"` // Hash of the evidence document (content stays off-hub)
+	Description string `json:"description"`
+}
+
+// DisputeEvidence is a recorded, immutable evidence entry
+type DisputeEvidence struct {
+	EvidenceID  string        `json:"evidence_id"`
+	SubmittedBy string        `json:"submitted_by"`
+	Party       EvidenceParty `json:"party"`
+	ContentHash string        `json:"content_hash"`
+	Description string        `json:"description"`
+	SubmittedAt time.Time     `json:"submitted_at"`
+}
+
+// SubmitDisputeEvidence attaches evidence to a disputed contract
+//
+// EVIDENCE LOGIC:
+// 1. Only the contract citizen or professional can submit
+// 2. Contract must be disputed
+// 3. Each reference needs a content hash; a party cannot submit the same hash twice
+// 4. Entries are appended and never modified
+func (csc *ConsultationSmartContract) SubmitDisputeEvidence(
+	ctx context.Context,
+	contractID string,
+	submitterDID string,
+	evidence []EvidenceReference,
+) ([]DisputeEvidence, error) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	contract, exists := csc.contracts[contractID]
+	if !exists {
+		return nil, fmt.Errorf("contract not found: %s", contractID)
+	}
+
+	if contract.Status != StatusDisputed {
+		return nil, fmt.Errorf("invalid status: evidence can only be submitted for disputed contracts")
+	}
+
+	return contract.appendEvidence(submitterDID, evidence)
+}
+
+// GetDisputeEvidence returns a copy of the evidence recorded on a contract
+func (csc *ConsultationSmartContract) GetDisputeEvidence(ctx context.Context, contractID string) ([]DisputeEvidence, error) {
+	csc.mu.RLock()
+	defer csc.mu.RUnlock()
+
+	contract, exists := csc.contracts[contractID]
+	if !exists {
+		return nil, fmt.Errorf("contract not found: %s", contractID)
+	}
+
+	evidence := make([]DisputeEvidence, len(contract.DisputeEvidence))
+	copy(evidence, contract.DisputeEvidence)
+
+	return evidence, nil
+}
+
+// appendEvidence validates and records evidence from one party (caller holds csc.mu)
+func (c *ConsultationContract) appendEvidence(submitterDID string, evidence []EvidenceReference) ([]DisputeEvidence, error) {
+	var party EvidenceParty
+	switch submitterDID {
+	case c.CitizenDID:
+		party = EvidencePartyCitizen
+	case c.ProfessionalDID:
+		party = EvidencePartyProfessional
+	default:
+		return nil, fmt.Errorf("unauthorized: only contract parties can submit evidence")
+	}
+
+	if len(evidence) == 0 {
+		return nil, fmt.Errorf("at least one evidence reference required")
+	}
+
+	// Validate the whole batch before recording anything
+	seen := make(map[string]bool)
+	for _, existing := range c.DisputeEvidence {
+		if existing.Party == party {
+			seen[existing.ContentHash] = true
+		}
+	}
+	for _, ref := range evidence {
+		if ref.ContentHash == "" {
+			return nil, fmt.Errorf("evidence content hash required")
+		}
+		if seen[ref.ContentHash] {
+			return nil, fmt.Errorf("evidence already submitted: %s", ref.ContentHash)
+		}
+		seen[ref.ContentHash] = true
+	}
+
+	now := time.Now()
+	recorded := make([]DisputeEvidence, 0, len(evidence))
+	for _, ref := range evidence {
+		entry := DisputeEvidence{
+			EvidenceID:  uuid.New().String(),
+			SubmittedBy: submitterDID,
+			Party:       party,
+			ContentHash: ref.ContentHash,
+			Description: ref.Description,
+			SubmittedAt: now,
+		}
+		c.DisputeEvidence = append(c.DisputeEvidence, entry)
+		recorded = append(recorded, entry)
+	}
+
+	return recorded, nil
+}
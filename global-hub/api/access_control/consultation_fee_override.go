@@ -0,0 +1,88 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Consultation Fee Overrides
+//
+// A citizen always pays at least the professional's own tier fee. Only the
+// professional (or an authorized fee operator) can quote a different fee for
+// a citizen, and only at or above that tier fee; the quote is used by the
+// citizen's next hire and then discarded.
+
+package access_control
+
+import (
+	"fmt"
+)
+
+// AuthorizeFeeOperator allows a DID to set fee overrides for any professional
+func (csc *ConsultationSmartContract) AuthorizeFeeOperator(operatorDID string) error {
+	if operatorDID == "" {
+		return fmt.Errorf("operator DID required")
+	}
+
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.feeOperators[operatorDID] = true
+	return nil
+}
+
+// RevokeFeeOperator removes a DID's authority to set fee overrides
+func (csc *ConsultationSmartContract) RevokeFeeOperator(operatorDID string) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	delete(csc.feeOperators, operatorDID)
+}
+
+// SetFeeOverride quotes a fee for a citizen's next hire of a professional
+//
+// OVERRIDE LOGIC:
+// 1. Only the professional themselves or an authorized fee operator may set it
+// 2. The fee cannot be below the professional's own tier fee
+// 3. A zero fee clears the override (the tier fee applies)
+// 4. The override is consumed by the citizen's next successful hire
+func (csc *ConsultationSmartContract) SetFeeOverride(
+	actorDID string,
+	professional *CertifiedProfessional,
+	citizenDID string,
+	fee int64,
+) error {
+	if citizenDID == "" {
+		return fmt.Errorf("citizen DID required")
+	}
+	if fee < 0 {
+		return fmt.Errorf("fee override cannot be negative")
+	}
+
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	// 1. Authorize the actor
+	if actorDID != professional.DID && !csc.feeOperators[actorDID] {
+		return fmt.Errorf("unauthorized: only the professional or a fee operator can override fees")
+	}
+
+	key := feeOverrideKey(professional.DID, citizenDID)
+
+	// 3. Clear
+	if fee == 0 {
+		delete(csc.feeOverrides, key)
+		return nil
+	}
+
+	// 2. Floor at the professional's tier fee
+	tierFee, _, err := csc.tierFeeLocked(professional)
+	if err != nil {
+		return err
+	}
+	if fee < tierFee {
+		return fmt.Errorf("fee override %d uSOV is below %s's tier fee of %d uSOV", fee, professional.DID, tierFee)
+	}
+
+	csc.feeOverrides[key] = fee
+	return nil
+}
+
+// feeOverrideKey returns the override map key for a professional/citizen pair
+func feeOverrideKey(professionalDID string, citizenDID string) string {
+	return professionalDID + "|" + citizenDID
+}
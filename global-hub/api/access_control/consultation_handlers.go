@@ -0,0 +1,371 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Consultation HTTP Handlers
+//
+// REST endpoints for the consultation smart contract lifecycle after hiring:
+// start, deliver, confirm, dispute, cancel, and paginated listings by citizen
+// or professional. Hiring is served by AccessControlHandlers.HandleHireProfessional.
+
+package access_control
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ConsultationHandlers provides HTTP endpoints for consultation contracts
+type ConsultationHandlers struct {
+	contract *ConsultationSmartContract
+}
+
+// NewConsultationHandlers creates new consultation HTTP handlers
+func NewConsultationHandlers(contract *ConsultationSmartContract) *ConsultationHandlers {
+	return &ConsultationHandlers{
+		contract: contract,
+	}
+}
+
+// RegisterRoutes registers all consultation routes
+func (h *ConsultationHandlers) RegisterRoutes(mux *http.ServeMux) {
+	// Lifecycle (hire is AccessControlHandlers.HandleHireProfessional)
+	mux.HandleFunc("/v1/access-control/consultation/start", h.HandleStart)
+	mux.HandleFunc("/v1/access-control/consultation/deliver", h.HandleDeliver)
+	mux.HandleFunc("/v1/access-control/consultation/confirm", h.HandleConfirm)
+	mux.HandleFunc("/v1/access-control/consultation/dispute", h.HandleDispute)
+	mux.HandleFunc("/v1/access-control/consultation/cancel", h.HandleCancel)
+
+	// Queries
+	mux.HandleFunc("/v1/access-control/consultation/get", h.HandleGetContract)
+	mux.HandleFunc("/v1/access-control/consultation/citizen", h.HandleGetCitizenContracts)
+	mux.HandleFunc("/v1/access-control/consultation/professional", h.HandleGetProfessionalContracts)
+	mux.HandleFunc("/v1/access-control/consultation/archive/get", h.HandleGetArchivedContract)
+	mux.HandleFunc("/v1/access-control/consultation/archive/party", h.HandleGetArchivedContracts)
+}
+
+// HandleStart handles POST /v1/access-control/consultation/start
+func (h *ConsultationHandlers) HandleStart(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		ContractID      string `json:"contract_id"`
+		ProfessionalDID string `json:"professional_did"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	result, err := h.contract.StartConsultation(ctx, req.ContractID, req.ProfessionalDID)
+	if err != nil {
+		writeConsultationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// HandleDeliver handles POST /v1/access-control/consultation/deliver
+func (h *ConsultationHandlers) HandleDeliver(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		ContractID      string `json:"contract_id"`
+		ProfessionalDID string `json:"professional_did"`
+		DeliveryProof   string `json:"delivery_proof"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	result, err := h.contract.DeliverService(ctx, req.ContractID, req.ProfessionalDID, req.DeliveryProof)
+	if err != nil {
+		writeConsultationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// HandleConfirm handles POST /v1/access-control/consultation/confirm
+func (h *ConsultationHandlers) HandleConfirm(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		ContractID       string `json:"contract_id"`
+		CitizenDID       string `json:"citizen_did"`
+		CitizenSignature []byte `json:"citizen_signature"` // Base64-encoded
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	result, err := h.contract.ConfirmDelivery(ctx, req.ContractID, req.CitizenDID, req.CitizenSignature)
+	if err != nil {
+		writeConsultationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// HandleDispute handles POST /v1/access-control/consultation/dispute
+func (h *ConsultationHandlers) HandleDispute(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		ContractID string              `json:"contract_id"`
+		CitizenDID string              `json:"citizen_did"`
+		Reason     string              `json:"reason"`
+		Evidence   []EvidenceReference `json:"evidence,omitempty"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	result, err := h.contract.RaiseDispute(ctx, req.ContractID, req.CitizenDID, req.Reason, req.Evidence...)
+	if err != nil {
+		writeConsultationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// HandleCancel handles POST /v1/access-control/consultation/cancel
+func (h *ConsultationHandlers) HandleCancel(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		ContractID string `json:"contract_id"`
+		CitizenDID string `json:"citizen_did"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	result, err := h.contract.CancelContract(ctx, req.ContractID, req.CitizenDID)
+	if err != nil {
+		writeConsultationError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// HandleGetContract handles GET /v1/access-control/consultation/get?contract_id=xxx
+func (h *ConsultationHandlers) HandleGetContract(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	contractID := r.URL.Query().Get("contract_id")
+	if contractID == "" {
+		http.Error(w, "contract_id query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	contract, err := h.contract.GetContract(ctx, contractID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(contract)
+}
+
+// HandleGetCitizenContracts handles GET /v1/access-control/consultation/citizen?citizen_did=xxx&status=completed&limit=20&offset=0&sort_by=created_at
+func (h *ConsultationHandlers) HandleGetCitizenContracts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	citizenDID := r.URL.Query().Get("citizen_did")
+	if citizenDID == "" {
+		http.Error(w, "citizen_did query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	opts, err := parseContractListOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	page, err := h.contract.ListCitizenContracts(ctx, citizenDID, opts)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(page)
+}
+
+// HandleGetProfessionalContracts handles GET /v1/access-control/consultation/professional?professional_did=xxx&status=completed&limit=20&offset=0&sort_by=created_at
+func (h *ConsultationHandlers) HandleGetProfessionalContracts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	professionalDID := r.URL.Query().Get("professional_did")
+	if professionalDID == "" {
+		http.Error(w, "professional_did query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	opts, err := parseContractListOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	page, err := h.contract.ListProfessionalContracts(ctx, professionalDID, opts)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(page)
+}
+
+// HandleGetArchivedContract handles GET /v1/access-control/consultation/archive/get?contract_id=xxx
+func (h *ConsultationHandlers) HandleGetArchivedContract(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	contractID := r.URL.Query().Get("contract_id")
+	if contractID == "" {
+		http.Error(w, "contract_id query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	contract, err := h.contract.GetArchivedContract(ctx, contractID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(contract)
+}
+
+// HandleGetArchivedContracts handles GET /v1/access-control/consultation/archive/party?did=xxx
+func (h *ConsultationHandlers) HandleGetArchivedContracts(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	did := r.URL.Query().Get("did")
+	if did == "" {
+		http.Error(w, "did query parameter is required", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	contracts, err := h.contract.GetArchivedContracts(ctx, did)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(contracts)
+}
+
+// parseContractListOptions reads status (comma-separated), limit, offset and sort_by query parameters
+func parseContractListOptions(r *http.Request) (ContractListOptions, error) {
+	query := r.URL.Query()
+	opts := ContractListOptions{
+		SortBy: ContractSortField(query.Get("sort_by")),
+	}
+
+	if opts.SortBy != "" && opts.SortBy != SortByCreatedAt && opts.SortBy != SortByCompletedAt {
+		return opts, fmt.Errorf("invalid sort_by: %s", opts.SortBy)
+	}
+
+	if status := query.Get("status"); status != "" {
+		for _, s := range strings.Split(status, ",") {
+			opts.StatusFilter = append(opts.StatusFilter, ConsultationStatus(strings.TrimSpace(s)))
+		}
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 0 {
+			return opts, fmt.Errorf("invalid limit: %s", limit)
+		}
+		opts.Limit = n
+	}
+
+	if offset := query.Get("offset"); offset != "" {
+		n, err := strconv.Atoi(offset)
+		if err != nil || n < 0 {
+			return opts, fmt.Errorf("invalid offset: %s", offset)
+		}
+		opts.Offset = n
+	}
+
+	return opts, nil
+}
+
+// writeConsultationError maps contract errors to status codes
+// Missing contracts are 404, party mismatches 403, everything else 400.
+func writeConsultationError(w http.ResponseWriter, err error) {
+	msg := err.Error()
+	switch {
+	case strings.HasPrefix(msg, "contract not found"):
+		http.Error(w, msg, http.StatusNotFound)
+	case strings.HasPrefix(msg, "unauthorized"):
+		http.Error(w, msg, http.StatusForbidden)
+	default:
+		http.Error(w, msg, http.StatusBadRequest)
+	}
+}
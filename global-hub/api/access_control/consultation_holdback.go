@@ -0,0 +1,83 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Professional Payout Holdback
+//
+// Optional risk-management retention: a percentage of each consultation
+// payout is withheld for a holdback period and released by a sweep once the
+// window passes without a dispute.
+
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/audit"
+)
+
+// MaxHoldbackBasisPoints is 100% expressed in basis points
+const MaxHoldbackBasisPoints = 10_000
+
+// SetPayoutHoldback retains basisPoints/10000 of each payout for the given period (0 disables)
+func (csc *ConsultationSmartContract) SetPayoutHoldback(basisPoints int64, period time.Duration) error {
+	if basisPoints < 0 || basisPoints > MaxHoldbackBasisPoints {
+		return fmt.Errorf("holdback must be between 0 and %d basis points, got %d", MaxHoldbackBasisPoints, basisPoints)
+	}
+	if basisPoints > 0 && period <= 0 {
+		return fmt.Errorf("holdback period must be positive")
+	}
+
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.holdbackBasisPoints = basisPoints
+	csc.holdbackPeriod = period
+
+	return nil
+}
+
+// ReleaseHoldback pays out withheld amounts whose holdback window has passed
+// Disputed contracts keep their holdback until the dispute is resolved.
+// Returns the IDs of the contracts whose holdback was released.
+func (csc *ConsultationSmartContract) ReleaseHoldback(ctx context.Context) ([]string, error) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	now := time.Now()
+	released := make([]string, 0)
+
+	for _, contract := range csc.contracts {
+		if contract.HoldbackBalance == 0 || contract.Status != StatusCompleted {
+			continue
+		}
+
+		if contract.HoldbackReleaseAt == nil || now.Before(*contract.HoldbackReleaseAt) {
+			continue
+		}
+
+		txID, err := csc.walletManager.CreditRegular(ctx, contract.ProfessionalDID, contract.HoldbackBalance, "consultation_holdback_release", contract.ContractID)
+		if err != nil {
+			return released, fmt.Errorf("failed to release holdback for contract %s: %w", contract.ContractID, err)
+		}
+
+		csc.recordEscrowAudit(audit.EscrowActionRelease, contract.ContractID, contract.ProfessionalDID, contract.HoldbackBalance, txID)
+
+		contract.ReleasedAmount += contract.HoldbackBalance
+		contract.HoldbackBalance = 0
+		contract.HoldbackReleaseAt = nil
+
+		released = append(released, contract.ContractID)
+	}
+
+	return released, nil
+}
+
+// splitHoldbackLocked splits an escrow amount into immediate payout and holdback (caller holds csc.mu)
+func (csc *ConsultationSmartContract) splitHoldbackLocked(amount int64) (payout int64, holdback int64) {
+	if csc.holdbackBasisPoints == 0 {
+		return amount, 0
+	}
+
+	holdback = amount * csc.holdbackBasisPoints / MaxHoldbackBasisPoints
+	return amount - holdback, holdback
+}
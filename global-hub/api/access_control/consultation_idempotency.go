@@ -0,0 +1,92 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Hire Idempotency
+//
+// Clients retrying HireProfessional after a timeout pass the same idempotency
+// key and get the original contract back instead of a second escrow debit.
+// Keys are scoped per citizen DID and expire after a configurable window.
+
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultIdempotencyWindow is how long a hire idempotency key is remembered
+const DefaultIdempotencyWindow = 24 * time.Hour
+
+// hireIdempotencyEntry maps an idempotency key to the contract it created
+type hireIdempotencyEntry struct {
+	ContractID      string
+	ProfessionalDID string
+	ExpiresAt       time.Time
+}
+
+// SetIdempotencyWindow configures how long hire idempotency keys are remembered
+func (csc *ConsultationSmartContract) SetIdempotencyWindow(window time.Duration) error {
+	if window <= 0 {
+		return fmt.Errorf("idempotency window must be positive")
+	}
+
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.idempotencyWindow = window
+
+	return nil
+}
+
+// idempotentHireLocked returns the contract previously created with this key, if any (caller holds csc.mu)
+// Reusing a key for a different professional is rejected. Keyed hires are never
+// rolled back, so a contract missing from the active set was archived and is
+// returned from the archive; if the archive cannot produce it the retry fails
+// rather than debiting the citizen a second time.
+func (csc *ConsultationSmartContract) idempotentHireLocked(ctx context.Context, citizenDID string, professionalDID string, key string, now time.Time) (*ConsultationContract, error) {
+	scopedKey := idempotencyScope(citizenDID, key)
+
+	entry, exists := csc.idempotencyKeys[scopedKey]
+	if !exists {
+		return nil, nil
+	}
+
+	if !now.Before(entry.ExpiresAt) {
+		delete(csc.idempotencyKeys, scopedKey)
+		return nil, nil
+	}
+
+	if entry.ProfessionalDID != professionalDID {
+		return nil, fmt.Errorf("idempotency key %q already used for a different professional", key)
+	}
+
+	if contract, exists := csc.contracts[entry.ContractID]; exists {
+		return contract, nil
+	}
+
+	contract, err := csc.archive.Get(ctx, entry.ContractID)
+	if err != nil {
+		return nil, fmt.Errorf("idempotency key %q refers to contract %s, which could not be loaded: %w", key, entry.ContractID, err)
+	}
+
+	return contract, nil
+}
+
+// recordIdempotentHireLocked remembers the contract created with a key and drops expired keys (caller holds csc.mu)
+func (csc *ConsultationSmartContract) recordIdempotentHireLocked(contract *ConsultationContract, key string, now time.Time) {
+	for scopedKey, entry := range csc.idempotencyKeys {
+		if !now.Before(entry.ExpiresAt) {
+			delete(csc.idempotencyKeys, scopedKey)
+		}
+	}
+
+	csc.idempotencyKeys[idempotencyScope(contract.CitizenDID, key)] = hireIdempotencyEntry{
+		ContractID:      contract.ContractID,
+		ProfessionalDID: contract.ProfessionalDID,
+		ExpiresAt:       now.Add(csc.idempotencyWindow),
+	}
+}
+
+// idempotencyScope namespaces a client key by citizen DID
+func idempotencyScope(citizenDID string, key string) string {
+	return citizenDID + "|" + key
+}
@@ -0,0 +1,120 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Contract Listing
+//
+// Paginated, filtered contract listings for citizens and professionals.
+// Results are sorted most-recent-first with a deterministic tie-break so
+// pages stay stable between calls.
+
+package access_control
+
+import (
+	"context"
+	"sort"
+)
+
+// ContractSortField selects the timestamp contracts are ordered by
+type ContractSortField string
+
+const (
+	SortByCreatedAt   ContractSortField = "created_at"   // Default
+	SortByCompletedAt ContractSortField = "completed_at" // Undelivered contracts sort last
+)
+
+// ContractListOptions filters and paginates a contract listing
+type ContractListOptions struct {
+	StatusFilter []ConsultationStatus // Empty = all statuses
+	Limit        int                  // 0 = no limit
+	Offset       int
+	SortBy       ContractSortField // Empty = SortByCreatedAt
+}
+
+// ContractPage is one page of a contract listing
+type ContractPage struct {
+	Contracts []*ConsultationContract `json:"contracts"`
+	Total     int                     `json:"total"` // Matching contracts across all pages
+	Limit     int                     `json:"limit"`
+	Offset    int                     `json:"offset"`
+}
+
+// ListCitizenContracts returns a page of a citizen's contracts
+func (csc *ConsultationSmartContract) ListCitizenContracts(ctx context.Context, citizenDID string, opts ContractListOptions) (*ContractPage, error) {
+	return csc.listContracts(opts, func(contract *ConsultationContract) bool {
+		return contract.CitizenDID == citizenDID
+	}), nil
+}
+
+// ListProfessionalContracts returns a page of a professional's contracts
+func (csc *ConsultationSmartContract) ListProfessionalContracts(ctx context.Context, professionalDID string, opts ContractListOptions) (*ContractPage, error) {
+	return csc.listContracts(opts, func(contract *ConsultationContract) bool {
+		return contract.ProfessionalDID == professionalDID
+	}), nil
+}
+
+// listContracts filters, sorts (most recent first) and paginates contracts
+func (csc *ConsultationSmartContract) listContracts(opts ContractListOptions, match func(*ConsultationContract) bool) *ContractPage {
+	csc.mu.RLock()
+	defer csc.mu.RUnlock()
+
+	matched := make([]*ConsultationContract, 0)
+	for _, contract := range csc.contracts {
+		if !match(contract) {
+			continue
+		}
+		if len(opts.StatusFilter) > 0 && !containsStatus(opts.StatusFilter, contract.Status) {
+			continue
+		}
+		matched = append(matched, contract)
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		a, b := matched[i], matched[j]
+
+		if opts.SortBy == SortByCompletedAt {
+			switch {
+			case a.CompletedAt == nil && b.CompletedAt != nil:
+				return false
+			case a.CompletedAt != nil && b.CompletedAt == nil:
+				return true
+			case a.CompletedAt != nil && !a.CompletedAt.Equal(*b.CompletedAt):
+				return a.CompletedAt.After(*b.CompletedAt)
+			}
+		}
+
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ContractID < b.ContractID
+	})
+
+	page := &ContractPage{
+		Total:  len(matched),
+		Limit:  opts.Limit,
+		Offset: opts.Offset,
+	}
+
+	start := opts.Offset
+	if start < 0 {
+		start = 0
+	}
+	if start > len(matched) {
+		start = len(matched)
+	}
+
+	end := len(matched)
+	if opts.Limit > 0 && start+opts.Limit < end {
+		end = start + opts.Limit
+	}
+
+	page.Contracts = matched[start:end]
+	return page
+}
+
+// containsStatus checks if a status is in the filter list
+func containsStatus(statuses []ConsultationStatus, status ConsultationStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Multi-Signature Consultation Release
+//
+// Optional dual-control release for high-value consultations. When configured,
+// delivery no longer auto-releases escrow: N-of-M citizen-side signers must
+// confirm first, or the dispute window must pass without a dispute.
+
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/audit"
+)
+
+// MultisigDisputeWindow is the default dispute window for held escrow (see SetDisputeWindow)
+const MultisigDisputeWindow = 72 * time.Hour
+
+// ConsultationMultisig defines an N-of-M confirmation requirement for escrow release
+type ConsultationMultisig struct {
+	Signers       []string          `json:"signers"`       // Citizen-side DIDs allowed to confirm
+	Threshold     int               `json:"threshold"`     // Confirmations required before release
+	Confirmations map[string][]byte `json:"confirmations"` // signer DID -> acceptance signature
+}
+
+// IsSatisfied returns true once enough signers have confirmed delivery
+func (cm *ConsultationMultisig) IsSatisfied() bool {
+	return len(cm.Confirmations) >= cm.Threshold
+}
+
+// isSigner returns true if the DID is an authorized confirmation signer
+func (cm *ConsultationMultisig) isSigner(did string) bool {
+	return contains(cm.Signers, did)
+}
+
+// SetMultisigRequirement requires N-of-M citizen-side confirmations before escrow release
+//
+// MULTISIG LOGIC:
+// 1. Only the contract citizen can configure, and only before delivery
+// 2. Threshold must be between 1 and the number of signers
+// 3. Signers must be unique
+func (csc *ConsultationSmartContract) SetMultisigRequirement(
+	ctx context.Context,
+	contractID string,
+	citizenDID string,
+	signers []string,
+	threshold int,
+) error {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	contract, exists := csc.contracts[contractID]
+	if !exists {
+		return fmt.Errorf("contract not found: %s", contractID)
+	}
+
+	if contract.CitizenDID != citizenDID {
+		return fmt.Errorf("unauthorized: only contract citizen can configure multisig release")
+	}
+
+	if contract.Status != StatusPending && contract.Status != StatusInProgress {
+		return fmt.Errorf("invalid status: multisig must be configured before delivery")
+	}
+
+	if threshold < 1 || threshold > len(signers) {
+		return fmt.Errorf("threshold must be between 1 and %d, got %d", len(signers), threshold)
+	}
+
+	seen := make(map[string]bool)
+	for _, signer := range signers {
+		if signer == "" {
+			return fmt.Errorf("signer DID cannot be empty")
+		}
+		if seen[signer] {
+			return fmt.Errorf("duplicate signer: %s", signer)
+		}
+		seen[signer] = true
+	}
+
+	contract.Multisig = &ConsultationMultisig{
+		Signers:       signers,
+		Threshold:     threshold,
+		Confirmations: make(map[string][]byte),
+	}
+
+	return nil
+}
+
+// confirmMultisigLocked records a signer's confirmation and releases escrow at threshold
+func (csc *ConsultationSmartContract) confirmMultisigLocked(
+	ctx context.Context,
+	contract *ConsultationContract,
+	signerDID string,
+	signature []byte,
+) (*ConsultationResult, error) {
+	multisig := contract.Multisig
+
+	if !multisig.isSigner(signerDID) {
+		return nil, fmt.Errorf("unauthorized: %s is not a confirmation signer", signerDID)
+	}
+
+	if contract.Status != StatusCompleted {
+		return nil, fmt.Errorf("invalid status: contract must be completed")
+	}
+
+	if _, signed := multisig.Confirmations[signerDID]; signed {
+		return nil, fmt.Errorf("signer %s has already confirmed", signerDID)
+	}
+
+	if err := csc.verifyAcceptanceLocked(contract, signerDID, signature); err != nil {
+		return nil, err
+	}
+
+	multisig.Confirmations[signerDID] = signature
+	csc.openDisputeWindowLocked(contract, DisputeWindowFromConfirmation, time.Now())
+
+	if !multisig.IsSatisfied() || contract.EscrowBalance == 0 {
+		return &ConsultationResult{
+			ContractID:    contract.ContractID,
+			Status:        StatusCompleted,
+			Message:       fmt.Sprintf("Confirmation recorded (%d/%d)", len(multisig.Confirmations), multisig.Threshold),
+			EscrowBalance: contract.EscrowBalance,
+			Timestamp:     time.Now(),
+		}, nil
+	}
+
+	// Threshold met: release escrow
+	if err := csc.releaseEscrowLocked(ctx, contract); err != nil {
+		return nil, err
+	}
+
+	return &ConsultationResult{
+		ContractID:    contract.ContractID,
+		Status:        StatusCompleted,
+		Message:       fmt.Sprintf("Confirmation threshold met (%d/%d) - payment released to professional", len(multisig.Confirmations), multisig.Threshold),
+		EscrowBalance: 0,
+		Timestamp:     time.Now(),
+	}, nil
+}
+
+// ReleaseExpiredEscrows releases held escrow for multisig contracts whose dispute window passed
+// Contracts whose window has not opened yet (see DisputeWindowTrigger) are not eligible.
+// Returns the IDs of the contracts that were released
+func (csc *ConsultationSmartContract) ReleaseExpiredEscrows(ctx context.Context) ([]string, error) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	now := time.Now()
+	released := make([]string, 0)
+
+	for _, contract := range csc.contracts {
+		if contract.Multisig == nil || contract.Status != StatusCompleted || contract.EscrowBalance == 0 {
+			continue
+		}
+
+		if contract.ReleaseDeadline == nil || now.Before(*contract.ReleaseDeadline) {
+			continue
+		}
+
+		if err := csc.releaseEscrowLocked(ctx, contract); err != nil {
+			return released, fmt.Errorf("failed to release contract %s: %w", contract.ContractID, err)
+		}
+
+		released = append(released, contract.ContractID)
+	}
+
+	return released, nil
+}
+
+// releaseEscrowLocked pays the held escrow to the professional (caller holds csc.mu)
+// If a payout holdback is configured, the withheld share moves to HoldbackBalance.
+func (csc *ConsultationSmartContract) releaseEscrowLocked(ctx context.Context, contract *ConsultationContract) error {
+	payout, holdback := csc.splitHoldbackLocked(contract.EscrowBalance)
+
+	if payout > 0 {
+		txID, err := csc.walletManager.CreditRegular(ctx, contract.ProfessionalDID, payout, "consultation_payment", contract.ContractID)
+		if err != nil {
+			return fmt.Errorf("failed to release payment: %w", err)
+		}
+
+		csc.recordEscrowAudit(audit.EscrowActionRelease, contract.ContractID, contract.ProfessionalDID, payout, txID)
+		contract.ReleasedAmount += payout
+	}
+
+	if holdback > 0 {
+		releaseAt := time.Now().Add(csc.holdbackPeriod)
+		contract.HoldbackBalance = holdback
+		contract.HoldbackReleaseAt = &releaseAt
+	}
+
+	contract.EscrowBalance = 0
+
+	return nil
+}
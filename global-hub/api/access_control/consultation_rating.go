@@ -0,0 +1,68 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Consultation Ratings
+//
+// Citizens rate a professional once per delivered consultation. Ratings feed
+// the professional summary (average rating) and are stored on the contract.
+
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Rating bounds (stars)
+const (
+	MinConsultationRating = 1
+	MaxConsultationRating = 5
+)
+
+// ConsultationRating is a citizen's rating of a delivered consultation
+type ConsultationRating struct {
+	Score   int       `json:"score"` // 1-5
+	Comment string    `json:"comment,omitempty"`
+	RatedAt time.Time `json:"rated_at"`
+}
+
+// RateConsultation records the citizen's rating for a delivered contract
+// Only the contract citizen can rate, once, after delivery.
+func (csc *ConsultationSmartContract) RateConsultation(
+	ctx context.Context,
+	contractID string,
+	citizenDID string,
+	score int,
+	comment string,
+) error {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	contract, exists := csc.contracts[contractID]
+	if !exists {
+		return fmt.Errorf("contract not found: %s", contractID)
+	}
+
+	if contract.CitizenDID != citizenDID {
+		return fmt.Errorf("unauthorized: only contract citizen can rate")
+	}
+
+	if contract.CompletedAt == nil {
+		return fmt.Errorf("invalid status: service must be delivered before rating")
+	}
+
+	if contract.Rating != nil {
+		return fmt.Errorf("contract already rated")
+	}
+
+	if score < MinConsultationRating || score > MaxConsultationRating {
+		return fmt.Errorf("rating must be between %d and %d, got %d", MinConsultationRating, MaxConsultationRating, score)
+	}
+
+	contract.Rating = &ConsultationRating{
+		Score:   score,
+		Comment: comment,
+		RatedAt: time.Now(),
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Dispute Resolution
+//
+// An authorized arbiter settles a disputed consultation by refunding part (or
+// all) of the fee to the citizen. The refund is funded from any escrow or
+// holdback still held, then clawed back from the professional's wallet, since
+// delivery usually released the payment before the dispute was raised.
+
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/audit"
+)
+
+// DisputeResolution records how an arbiter settled a disputed contract
+type DisputeResolution struct {
+	ArbiterDID           string    `json:"arbiter_did"`
+	Reason               string    `json:"reason"`
+	CitizenRefund        int64     `json:"citizen_refund"`        // Total returned to the citizen (uSOV)
+	RefundFromEscrow     int64     `json:"refund_from_escrow"`    // Portion funded by held escrow/holdback
+	ProfessionalClawback int64     `json:"professional_clawback"` // Portion debited from the professional's wallet
+	ProfessionalPayout   int64     `json:"professional_payout"`   // Remaining held funds paid to the professional
+	ResolvedAt           time.Time `json:"resolved_at"`
+}
+
+// AuthorizeArbiter allows a DID to resolve consultation disputes
+func (csc *ConsultationSmartContract) AuthorizeArbiter(arbiterDID string) error {
+	if arbiterDID == "" {
+		return fmt.Errorf("arbiter DID required")
+	}
+
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	csc.arbiters[arbiterDID] = true
+	return nil
+}
+
+// RevokeArbiter removes a DID's authority to resolve disputes
+func (csc *ConsultationSmartContract) RevokeArbiter(arbiterDID string) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	delete(csc.arbiters, arbiterDID)
+}
+
+// ResolveDispute settles a disputed contract with a (partial) refund to the citizen
+//
+// RESOLUTION LOGIC:
+// 1. Arbiter must be authorized and not a party to the contract
+// 2. Refund is capped at the original fee (0 upholds the professional in full)
+// 3. Refund is funded from held escrow, then holdback, then clawed back from the professional
+// 4. Contract moves to resolved with the reason and amounts recorded
+// 5. Any held funds not refunded are paid to the professional
+func (csc *ConsultationSmartContract) ResolveDispute(
+	ctx context.Context,
+	contractID string,
+	arbiterDID string,
+	citizenRefundAmount int64,
+	reason string,
+) (*ConsultationResult, error) {
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	contract, exists := csc.contracts[contractID]
+	if !exists {
+		return nil, fmt.Errorf("contract not found: %s", contractID)
+	}
+
+	// 1. Validate arbiter
+	if !csc.arbiters[arbiterDID] {
+		return nil, fmt.Errorf("unauthorized: %s is not an authorized arbiter", arbiterDID)
+	}
+	if arbiterDID == contract.CitizenDID || arbiterDID == contract.ProfessionalDID {
+		return nil, fmt.Errorf("unauthorized: contract parties cannot arbitrate their own dispute")
+	}
+
+	if err := ConsultationTransitions.ValidateTransition(string(contract.Status), string(StatusResolved)); err != nil {
+		return nil, fmt.Errorf("invalid status: %w", err)
+	}
+
+	// 2. Validate refund
+	if citizenRefundAmount < 0 || citizenRefundAmount > contract.Fee {
+		return nil, fmt.Errorf("refund must be between 0 and the fee (%d uSOV), got %d", contract.Fee, citizenRefundAmount)
+	}
+	if reason == "" {
+		return nil, fmt.Errorf("resolution reason required")
+	}
+
+	// 3. Split the refund across held funds and the professional's wallet
+	fromEscrow := minInt64(citizenRefundAmount, contract.EscrowBalance)
+	fromHoldback := minInt64(citizenRefundAmount-fromEscrow, contract.HoldbackBalance)
+	clawback := citizenRefundAmount - fromEscrow - fromHoldback
+	payout := (contract.EscrowBalance - fromEscrow) + (contract.HoldbackBalance - fromHoldback)
+
+	if clawback > 0 {
+		if _, err := csc.walletManager.DebitRegular(ctx, contract.ProfessionalDID, clawback, "consultation_dispute_clawback", contract.ContractID); err != nil {
+			return nil, fmt.Errorf("failed to claw back from professional: %w", err)
+		}
+	}
+
+	if citizenRefundAmount > 0 {
+		txID, err := csc.walletManager.CreditRegular(ctx, contract.CitizenDID, citizenRefundAmount, "consultation_dispute_refund", contract.ContractID)
+		if err != nil {
+			// Return the clawed-back amount so the professional isn't left short
+			if clawback > 0 {
+				if _, rollbackErr := csc.walletManager.CreditRegular(ctx, contract.ProfessionalDID, clawback, "consultation_dispute_clawback_reversal", contract.ContractID); rollbackErr != nil {
+					return nil, fmt.Errorf("failed to refund citizen: %v (clawback reversal failed: %w)", err, rollbackErr)
+				}
+			}
+			return nil, fmt.Errorf("failed to refund citizen: %w", err)
+		}
+
+		csc.recordEscrowAudit(audit.EscrowActionRefund, contractID, contract.CitizenDID, citizenRefundAmount, txID)
+	}
+
+	// 4. Record the resolution (held funds are now either refunded or owed to the professional)
+	if err := contract.transitionTo(StatusResolved); err != nil {
+		return nil, err
+	}
+
+	contract.EscrowBalance = payout
+	contract.HoldbackBalance = 0
+	contract.HoldbackReleaseAt = nil
+	contract.Resolution = &DisputeResolution{
+		ArbiterDID:           arbiterDID,
+		Reason:               reason,
+		CitizenRefund:        citizenRefundAmount,
+		RefundFromEscrow:     fromEscrow + fromHoldback,
+		ProfessionalClawback: clawback,
+		ProfessionalPayout:   payout,
+		ResolvedAt:           time.Now(),
+	}
+
+	// 5. Pay remaining held funds to the professional
+	if payout > 0 {
+		txID, err := csc.walletManager.CreditRegular(ctx, contract.ProfessionalDID, payout, "consultation_payment", contract.ContractID)
+		if err != nil {
+			return nil, fmt.Errorf("dispute resolved but failed to release %d uSOV to professional: %w", payout, err)
+		}
+
+		csc.recordEscrowAudit(audit.EscrowActionRelease, contractID, contract.ProfessionalDID, payout, txID)
+		contract.ReleasedAmount += payout
+		contract.EscrowBalance = 0
+	}
+
+	fmt.Printf("⚖️  Dispute Resolved\n")
+	fmt.Printf("   Contract ID: %s\n", contractID)
+	fmt.Printf("   Arbiter: %s\n", arbiterDID)
+	fmt.Printf("   Citizen Refund: %.6f SOV (%.6f SOV clawed back)\n", float64(citizenRefundAmount)/1_000_000, float64(clawback)/1_000_000)
+	fmt.Printf("   Reason: %s\n", reason)
+
+	return &ConsultationResult{
+		ContractID:    contractID,
+		Status:        StatusResolved,
+		Message:       fmt.Sprintf("Dispute resolved: %.6f SOV refunded to citizen", float64(citizenRefundAmount)/1_000_000),
+		EscrowBalance: 0,
+		Timestamp:     time.Now(),
+	}, nil
+}
+
+// minInt64 returns the smaller of two amounts
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
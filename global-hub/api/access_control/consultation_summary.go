@@ -0,0 +1,114 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Professional Summary
+//
+// Aggregates a professional's contracts, ratings, disputes and earnings into
+// one dashboard view instead of stitching together several listings. Archived
+// contracts count too, so earnings and ratings survive ArchiveOldContracts.
+
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ProfessionalSummary is the dashboard aggregate for one professional
+type ProfessionalSummary struct {
+	ProfessionalDID string `json:"professional_did"`
+
+	// Contract counts
+	TotalContracts     int `json:"total_contracts"`
+	ActiveContracts    int `json:"active_contracts"`    // Pending or in progress
+	CompletedContracts int `json:"completed_contracts"` // Delivered and not disputed
+	DisputedContracts  int `json:"disputed_contracts"`  // Currently awaiting resolution
+	ResolvedContracts  int `json:"resolved_contracts"`  // Disputes settled by an arbiter
+
+	// Money (uSOV)
+	TotalEarnings  int64 `json:"total_earnings"`  // Released payouts minus dispute clawbacks
+	EscrowExposure int64 `json:"escrow_exposure"` // Escrow and holdback not yet released
+
+	// Quality
+	AverageRating float64 `json:"average_rating"` // 0 if unrated
+	RatingCount   int     `json:"rating_count"`
+	DisputeRate   float64 `json:"dispute_rate"` // Share of delivered contracts ever disputed (0-1)
+
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// GetProfessionalSummary assembles the dashboard aggregate for a professional
+// Covers live contracts and those moved to the archive.
+func (csc *ConsultationSmartContract) GetProfessionalSummary(ctx context.Context, professionalDID string) (*ProfessionalSummary, error) {
+	csc.mu.RLock()
+	defer csc.mu.RUnlock()
+
+	archived, err := csc.archive.ListByParty(ctx, professionalDID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load archived contracts: %w", err)
+	}
+
+	contracts := make([]*ConsultationContract, 0, len(csc.contracts)+len(archived))
+	for _, contract := range csc.contracts {
+		contracts = append(contracts, contract)
+	}
+	contracts = append(contracts, archived...)
+
+	summary := &ProfessionalSummary{
+		ProfessionalDID: professionalDID,
+		GeneratedAt:     time.Now(),
+	}
+
+	delivered := 0
+	everDisputed := 0
+	ratingTotal := 0
+
+	for _, contract := range contracts {
+		if contract.ProfessionalDID != professionalDID {
+			continue
+		}
+
+		summary.TotalContracts++
+
+		switch contract.Status {
+		case StatusPending, StatusInProgress:
+			summary.ActiveContracts++
+		case StatusCompleted:
+			summary.CompletedContracts++
+		case StatusDisputed:
+			summary.DisputedContracts++
+		case StatusResolved:
+			summary.ResolvedContracts++
+		}
+
+		summary.TotalEarnings += contract.ReleasedAmount
+		if contract.Resolution != nil {
+			summary.TotalEarnings -= contract.Resolution.ProfessionalClawback
+		}
+
+		if contract.Status != StatusRefunded && contract.Status != StatusCancelled {
+			summary.EscrowExposure += contract.EscrowBalance + contract.HoldbackBalance
+		}
+
+		if contract.CompletedAt != nil {
+			delivered++
+			if contract.DisputeReason != "" || contract.Status == StatusDisputed || contract.Status == StatusResolved {
+				everDisputed++
+			}
+		}
+
+		if contract.Rating != nil {
+			summary.RatingCount++
+			ratingTotal += contract.Rating.Score
+		}
+	}
+
+	if summary.RatingCount > 0 {
+		summary.AverageRating = float64(ratingTotal) / float64(summary.RatingCount)
+	}
+
+	if delivered > 0 {
+		summary.DisputeRate = float64(everDisputed) / float64(delivered)
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,92 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Escrow Release Sweeper
+//
+// ReleaseMaturedEscrows, ReleaseExpiredEscrows and ReleaseHoldback only move
+// funds when called. Start runs all three on a ticker until its context is
+// cancelled, so unconfirmed escrow, multisig escrow past its dispute window and
+// matured holdback are paid out without an operator.
+
+package access_control
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultEscrowSweepInterval is how often Start runs the release sweeps
+const DefaultEscrowSweepInterval = 5 * time.Minute
+
+// escrowSweep is one release sweep run by the sweeper
+type escrowSweep struct {
+	name    string
+	release func(ctx context.Context) ([]string, error)
+}
+
+// Start runs the escrow release sweeps every interval until ctx is cancelled
+// A zero interval uses DefaultEscrowSweepInterval. The sweeps also run once
+// immediately, so escrow that matured while the service was down is released
+// on startup. Returns an error if the sweeper is already running.
+func (csc *ConsultationSmartContract) Start(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultEscrowSweepInterval
+	}
+
+	csc.mu.Lock()
+	defer csc.mu.Unlock()
+
+	if csc.sweeping {
+		return fmt.Errorf("escrow sweeper already running")
+	}
+	csc.sweeping = true
+
+	go csc.sweepLoop(ctx, interval)
+
+	return nil
+}
+
+// sweepLoop runs the release sweeps every interval until ctx is cancelled
+func (csc *ConsultationSmartContract) sweepLoop(ctx context.Context, interval time.Duration) {
+	defer func() {
+		csc.mu.Lock()
+		csc.sweeping = false
+		csc.mu.Unlock()
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	csc.runSweeps(ctx)
+
+	for {
+		select {
+		case <-ticker.C:
+			csc.runSweeps(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runSweeps runs each release sweep once; a failing sweep does not stop the others
+func (csc *ConsultationSmartContract) runSweeps(ctx context.Context) {
+	sweeps := []escrowSweep{
+		{name: "auto-release", release: csc.ReleaseMaturedEscrows},
+		{name: "multisig dispute window", release: csc.ReleaseExpiredEscrows},
+		{name: "holdback", release: csc.ReleaseHoldback},
+	}
+
+	for _, sweep := range sweeps {
+		if ctx.Err() != nil {
+			return
+		}
+
+		released, err := sweep.release(ctx)
+		if err != nil {
+			fmt.Printf("Warning: %s escrow sweep failed: %v\n", sweep.name, err)
+		}
+		if len(released) > 0 {
+			fmt.Printf("✅ %s sweep released %d consultation contracts\n", sweep.name, len(released))
+		}
+	}
+}
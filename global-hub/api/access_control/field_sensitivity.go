@@ -0,0 +1,218 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Metadata Field Sensitivity
+//
+// Not every field in a role's access scope is equally sensitive: court_records
+// warrants far more care than legal_name. High-sensitivity fields get a
+// shorter consent expiry and require a fresh citizen biometric on every access:
+// the citizen signs a single-use, short-lived challenge with their DID key.
+
+package access_control
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FieldSensitivity is the sensitivity tier of a metadata field
+type FieldSensitivity string
+
+const (
+	SensitivityLow    FieldSensitivity = "low"
+	SensitivityMedium FieldSensitivity = "medium"
+	SensitivityHigh   FieldSensitivity = "high" // Fresh biometric per access, shorter consent expiry
+)
+
+// DefaultConsentDuration is how long a consent lasts
+const DefaultConsentDuration = 30 * 24 * time.Hour
+
+// HighSensitivityConsentDuration caps consents that cover any high-sensitivity field
+const HighSensitivityConsentDuration = 7 * 24 * time.Hour
+
+// DefaultFieldSensitivity returns the built-in sensitivity of each metadata field
+// Fields not listed are low sensitivity.
+func DefaultFieldSensitivity() map[string]FieldSensitivity {
+	return map[string]FieldSensitivity{
+		"court_records":       SensitivityHigh,
+		"financial_records":   SensitivityHigh,
+		"transaction_history": SensitivityHigh,
+		"asset_declarations":  SensitivityHigh,
+		"legal_documents":     SensitivityMedium,
+		"tax_compliance":      SensitivityMedium,
+		"property_ownership":  SensitivityMedium,
+		"land_registry":       SensitivityMedium,
+	}
+}
+
+// SetFieldSensitivity overrides a field's sensitivity for one role
+func (mac *MetadataAccessController) SetFieldSensitivity(role ProfessionalRole, field string, sensitivity FieldSensitivity) error {
+	if sensitivity != SensitivityLow && sensitivity != SensitivityMedium && sensitivity != SensitivityHigh {
+		return fmt.Errorf("invalid field sensitivity: %s", sensitivity)
+	}
+	if !contains(role.GetAccessScope(), field) {
+		return fmt.Errorf("field %s is not in the %s access scope", field, role)
+	}
+
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	if mac.fieldSensitivity[role] == nil {
+		mac.fieldSensitivity[role] = make(map[string]FieldSensitivity)
+	}
+	mac.fieldSensitivity[role][field] = sensitivity
+
+	return nil
+}
+
+// GetFieldSensitivity returns a field's sensitivity for a role
+func (mac *MetadataAccessController) GetFieldSensitivity(role ProfessionalRole, field string) FieldSensitivity {
+	mac.mu.RLock()
+	defer mac.mu.RUnlock()
+
+	return mac.fieldSensitivityLocked(role, field)
+}
+
+// fieldSensitivityLocked resolves role overrides, then defaults (caller holds mac.mu)
+func (mac *MetadataAccessController) fieldSensitivityLocked(role ProfessionalRole, field string) FieldSensitivity {
+	if sensitivity, exists := mac.fieldSensitivity[role][field]; exists {
+		return sensitivity
+	}
+	if sensitivity, exists := DefaultFieldSensitivity()[field]; exists {
+		return sensitivity
+	}
+	return SensitivityLow
+}
+
+// consentExpiryLocked returns when a consent for these fields expires (caller holds mac.mu)
+func (mac *MetadataAccessController) consentExpiryLocked(role ProfessionalRole, fields []string, grantedAt time.Time) time.Time {
+	for _, field := range fields {
+		if mac.fieldSensitivityLocked(role, field) == SensitivityHigh {
+			return grantedAt.Add(HighSensitivityConsentDuration)
+		}
+	}
+	return grantedAt.Add(DefaultConsentDuration)
+}
+
+// BiometricChallengeTTL is how long a citizen has to sign a biometric access challenge
+const BiometricChallengeTTL = 2 * time.Minute
+
+// BiometricChallenge is a single-use nonce the citizen signs to re-verify for one access
+type BiometricChallenge struct {
+	ChallengeID     string    `json:"challenge_id"`
+	CitizenDID      string    `json:"citizen_did"`
+	ProfessionalDID string    `json:"professional_did"`
+	Nonce           string    `json:"nonce"` // 32 random bytes, hex
+	IssuedAt        time.Time `json:"issued_at"`
+	ExpiresAt       time.Time `json:"expires_at"`
+}
+
+// BiometricChallengeMessage is the payload the citizen signs with their DID key
+func BiometricChallengeMessage(challenge *BiometricChallenge) []byte {
+	return []byte(fmt.Sprintf("sovrn-biometric-access|%s|%s|%s|%s|%d",
+		challenge.ChallengeID, challenge.CitizenDID, challenge.ProfessionalDID, challenge.Nonce, challenge.ExpiresAt.Unix()))
+}
+
+// SetDIDKeyResolver sets where citizens' DID keys are resolved for biometric re-verification
+func (mac *MetadataAccessController) SetDIDKeyResolver(keys DIDKeyResolver) {
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	mac.keys = keys
+}
+
+// IssueBiometricChallenge creates a fresh challenge for one high-sensitivity access
+// Expired challenges are pruned on every issue.
+func (mac *MetadataAccessController) IssueBiometricChallenge(citizenDID string, professionalDID string) (*BiometricChallenge, error) {
+	if citizenDID == "" || professionalDID == "" {
+		return nil, fmt.Errorf("citizen and professional DIDs required")
+	}
+
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate challenge nonce: %w", err)
+	}
+
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	now := time.Now()
+	for id, challenge := range mac.challenges {
+		if now.After(challenge.ExpiresAt) {
+			delete(mac.challenges, id)
+		}
+	}
+
+	challenge := &BiometricChallenge{
+		ChallengeID:     uuid.New().String(),
+		CitizenDID:      citizenDID,
+		ProfessionalDID: professionalDID,
+		Nonce:           hex.EncodeToString(nonce),
+		IssuedAt:        now,
+		ExpiresAt:       now.Add(BiometricChallengeTTL),
+	}
+	mac.challenges[challenge.ChallengeID] = challenge
+
+	return challenge, nil
+}
+
+// consumeBiometricChallenge removes a challenge and checks it was issued for this access
+// A challenge is spent on first use, so a failed or replayed signature cannot retry it.
+func (mac *MetadataAccessController) consumeBiometricChallenge(challengeID string, citizenDID string, professionalDID string) (*BiometricChallenge, DIDKeyResolver, error) {
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	challenge, exists := mac.challenges[challengeID]
+	if !exists {
+		return nil, nil, fmt.Errorf("biometric challenge not found or already used: %s", challengeID)
+	}
+	delete(mac.challenges, challengeID)
+
+	if time.Now().After(challenge.ExpiresAt) {
+		return nil, nil, fmt.Errorf("biometric challenge %s expired", challengeID)
+	}
+	if challenge.CitizenDID != citizenDID || challenge.ProfessionalDID != professionalDID {
+		return nil, nil, fmt.Errorf("biometric challenge %s was issued for a different access", challengeID)
+	}
+
+	return challenge, mac.keys, nil
+}
+
+// RequestMetadataAccessWithBiometric requests access including high-sensitivity fields
+//
+// RE-VERIFICATION LOGIC:
+// 1. The challenge must be outstanding, unexpired and issued for this citizen/professional pair
+// 2. The challenge is spent whether or not the signature verifies (no replays)
+// 3. The citizen's signature over BiometricChallengeMessage must verify against their DID key
+// 4. Access is then resolved with high-sensitivity fields included
+func (mac *MetadataAccessController) RequestMetadataAccessWithBiometric(
+	ctx context.Context,
+	citizenDID string,
+	professionalDID string,
+	professional *CertifiedProfessional,
+	requestedFields []string,
+	purpose string,
+	challengeID string,
+	citizenBiometricSignature []byte,
+) (*MetadataAccessResult, error) {
+	if len(citizenBiometricSignature) == 0 {
+		return nil, fmt.Errorf("fresh biometric signature required")
+	}
+
+	// 1-2. Spend the challenge
+	challenge, keys, err := mac.consumeBiometricChallenge(challengeID, citizenDID, professionalDID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Verify the citizen's signature
+	if err := verifyDIDSignature(ctx, keys, citizenDID, BiometricChallengeMessage(challenge), citizenBiometricSignature); err != nil {
+		return nil, fmt.Errorf("biometric re-verification failed: %w", err)
+	}
+
+	// 4. Resolve access including high-sensitivity fields
+	return mac.requestMetadataAccess(ctx, citizenDID, professionalDID, professional, requestedFields, purpose, true)
+}
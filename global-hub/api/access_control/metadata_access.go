@@ -15,6 +15,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -33,7 +35,12 @@ type AccessConsent struct {
 	GrantedAt        time.Time `json:"granted_at"`
 	RevokedAt        *time.Time `json:"revoked_at,omitempty"`
 	IsActive         bool      `json:"is_active"`
-	BiometricSignature []byte  `json:"biometric_signature"` // Citizen's PFF signature
+	BiometricSignature []byte  `json:"biometric_signature"` // Citizen's PFF signature (guardian's if delegated)
+	
+	// Delegated consent: guardian who granted on the citizen's behalf
+	GrantedByDID     string    `json:"granted_by_did,omitempty"`
+	DelegationID     string    `json:"delegation_id,omitempty"`
+	delegations      *DelegationRegistry // Registry holding DelegationID, checked on every use
 }
 
 // IsValid checks if the consent is still valid
@@ -47,7 +54,31 @@ func (ac *AccessConsent) IsValid() bool {
 	if time.Now().After(ac.ExpiresAt) {
 		return false
 	}
-	return true
+	return ac.delegationActive()
+}
+
+// delegationActive checks that a delegated consent's delegation has not been revoked or expired
+func (ac *AccessConsent) delegationActive() bool {
+	if ac.DelegationID == "" {
+		return true
+	}
+	return ac.delegations != nil && ac.delegations.IsDelegationActive(ac.DelegationID)
+}
+
+// IsInGrace checks if the consent has just expired but is still within the grace window
+// Revoked or deactivated consents, and consents whose delegation ended, are never in grace
+func (ac *AccessConsent) IsInGrace(gracePeriod time.Duration) bool {
+	if !ac.IsActive || ac.RevokedAt != nil || gracePeriod <= 0 || !ac.delegationActive() {
+		return false
+	}
+	now := time.Now()
+	return now.After(ac.ExpiresAt) && !now.After(ac.ExpiresAt.Add(gracePeriod))
+}
+
+// ConsentNotifier notifies citizens about access made under their consents
+type ConsentNotifier interface {
+	// NotifyGraceAccess tells the citizen a professional read data under an expired consent
+	NotifyGraceAccess(ctx context.Context, citizenDID string, professionalDID string, consentID string, graceEndsAt time.Time) error
 }
 
 // CitizenMetadata represents encrypted citizen metadata
@@ -66,9 +97,18 @@ type MetadataAccessResult struct {
 	RequestedFields  []string               `json:"requested_fields"`
 	GrantedFields    []string               `json:"granted_fields"`
 	DecryptedData    map[string]interface{} `json:"decrypted_data,omitempty"`
-	Status           string                 `json:"status"` // "success", "consent_required", "denied"
+	Status           string                 `json:"status"` // "success", "consent_required", "reverification_required", "denied"
 	DenialReason     string                 `json:"denial_reason,omitempty"`
 	Timestamp        time.Time              `json:"timestamp"`
+	Purpose          string                 `json:"purpose,omitempty"`     // Purpose filter, if requested
+	ConsentIDs       []string               `json:"consent_ids,omitempty"` // Consents the granted fields came from
+
+	// High-sensitivity fields withheld until the citizen re-verifies (see RequestMetadataAccessWithBiometric)
+	ReverificationRequired []string `json:"reverification_required,omitempty"`
+
+	// Set when access was served from a just-expired consent
+	InGrace          bool                   `json:"in_grace,omitempty"`
+	GraceEndsAt      *time.Time             `json:"grace_ends_at,omitempty"`
 }
 
 // MetadataAccessController manages consent-based metadata access
@@ -76,6 +116,12 @@ type MetadataAccessController struct {
 	consents         map[string]*AccessConsent // consentID -> consent
 	citizenMetadata  map[string]*CitizenMetadata // citizenDID -> metadata
 	encryptionKey    []byte // AES-256 key for metadata encryption
+	delegations      *DelegationRegistry // Optional guardian -> ward delegations
+	gracePeriod      time.Duration // Read access window after consent expiry (0 = none)
+	notifier         ConsentNotifier // Optional citizen notifications
+	fieldSensitivity map[ProfessionalRole]map[string]FieldSensitivity // Per-role overrides of DefaultFieldSensitivity
+	keys             DIDKeyResolver // Verifies fresh biometric signatures for high-sensitivity access
+	challenges       map[string]*BiometricChallenge // challengeID -> outstanding challenge
 	mu               sync.RWMutex
 }
 
@@ -86,17 +132,49 @@ func NewMetadataAccessController(encryptionKey []byte) *MetadataAccessController
 	}
 
 	return &MetadataAccessController{
-		consents:        make(map[string]*AccessConsent),
-		citizenMetadata: make(map[string]*CitizenMetadata),
-		encryptionKey:   encryptionKey,
+		consents:         make(map[string]*AccessConsent),
+		citizenMetadata:  make(map[string]*CitizenMetadata),
+		encryptionKey:    encryptionKey,
+		fieldSensitivity: make(map[ProfessionalRole]map[string]FieldSensitivity),
+		challenges:       make(map[string]*BiometricChallenge),
+	}
+}
+
+// SetDelegationRegistry enables guardians to grant consent on behalf of wards
+func (mac *MetadataAccessController) SetDelegationRegistry(delegations *DelegationRegistry) {
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	mac.delegations = delegations
+}
+
+// SetConsentGracePeriod allows read access for a short window after a consent expires
+// Zero disables the grace window (consents stop at ExpiresAt)
+func (mac *MetadataAccessController) SetConsentGracePeriod(gracePeriod time.Duration) error {
+	if gracePeriod < 0 {
+		return fmt.Errorf("grace period cannot be negative")
 	}
+
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	mac.gracePeriod = gracePeriod
+	return nil
+}
+
+// SetConsentNotifier sets the notifier used to alert citizens of in-grace access
+func (mac *MetadataAccessController) SetConsentNotifier(notifier ConsentNotifier) {
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	mac.notifier = notifier
 }
 
 // GrantConsent grants a professional access to specific metadata fields
 //
 // CONSENT LOGIC:
 // 1. Citizen explicitly grants access to specific fields
-// 2. Consent is time-limited (default: 30 days)
+// 2. Consent is time-limited (default: 30 days, 7 days if it covers a high-sensitivity field)
 // 3. Consent can be revoked at any time
 // 4. Biometric signature required for consent
 func (mac *MetadataAccessController) GrantConsent(
@@ -117,20 +195,14 @@ func (mac *MetadataAccessController) GrantConsent(
 	}
 
 	// Validate requested fields against professional's access scope
-	allowedFields := professionalRole.GetAccessScope()
-	grantedFields := []string{}
-
-	for _, field := range requestedFields {
-		if contains(allowedFields, field) {
-			grantedFields = append(grantedFields, field)
-		}
-	}
+	grantedFields := filterFields(requestedFields, professionalRole.GetAccessScope())
 
 	if len(grantedFields) == 0 {
 		return nil, fmt.Errorf("no valid fields requested for role %s", professionalRole)
 	}
 
 	// Create consent record
+	now := time.Now()
 	consent := &AccessConsent{
 		ConsentID:          uuid.New().String(),
 		CitizenDID:         citizenDID,
@@ -138,8 +210,8 @@ func (mac *MetadataAccessController) GrantConsent(
 		ProfessionalRole:   professionalRole,
 		GrantedFields:      grantedFields,
 		Purpose:            purpose,
-		ExpiresAt:          time.Now().Add(30 * 24 * time.Hour), // 30 days
-		GrantedAt:          time.Now(),
+		ExpiresAt:          mac.consentExpiryLocked(professionalRole, grantedFields, now),
+		GrantedAt:          now,
 		IsActive:           true,
 		BiometricSignature: biometricSignature,
 	}
@@ -149,6 +221,75 @@ func (mac *MetadataAccessController) GrantConsent(
 	return consent, nil
 }
 
+// GrantDelegatedConsent grants consent on a ward's behalf using the guardian's biometric
+//
+// DELEGATION LOGIC:
+// 1. Guardian must hold a valid delegation for the ward
+// 2. Guardian's biometric signature authorizes the grant
+// 3. Granted fields = requested ∩ role scope ∩ delegation's legally-bounded fields
+// 4. Consent records the guardian and delegation for audit
+func (mac *MetadataAccessController) GrantDelegatedConsent(
+	ctx context.Context,
+	guardianDID string,
+	wardDID string,
+	professionalDID string,
+	professionalRole ProfessionalRole,
+	requestedFields []string,
+	purpose string,
+	guardianBiometricSignature []byte,
+) (*AccessConsent, error) {
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	if mac.delegations == nil {
+		return nil, fmt.Errorf("consent delegation not enabled")
+	}
+
+	// 1. Verify guardian relationship
+	delegation, err := mac.delegations.GetActiveDelegation(ctx, guardianDID, wardDID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Guardian's biometric authorizes the grant
+	if len(guardianBiometricSignature) == 0 {
+		return nil, fmt.Errorf("guardian biometric signature required for delegated consent")
+	}
+
+	// 3. Bound fields by role scope and the legal instrument
+	grantedFields := filterFields(filterFields(requestedFields, professionalRole.GetAccessScope()), delegation.AllowedFields)
+	if len(grantedFields) == 0 {
+		return nil, fmt.Errorf("no requested fields are covered by both role %s and delegation %s", professionalRole, delegation.DelegationID)
+	}
+
+	// 4. Create consent record attributed to the guardian
+	now := time.Now()
+	consent := &AccessConsent{
+		ConsentID:          uuid.New().String(),
+		CitizenDID:         wardDID,
+		ProfessionalDID:    professionalDID,
+		ProfessionalRole:   professionalRole,
+		GrantedFields:      grantedFields,
+		Purpose:            purpose,
+		ExpiresAt:          mac.consentExpiryLocked(professionalRole, grantedFields, now),
+		GrantedAt:          now,
+		IsActive:           true,
+		BiometricSignature: guardianBiometricSignature,
+		GrantedByDID:       guardianDID,
+		DelegationID:       delegation.DelegationID,
+		delegations:        mac.delegations,
+	}
+
+	// Consent cannot outlive the delegation that authorized it
+	if delegation.ExpiresAt.Before(consent.ExpiresAt) {
+		consent.ExpiresAt = delegation.ExpiresAt
+	}
+
+	mac.consents[consent.ConsentID] = consent
+
+	return consent, nil
+}
+
 // RevokeConsent revokes a previously granted consent
 func (mac *MetadataAccessController) RevokeConsent(ctx context.Context, consentID string) error {
 	mac.mu.Lock()
@@ -167,18 +308,46 @@ func (mac *MetadataAccessController) RevokeConsent(ctx context.Context, consentI
 }
 
 // RequestMetadataAccess requests access to citizen metadata with consent validation
+// Fields are resolved against the union of all valid consents for the pair
+func (mac *MetadataAccessController) RequestMetadataAccess(
+	ctx context.Context,
+	citizenDID string,
+	professionalDID string,
+	professional *CertifiedProfessional,
+	requestedFields []string,
+) (*MetadataAccessResult, error) {
+	return mac.RequestMetadataAccessForPurpose(ctx, citizenDID, professionalDID, professional, requestedFields, "")
+}
+
+// RequestMetadataAccessForPurpose requests metadata access under consents granted for a purpose
 //
 // ACCESS CONTROL LOGIC:
-// 1. Check if valid consent exists
-// 2. Validate professional's license
-// 3. Decrypt only the granted fields
-// 4. Return filtered metadata
-func (mac *MetadataAccessController) RequestMetadataAccess(
+// 1. Collect every valid consent for the pair (or just-expired ones within the grace window)
+// 2. If a purpose is given, keep only consents granted for that purpose
+// 3. Validate professional's license
+// 4. Withhold high-sensitivity fields unless the citizen re-verified for this access
+// 5. Decrypt only fields covered by the union of the matching consents
+// 6. Return filtered metadata (flagged and citizen notified if served in grace)
+func (mac *MetadataAccessController) RequestMetadataAccessForPurpose(
 	ctx context.Context,
 	citizenDID string,
 	professionalDID string,
 	professional *CertifiedProfessional,
 	requestedFields []string,
+	purpose string,
+) (*MetadataAccessResult, error) {
+	return mac.requestMetadataAccess(ctx, citizenDID, professionalDID, professional, requestedFields, purpose, false)
+}
+
+// requestMetadataAccess resolves an access request; reverified allows high-sensitivity fields
+func (mac *MetadataAccessController) requestMetadataAccess(
+	ctx context.Context,
+	citizenDID string,
+	professionalDID string,
+	professional *CertifiedProfessional,
+	requestedFields []string,
+	purpose string,
+	reverified bool,
 ) (*MetadataAccessResult, error) {
 	mac.mu.RLock()
 	defer mac.mu.RUnlock()
@@ -189,6 +358,7 @@ func (mac *MetadataAccessController) RequestMetadataAccess(
 		ProfessionalDID: professionalDID,
 		RequestedFields: requestedFields,
 		Timestamp:       time.Now(),
+		Purpose:         purpose,
 	}
 
 	// 1. Validate professional's license
@@ -198,30 +368,65 @@ func (mac *MetadataAccessController) RequestMetadataAccess(
 		return result, fmt.Errorf("professional license invalid")
 	}
 
-	// 2. Find valid consent
-	var validConsent *AccessConsent
+	// 2. Collect matching consents, falling back to ones still within the grace window
+	validConsents := []*AccessConsent{}
+	graceConsents := []*AccessConsent{}
 	for _, consent := range mac.consents {
-		if consent.CitizenDID == citizenDID &&
-			consent.ProfessionalDID == professionalDID &&
-			consent.IsValid() {
-			validConsent = consent
-			break
+		if consent.CitizenDID != citizenDID || consent.ProfessionalDID != professionalDID {
+			continue
+		}
+		if purpose != "" && !strings.EqualFold(consent.Purpose, purpose) {
+			continue
+		}
+		if consent.IsValid() {
+			validConsents = append(validConsents, consent)
+		} else if consent.IsInGrace(mac.gracePeriod) {
+			graceConsents = append(graceConsents, consent)
 		}
 	}
 
-	if validConsent == nil {
+	if len(validConsents) == 0 && len(graceConsents) > 0 {
+		validConsents = graceConsents
+
+		// Grace ends when the last in-grace consent's window closes
+		var graceEndsAt time.Time
+		for _, consent := range graceConsents {
+			if end := consent.ExpiresAt.Add(mac.gracePeriod); end.After(graceEndsAt) {
+				graceEndsAt = end
+			}
+		}
+		result.InGrace = true
+		result.GraceEndsAt = &graceEndsAt
+	}
+
+	if len(validConsents) == 0 {
 		result.Status = "consent_required"
 		result.DenialReason = "No valid consent found. Citizen must grant access first."
+		if purpose != "" {
+			result.DenialReason = fmt.Sprintf("No valid consent found for purpose %q. Citizen must grant access first.", purpose)
+		}
 		return result, fmt.Errorf("consent required")
 	}
 
-	// 3. Filter requested fields by granted fields
+	// 3. Filter requested fields by the union of granted fields
 	grantedFields := []string{}
-	for _, field := range requestedFields {
-		if contains(validConsent.GrantedFields, field) {
-			grantedFields = append(grantedFields, field)
+	consentIDs := []string{}
+	for _, consent := range validConsents {
+		covered := false
+		for _, field := range requestedFields {
+			if !contains(consent.GrantedFields, field) {
+				continue
+			}
+			covered = true
+			if !contains(grantedFields, field) {
+				grantedFields = append(grantedFields, field)
+			}
+		}
+		if covered {
+			consentIDs = append(consentIDs, consent.ConsentID)
 		}
 	}
+	result.ConsentIDs = consentIDs
 
 	if len(grantedFields) == 0 {
 		result.Status = "denied"
@@ -229,6 +434,25 @@ func (mac *MetadataAccessController) RequestMetadataAccess(
 		return result, fmt.Errorf("no granted fields")
 	}
 
+	// High-sensitivity fields need a fresh biometric on every access
+	if !reverified {
+		allowed := []string{}
+		for _, field := range grantedFields {
+			if mac.fieldSensitivityLocked(professional.Role, field) == SensitivityHigh {
+				result.ReverificationRequired = append(result.ReverificationRequired, field)
+				continue
+			}
+			allowed = append(allowed, field)
+		}
+		grantedFields = allowed
+	}
+
+	if len(grantedFields) == 0 {
+		result.Status = "reverification_required"
+		result.DenialReason = "High-sensitivity fields require a fresh biometric verification by the citizen"
+		return result, fmt.Errorf("biometric re-verification required")
+	}
+
 	result.GrantedFields = grantedFields
 
 	// 4. Get citizen metadata
@@ -258,10 +482,21 @@ func (mac *MetadataAccessController) RequestMetadataAccess(
 	result.DecryptedData = filteredData
 	result.Status = "success"
 
+	// 7. Notify citizen that expired consents are still being used
+	if result.InGrace && mac.notifier != nil {
+		for _, consentID := range consentIDs {
+			if err := mac.notifier.NotifyGraceAccess(ctx, citizenDID, professionalDID, consentID, *result.GraceEndsAt); err != nil {
+				fmt.Printf("⚠️  Failed to send grace access notification to %s: %v\n", citizenDID, err)
+				// Continue even if notification fails
+			}
+		}
+	}
+
 	return result, nil
 }
 
 // StoreEncryptedMetadata stores encrypted citizen metadata
+// Replaces the citizen's entire metadata; use UpdateMetadataFields to change individual fields.
 func (mac *MetadataAccessController) StoreEncryptedMetadata(
 	ctx context.Context,
 	citizenDID string,
@@ -270,10 +505,124 @@ func (mac *MetadataAccessController) StoreEncryptedMetadata(
 	mac.mu.Lock()
 	defer mac.mu.Unlock()
 
+	return mac.storeMetadataLocked(citizenDID, metadata)
+}
+
+// StoreEncryptedMetadataBulk stores metadata for many citizens at once
+// All blobs are encrypted before any is stored, so a failure stores nothing.
+func (mac *MetadataAccessController) StoreEncryptedMetadataBulk(
+	ctx context.Context,
+	metadataByDID map[string]map[string]interface{},
+) error {
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	records := make(map[string]*CitizenMetadata, len(metadataByDID))
+	for citizenDID, metadata := range metadataByDID {
+		record, err := mac.buildMetadataRecord(citizenDID, metadata)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt metadata for %s: %w", citizenDID, err)
+		}
+		records[citizenDID] = record
+	}
+
+	for citizenDID, record := range records {
+		mac.citizenMetadata[citizenDID] = record
+	}
+
+	return nil
+}
+
+// UpdateMetadataFields merges fields into a citizen's existing metadata
+//
+// UPDATE LOGIC:
+// 1. Decrypt the current metadata (starts empty if none stored)
+// 2. Overwrite only the given fields, leaving the rest intact
+// 3. Re-encrypt and store
+func (mac *MetadataAccessController) UpdateMetadataFields(
+	ctx context.Context,
+	citizenDID string,
+	fields map[string]interface{},
+) error {
+	if len(fields) == 0 {
+		return fmt.Errorf("at least one field required")
+	}
+
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	metadata, err := mac.currentMetadataLocked(citizenDID)
+	if err != nil {
+		return err
+	}
+
+	for field, value := range fields {
+		metadata[field] = value
+	}
+
+	return mac.storeMetadataLocked(citizenDID, metadata)
+}
+
+// RemoveMetadataField deletes a single field from a citizen's metadata
+func (mac *MetadataAccessController) RemoveMetadataField(
+	ctx context.Context,
+	citizenDID string,
+	field string,
+) error {
+	mac.mu.Lock()
+	defer mac.mu.Unlock()
+
+	if _, exists := mac.citizenMetadata[citizenDID]; !exists {
+		return fmt.Errorf("metadata not found for citizen: %s", citizenDID)
+	}
+
+	metadata, err := mac.currentMetadataLocked(citizenDID)
+	if err != nil {
+		return err
+	}
+
+	if _, exists := metadata[field]; !exists {
+		return fmt.Errorf("field not found: %s", field)
+	}
+
+	delete(metadata, field)
+
+	return mac.storeMetadataLocked(citizenDID, metadata)
+}
+
+// currentMetadataLocked decrypts a citizen's stored metadata (caller holds mac.mu)
+func (mac *MetadataAccessController) currentMetadataLocked(citizenDID string) (map[string]interface{}, error) {
+	existing, exists := mac.citizenMetadata[citizenDID]
+	if !exists {
+		return make(map[string]interface{}), nil
+	}
+
+	metadata, err := mac.decryptMetadata(existing.EncryptedData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt existing metadata: %w", err)
+	}
+
+	return metadata, nil
+}
+
+// storeMetadataLocked encrypts and stores a citizen's metadata (caller holds mac.mu)
+func (mac *MetadataAccessController) storeMetadataLocked(citizenDID string, metadata map[string]interface{}) error {
+	record, err := mac.buildMetadataRecord(citizenDID, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt metadata: %w", err)
+	}
+
+	mac.citizenMetadata[citizenDID] = record
+
+	return nil
+}
+
+// buildMetadataRecord encrypts metadata and lists its available fields
+func (mac *MetadataAccessController) buildMetadataRecord(citizenDID string, metadata map[string]interface{}) (*CitizenMetadata, error) {
 	// Encrypt metadata
 	encryptedData, err := mac.encryptMetadata(metadata)
 	if err != nil {
-		return fmt.Errorf("failed to encrypt metadata: %w", err)
+		return nil, err
 	}
 
 	// Extract available fields
@@ -282,15 +631,12 @@ func (mac *MetadataAccessController) StoreEncryptedMetadata(
 		availableFields = append(availableFields, field)
 	}
 
-	// Store encrypted metadata
-	mac.citizenMetadata[citizenDID] = &CitizenMetadata{
+	return &CitizenMetadata{
 		DID:             citizenDID,
 		EncryptedData:   encryptedData,
 		AvailableFields: availableFields,
 		LastUpdated:     time.Now(),
-	}
-
-	return nil
+	}, nil
 }
 
 // encryptMetadata encrypts metadata using AES-256-GCM
@@ -382,6 +728,110 @@ func (mac *MetadataAccessController) GetActiveConsents(ctx context.Context, citi
 	return activeConsents, nil
 }
 
+// HasValidConsent reports which requested fields the professional currently holds consent for
+// Nothing is decrypted. Only unexpired, unrevoked consents count; consents in
+// their grace window are excluded so a UI does not start work that will lapse.
+func (mac *MetadataAccessController) HasValidConsent(
+	ctx context.Context,
+	citizenDID string,
+	professionalDID string,
+	fields []string,
+) ([]string, error) {
+	mac.mu.RLock()
+	defer mac.mu.RUnlock()
+
+	covered := make(map[string]bool)
+	for _, consent := range mac.consents {
+		if consent.CitizenDID != citizenDID || consent.ProfessionalDID != professionalDID || !consent.IsValid() {
+			continue
+		}
+		for _, field := range consent.GrantedFields {
+			covered[field] = true
+		}
+	}
+
+	consented := []string{}
+	for _, field := range fields {
+		if covered[field] && !contains(consented, field) {
+			consented = append(consented, field)
+		}
+	}
+
+	return consented, nil
+}
+
+// FieldExposure describes one consent under which a metadata field is currently readable
+type FieldExposure struct {
+	ConsentID        string           `json:"consent_id"`
+	ProfessionalDID  string           `json:"professional_did"`
+	ProfessionalRole ProfessionalRole `json:"professional_role"`
+	Purpose          string           `json:"purpose"`
+	GrantedAt        time.Time        `json:"granted_at"`
+	ExpiresAt        time.Time        `json:"expires_at"`
+	GrantedByDID     string           `json:"granted_by_did,omitempty"` // Guardian, for delegated consents
+	InGrace          bool             `json:"in_grace,omitempty"`       // Expired but still readable in the grace window
+	GraceEndsAt      *time.Time       `json:"grace_ends_at,omitempty"`
+}
+
+// GetFieldExposure lists the consents under which a citizen's field can currently be read
+// Answers "who can see my court_records?": one entry per consent granting the
+// field, including expired consents still readable in the grace window.
+// Sorted by expiry (soonest first).
+func (mac *MetadataAccessController) GetFieldExposure(ctx context.Context, citizenDID string, field string) ([]*FieldExposure, error) {
+	if citizenDID == "" || field == "" {
+		return nil, fmt.Errorf("citizen DID and field required")
+	}
+
+	mac.mu.RLock()
+	defer mac.mu.RUnlock()
+
+	exposures := []*FieldExposure{}
+	for _, consent := range mac.consents {
+		if consent.CitizenDID != citizenDID || !contains(consent.GrantedFields, field) {
+			continue
+		}
+
+		inGrace := !consent.IsValid() && consent.IsInGrace(mac.gracePeriod)
+		if !consent.IsValid() && !inGrace {
+			continue
+		}
+
+		exposure := &FieldExposure{
+			ConsentID:        consent.ConsentID,
+			ProfessionalDID:  consent.ProfessionalDID,
+			ProfessionalRole: consent.ProfessionalRole,
+			Purpose:          consent.Purpose,
+			GrantedAt:        consent.GrantedAt,
+			ExpiresAt:        consent.ExpiresAt,
+			GrantedByDID:     consent.GrantedByDID,
+			InGrace:          inGrace,
+		}
+		if inGrace {
+			graceEndsAt := consent.ExpiresAt.Add(mac.gracePeriod)
+			exposure.GraceEndsAt = &graceEndsAt
+		}
+
+		exposures = append(exposures, exposure)
+	}
+
+	sort.Slice(exposures, func(i, j int) bool {
+		return exposures[i].ExpiresAt.Before(exposures[j].ExpiresAt)
+	})
+
+	return exposures, nil
+}
+
+// filterFields returns the requested fields that appear in allowed
+func filterFields(requested []string, allowed []string) []string {
+	filtered := []string{}
+	for _, field := range requested {
+		if contains(allowed, field) {
+			filtered = append(filtered, field)
+		}
+	}
+	return filtered
+}
+
 // Helper function to check if a slice contains a string
 func contains(slice []string, item string) bool {
 	for _, s := range slice {
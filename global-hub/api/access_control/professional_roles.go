@@ -77,6 +77,7 @@ type CertifiedProfessional struct {
 	VerificationDate time.Time        `json:"verification_date"`
 	IsActive         bool             `json:"is_active"`
 	Specializations  []string         `json:"specializations,omitempty"`
+	AccessLevel      int              `json:"access_level"` // Tier within the role (see GetProfessionalTiers)
 	CreatedAt        time.Time        `json:"created_at"`
 	UpdatedAt        time.Time        `json:"updated_at"`
 }
@@ -94,6 +95,16 @@ func (cp *CertifiedProfessional) GetAccessibleFields() []string {
 	return cp.Role.GetAccessScope()
 }
 
+// GetTier returns the professional's tier for their role and access level
+func (cp *CertifiedProfessional) GetTier() (*ProfessionalTier, error) {
+	for _, tier := range GetProfessionalTiers()[cp.Role] {
+		if tier.AccessLevel == cp.AccessLevel {
+			return &tier, nil
+		}
+	}
+	return nil, fmt.Errorf("no %s tier with access level %d", cp.Role, cp.AccessLevel)
+}
+
 // FormatDID creates a professional DID in the format:
 // did:sovra:professional:{country}:{role}:{identifier}
 func FormatProfessionalDID(country string, role ProfessionalRole, identifier string) string {
@@ -170,3 +181,19 @@ func GetProfessionalTiers() map[ProfessionalRole][]ProfessionalTier {
 	}
 }
 
+// MinimumConsultationFee returns the lowest tier fee for a role
+// Roles without tiers fall back to DefaultConsultationFee.
+func MinimumConsultationFee(role ProfessionalRole) int64 {
+	tiers := GetProfessionalTiers()[role]
+	if len(tiers) == 0 {
+		return DefaultConsultationFee
+	}
+
+	minimum := tiers[0].ConsultationFee
+	for _, tier := range tiers[1:] {
+		if tier.ConsultationFee < minimum {
+			minimum = tier.ConsultationFee
+		}
+	}
+	return minimum
+}
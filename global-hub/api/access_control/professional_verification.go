@@ -74,6 +74,7 @@ func (pr *ProfessionalRegistry) RegisterProfessional(
 		VerificationDate: time.Now(),
 		IsActive:         true,
 		Specializations:  specializations,
+		AccessLevel:      1, // Entry tier until promoted
 		CreatedAt:        time.Now(),
 		UpdatedAt:        time.Now(),
 	}
@@ -146,6 +147,29 @@ func (pr *ProfessionalRegistry) verifyLicense(
 	return true, nil
 }
 
+// SetAccessLevel moves a professional to another tier of their role
+func (pr *ProfessionalRegistry) SetAccessLevel(ctx context.Context, did string, accessLevel int) error {
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+
+	professionalID, exists := pr.didIndex[did]
+	if !exists {
+		return fmt.Errorf("professional not found: %s", did)
+	}
+
+	professional := pr.professionals[professionalID]
+
+	previous := professional.AccessLevel
+	professional.AccessLevel = accessLevel
+	if _, err := professional.GetTier(); err != nil {
+		professional.AccessLevel = previous
+		return fmt.Errorf("invalid access level: %w", err)
+	}
+
+	professional.UpdatedAt = time.Now()
+	return nil
+}
+
 // GetProfessionalByDID retrieves a professional by their DID
 func (pr *ProfessionalRegistry) GetProfessionalByDID(ctx context.Context, did string) (*CertifiedProfessional, error) {
 	pr.mu.RLock()
@@ -18,6 +18,7 @@ CREATE TABLE IF NOT EXISTS certified_professionals (
   verification_date TIMESTAMP NOT NULL,
   is_active BOOLEAN DEFAULT true,
   specializations TEXT[],
+  access_level INTEGER DEFAULT 1, -- Tier within the role (prices consultations)
   created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
   updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
@@ -42,6 +43,8 @@ CREATE TABLE IF NOT EXISTS access_consents (
   revoked_at TIMESTAMP,
   is_active BOOLEAN DEFAULT true,
   biometric_signature BYTEA NOT NULL,
+  granted_by_did TEXT,   -- Guardian DID for delegated consent
+  delegation_id TEXT,    -- Delegation that authorized the grant
   FOREIGN KEY (professional_did) REFERENCES certified_professionals(did)
 );
 
@@ -50,6 +53,27 @@ CREATE INDEX idx_consents_professional ON access_consents(professional_did);
 CREATE INDEX idx_consents_active ON access_consents(is_active);
 CREATE INDEX idx_consents_expires ON access_consents(expires_at);
 
+-- ============================================================================
+-- CONSENT DELEGATIONS (Guardians / Power of Attorney)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS consent_delegations (
+  delegation_id TEXT PRIMARY KEY,
+  guardian_did TEXT NOT NULL,
+  ward_did TEXT NOT NULL,
+  type TEXT NOT NULL CHECK (type IN ('guardian', 'power_of_attorney')),
+  allowed_fields TEXT[] NOT NULL,
+  legal_document_hash TEXT NOT NULL,
+  guardian_signature BYTEA NOT NULL,
+  registered_at TIMESTAMP NOT NULL,
+  expires_at TIMESTAMP NOT NULL,
+  revoked_at TIMESTAMP,
+  is_active BOOLEAN DEFAULT true
+);
+
+CREATE INDEX idx_delegations_guardian ON consent_delegations(guardian_did);
+CREATE INDEX idx_delegations_ward ON consent_delegations(ward_did);
+
 -- ============================================================================
 -- CITIZEN METADATA (Encrypted)
 -- ============================================================================
@@ -75,15 +99,31 @@ CREATE TABLE IF NOT EXISTS consultation_contracts (
   service_type TEXT NOT NULL,
   description TEXT NOT NULL,
   fee BIGINT NOT NULL,
+  tier_name TEXT, -- Professional tier the fee was priced at
   escrow_balance BIGINT NOT NULL,
-  status TEXT NOT NULL CHECK (status IN ('pending', 'in_progress', 'completed', 'disputed', 'cancelled', 'refunded')),
+  status TEXT NOT NULL CHECK (status IN ('pending', 'in_progress', 'completed', 'disputed', 'cancelled', 'refunded', 'resolved')),
   created_at TIMESTAMP NOT NULL,
   started_at TIMESTAMP,
   completed_at TIMESTAMP,
   delivery_proof TEXT,
   citizen_signature BYTEA,
   dispute_reason TEXT,
-  FOREIGN KEY (professional_did) REFERENCES certified_professionals(did)
+  consent_id TEXT, -- Linked metadata consent (hired with access)
+  holdback_balance BIGINT DEFAULT 0, -- Withheld share of the payout
+  holdback_release_at TIMESTAMP,
+  auto_release_at TIMESTAMP, -- Unconfirmed escrow released after this (unless disputed)
+  released_amount BIGINT DEFAULT 0, -- Total paid out to the professional
+  rating_score INTEGER CHECK (rating_score BETWEEN 1 AND 5), -- Citizen rating after delivery
+  rating_comment TEXT,
+  rated_at TIMESTAMP,
+  resolution_arbiter_did TEXT, -- Arbiter who settled the dispute
+  resolution_reason TEXT,
+  resolution_citizen_refund BIGINT, -- Total refunded to the citizen
+  resolution_clawback BIGINT, -- Portion debited back from the professional
+  resolution_professional_payout BIGINT, -- Held funds paid to the professional
+  resolved_at TIMESTAMP,
+  FOREIGN KEY (professional_did) REFERENCES certified_professionals(did),
+  FOREIGN KEY (consent_id) REFERENCES access_consents(consent_id)
 );
 
 CREATE INDEX idx_contracts_citizen ON consultation_contracts(citizen_did);
@@ -91,6 +131,24 @@ CREATE INDEX idx_contracts_professional ON consultation_contracts(professional_d
 CREATE INDEX idx_contracts_status ON consultation_contracts(status);
 CREATE INDEX idx_contracts_created ON consultation_contracts(created_at);
 
+-- ============================================================================
+-- DISPUTE EVIDENCE (Append-only)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS dispute_evidence (
+  evidence_id TEXT PRIMARY KEY,
+  contract_id TEXT NOT NULL,
+  submitted_by TEXT NOT NULL,
+  party TEXT NOT NULL CHECK (party IN ('citizen', 'professional')),
+  content_hash TEXT NOT NULL,
+  description TEXT,
+  submitted_at TIMESTAMP NOT NULL,
+  UNIQUE (contract_id, party, content_hash),
+  FOREIGN KEY (contract_id) REFERENCES consultation_contracts(contract_id)
+);
+
+CREATE INDEX idx_evidence_contract ON dispute_evidence(contract_id);
+
 -- ============================================================================
 -- METADATA ACCESS LOG (Audit Trail)
 -- ============================================================================
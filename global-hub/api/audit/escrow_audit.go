@@ -0,0 +1,217 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Escrow Audit Chain
+//
+// Tamper-evident, hash-chained audit log for every escrow movement.
+// Each entry embeds the previous entry's hash and is signed with the hub key,
+// so regulators can verify that funds moved exactly as the contract logic dictates.
+
+package audit
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// EscrowAction represents the kind of escrow movement being audited
+type EscrowAction string
+
+const (
+	EscrowActionLock    EscrowAction = "lock"    // Funds moved into escrow
+	EscrowActionRelease EscrowAction = "release" // Funds released from escrow to the payee
+	EscrowActionRefund  EscrowAction = "refund"  // Funds returned from escrow to the payer
+)
+
+// Audit sources
+const (
+	SourceConsultation = "consultation"
+	SourceAirlineProxy = "airline_proxy"
+	SourceSettlement   = "settlement"
+)
+
+// GenesisHash is the previous-hash value of the first entry in the chain
+const GenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// AuditEvent represents a single signed, hash-chained escrow movement
+type AuditEvent struct {
+	Sequence      uint64       `json:"sequence"`     // Position in the chain (0-based)
+	Source        string       `json:"source"`       // "consultation", "airline_proxy", "settlement"
+	Action        EscrowAction `json:"action"`       // lock, release, refund
+	ReferenceID   string       `json:"reference_id"` // Contract, ticket or settlement transaction ID
+	Party         string       `json:"party"`        // Wallet/vault whose funds moved
+	AmountUSOV    int64        `json:"amount_usov"`
+	TransactionID string       `json:"transaction_id"` // Underlying wallet transaction ID
+	Timestamp     time.Time    `json:"timestamp"`
+	PrevHash      string       `json:"prev_hash"`
+	Hash          string       `json:"hash"`
+	Signature     string       `json:"signature"` // HMAC-SHA256 of Hash with the hub signing key
+}
+
+// EscrowAuditLog is an append-only, hash-chained log of escrow movements
+type EscrowAuditLog struct {
+	events     []*AuditEvent
+	signingKey []byte
+	mu         sync.RWMutex
+}
+
+// NewEscrowAuditLog creates a new escrow audit log signed with the given key
+func NewEscrowAuditLog(signingKey []byte) *EscrowAuditLog {
+	return &EscrowAuditLog{
+		events:     make([]*AuditEvent, 0),
+		signingKey: signingKey,
+	}
+}
+
+// Record appends a new escrow movement to the audit chain
+//
+// CHAIN LOGIC:
+// 1. Link to the hash of the previous entry (or GenesisHash)
+// 2. Hash the entry fields together with the previous hash
+// 3. Sign the hash with the hub signing key
+func (eal *EscrowAuditLog) Record(
+	source string,
+	action EscrowAction,
+	referenceID string,
+	party string,
+	amount int64,
+	transactionID string,
+) (*AuditEvent, error) {
+	eal.mu.Lock()
+	defer eal.mu.Unlock()
+
+	if referenceID == "" {
+		return nil, fmt.Errorf("reference ID required")
+	}
+
+	// 1. Link to previous entry
+	prevHash := GenesisHash
+	if n := len(eal.events); n > 0 {
+		prevHash = eal.events[n-1].Hash
+	}
+
+	event := &AuditEvent{
+		Sequence:      uint64(len(eal.events)),
+		Source:        source,
+		Action:        action,
+		ReferenceID:   referenceID,
+		Party:         party,
+		AmountUSOV:    amount,
+		TransactionID: transactionID,
+		Timestamp:     time.Now().UTC(),
+		PrevHash:      prevHash,
+	}
+
+	// 2. Hash entry
+	event.Hash = computeEventHash(event)
+
+	// 3. Sign hash
+	event.Signature = eal.sign(event.Hash)
+
+	eal.events = append(eal.events, event)
+
+	return copyEvent(event), nil
+}
+
+// VerifyAuditChain verifies the integrity of entries in the range [from, to]
+// Returns an error describing the first entry whose hash, signature or
+// previous-hash link does not match
+func (eal *EscrowAuditLog) VerifyAuditChain(from, to uint64) error {
+	eal.mu.RLock()
+	defer eal.mu.RUnlock()
+
+	if from > to {
+		return fmt.Errorf("invalid range: from (%d) > to (%d)", from, to)
+	}
+
+	if to >= uint64(len(eal.events)) {
+		return fmt.Errorf("invalid range: to (%d) exceeds chain length (%d)", to, len(eal.events))
+	}
+
+	for i := from; i <= to; i++ {
+		event := eal.events[i]
+
+		if event.Sequence != i {
+			return fmt.Errorf("entry %d: sequence mismatch (got %d)", i, event.Sequence)
+		}
+
+		// Check link to previous entry
+		expectedPrev := GenesisHash
+		if i > 0 {
+			expectedPrev = eal.events[i-1].Hash
+		}
+		if event.PrevHash != expectedPrev {
+			return fmt.Errorf("entry %d: previous hash mismatch", i)
+		}
+
+		// Check entry contents
+		if computeEventHash(event) != event.Hash {
+			return fmt.Errorf("entry %d: hash mismatch (entry has been altered)", i)
+		}
+
+		// Check signature
+		expectedSig := eal.sign(event.Hash)
+		if !hmac.Equal([]byte(expectedSig), []byte(event.Signature)) {
+			return fmt.Errorf("entry %d: invalid signature", i)
+		}
+	}
+
+	return nil
+}
+
+// GetEvents returns a copy of the entries in the range [from, to]
+func (eal *EscrowAuditLog) GetEvents(from, to uint64) ([]*AuditEvent, error) {
+	eal.mu.RLock()
+	defer eal.mu.RUnlock()
+
+	if from > to || to >= uint64(len(eal.events)) {
+		return nil, fmt.Errorf("invalid range: [%d, %d] (chain length %d)", from, to, len(eal.events))
+	}
+
+	events := make([]*AuditEvent, 0, to-from+1)
+	for i := from; i <= to; i++ {
+		events = append(events, copyEvent(eal.events[i]))
+	}
+
+	return events, nil
+}
+
+// Length returns the number of entries in the chain
+func (eal *EscrowAuditLog) Length() uint64 {
+	eal.mu.RLock()
+	defer eal.mu.RUnlock()
+
+	return uint64(len(eal.events))
+}
+
+// sign computes the HMAC-SHA256 signature of an entry hash
+func (eal *EscrowAuditLog) sign(hash string) string {
+	mac := hmac.New(sha256.New, eal.signingKey)
+	mac.Write([]byte(hash))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// computeEventHash hashes the entry fields together with the previous hash
+func computeEventHash(event *AuditEvent) string {
+	data := fmt.Sprintf("%d|%s|%s|%s|%s|%d|%s|%d|%s",
+		event.Sequence,
+		event.Source,
+		event.Action,
+		event.ReferenceID,
+		event.Party,
+		event.AmountUSOV,
+		event.TransactionID,
+		event.Timestamp.UnixNano(),
+		event.PrevHash,
+	)
+	hash := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(hash[:])
+}
+
+// copyEvent returns a copy of an audit event so callers cannot alter the chain
+func copyEvent(event *AuditEvent) *AuditEvent {
+	c := *event
+	return &c
+}
@@ -74,8 +74,8 @@ func (as *AutoSwapper) SwapFiatToSOV(ctx context.Context, req *SwapRequest) (*Sw
 		}, err
 	}
 
-	// 3. Calculate SOV amount
-	uSOVAmount, err := as.priceOracle.CalculateSOVAmount(ctx, req.Currency, req.FiatAmount)
+	// 3. Calculate SOV amount at the rate fetched above (exact decimal math, rounded down)
+	uSOVAmount, err := ConvertFiatToUSOV(req.FiatAmount, rate.USOVPerUnit)
 	if err != nil {
 		return &SwapResult{
 			RequestID:    req.RequestID,
@@ -96,10 +96,10 @@ func (as *AutoSwapper) SwapFiatToSOV(ctx context.Context, req *SwapRequest) (*Sw
 	var txHash string
 	if walletType == "escrow" {
 		// Enterprise users: credit to escrow wallet (restricted)
-		txHash, err = as.walletMgr.CreditEscrow(ctx, req.UserID, uSOVAmount, "fiat_purchase")
+		txHash, err = as.walletMgr.CreditEscrow(ctx, req.UserID, uSOVAmount, "fiat_purchase", req.RequestID)
 	} else {
 		// Individual users: credit to regular wallet (unrestricted)
-		txHash, err = as.walletMgr.CreditRegular(ctx, req.UserID, uSOVAmount, "fiat_purchase")
+		txHash, err = as.walletMgr.CreditRegular(ctx, req.UserID, uSOVAmount, "fiat_purchase", req.RequestID)
 	}
 
 	if err != nil {
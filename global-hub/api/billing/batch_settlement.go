@@ -0,0 +1,35 @@
+package billing
+
+import (
+	"context"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/workerpool"
+)
+
+// Batch settlement
+//
+// End-of-day jobs settle every pending transaction for a node at once.
+// SettleTransactions runs them through the shared bounded worker pool. Each
+// settlement holds the settlement lock only to claim and record its
+// transaction, so payer debits for different transactions run in parallel;
+// the pool stops starting new settlements once its context is cancelled.
+
+// SetSettlementConcurrency sets how many settlements SettleTransactions runs at a time
+func (mps *MultiPartySettlement) SetSettlementConcurrency(concurrency int) {
+	mps.mu.Lock()
+	defer mps.mu.Unlock()
+
+	mps.settlementPool = workerpool.New(concurrency)
+}
+
+// SettleTransactions settles many transactions, each independently
+// Returns one error per transaction ID, in input order (nil = settled).
+func (mps *MultiPartySettlement) SettleTransactions(ctx context.Context, transactionIDs []string) []error {
+	mps.mu.RLock()
+	pool := mps.settlementPool
+	mps.mu.RUnlock()
+
+	return pool.Run(ctx, len(transactionIDs), func(ctx context.Context, i int) error {
+		return mps.SettleTransaction(ctx, transactionIDs[i])
+	})
+}
@@ -213,9 +213,9 @@ func (bg *BillingGateway) GetExchangeRate(ctx context.Context, currency string)
 	return bg.priceOracle.GetExchangeRate(ctx, currency)
 }
 
-// GetTransactionHistory returns transaction history for a user
-func (bg *BillingGateway) GetTransactionHistory(ctx context.Context, userID string, limit int) ([]*WalletTransaction, error) {
-	return bg.walletMgr.GetTransactionHistory(ctx, userID, limit)
+// GetTransactionHistory returns a page of transaction history for a user and the next page's cursor
+func (bg *BillingGateway) GetTransactionHistory(ctx context.Context, userID string, limit int, cursor string) ([]*WalletTransaction, string, error) {
+	return bg.walletMgr.GetTransactionHistory(ctx, userID, limit, cursor)
 }
 
 // GetBillingStats returns billing gateway statistics
@@ -247,7 +247,7 @@ func (bg *BillingGateway) WithdrawToExchange(ctx context.Context, userID string,
 	}
 
 	// Debit regular wallet
-	txID, err := bg.walletMgr.DebitRegular(ctx, userID, amount, "withdrawal_to_exchange")
+	txID, err := bg.walletMgr.DebitRegular(ctx, userID, amount, "withdrawal_to_exchange", exchangeAddress)
 	if err != nil {
 		return "", err
 	}
@@ -259,9 +259,10 @@ func (bg *BillingGateway) WithdrawToExchange(ctx context.Context, userID string,
 }
 
 // PayPFFFee pays a PFF verification fee
-// This is called by the fast-track service when a verification occurs
-func (bg *BillingGateway) PayPFFFee(ctx context.Context, userID string, feeAmount int64) (string, error) {
-	return bg.walletMgr.PayPFFFeeSmart(ctx, userID, feeAmount)
+// This is called by the fast-track service when a verification occurs; the
+// verification ID is recorded as the transaction reference
+func (bg *BillingGateway) PayPFFFee(ctx context.Context, userID string, feeAmount int64, verificationID string) (*FeePayment, error) {
+	return bg.walletMgr.PayPFFFeeSmart(ctx, userID, feeAmount, verificationID)
 }
 
 
@@ -1,7 +1,6 @@
 package billing
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -43,7 +42,7 @@ func (h *HTTPHandlers) HandlePurchaseUnits(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 	resp, err := h.gateway.PurchaseUnits(ctx, &req)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
@@ -68,7 +67,7 @@ func (h *HTTPHandlers) HandleGetWallet(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 	wallet, err := h.gateway.GetWallet(ctx, userID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
@@ -86,7 +85,7 @@ func (h *HTTPHandlers) HandleGetExchangeRates(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 	currency := r.URL.Query().Get("currency")
 
 	if currency != "" {
@@ -110,7 +109,7 @@ func (h *HTTPHandlers) HandleGetExchangeRates(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// HandleGetTransactions handles GET /v1/billing/transactions?user_id=xxx&limit=10
+// HandleGetTransactions handles GET /v1/billing/transactions?user_id=xxx&limit=10&cursor=yyy
 func (h *HTTPHandlers) HandleGetTransactions(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -130,13 +129,17 @@ func (h *HTTPHandlers) HandleGetTransactions(w http.ResponseWriter, r *http.Requ
 		}
 	}
 
-	ctx := context.Background()
-	txs, err := h.gateway.GetTransactionHistory(ctx, userID, limit)
+	ctx := r.Context()
+	txs, nextCursor, err := h.gateway.GetTransactionHistory(ctx, userID, limit, r.URL.Query().Get("cursor"))
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
+	// Next page: repeat the request with cursor=<X-Next-Cursor> (absent on the last page)
+	if nextCursor != "" {
+		w.Header().Set("X-Next-Cursor", nextCursor)
+	}
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(txs)
 }
@@ -159,7 +162,7 @@ func (h *HTTPHandlers) HandleWithdraw(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 	txID, err := h.gateway.WithdrawToExchange(ctx, req.UserID, req.Amount, req.ExchangeAddress)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -181,7 +184,7 @@ func (h *HTTPHandlers) HandleGetStats(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 	stats := h.gateway.GetBillingStats(ctx)
 
 	w.Header().Set("Content-Type", "application/json")
@@ -19,6 +19,10 @@ type InvoiceLineItem struct {
 	Percentage      float64   `json:"percentage,omitempty"`
 	Description     string    `json:"description"`
 	Timestamp       time.Time `json:"timestamp"`
+	
+	// Fiat amount at the settlement-time FX snapshot (zero if no snapshot was taken)
+	FXRate          float64   `json:"fx_rate,omitempty"` // uSOV per unit of reporting currency
+	AmountFiat      float64   `json:"amount_fiat,omitempty"`
 }
 
 // MonthlyInvoice represents a monthly billing summary for a corporate node
@@ -34,13 +38,31 @@ type MonthlyInvoice struct {
 	TotalAmountUSOV int64              `json:"total_amount_usov"`
 	TotalSOV        float64            `json:"total_sov"`
 	TotalTransactions int              `json:"total_transactions"`
-	Status          string             `json:"status"` // "draft", "finalized", "paid"
+	ReportingCurrency string           `json:"reporting_currency"`
+	TotalFiat       float64            `json:"total_fiat"` // Sum of line items at their snapshot rates
+	Status          string             `json:"status"` // "draft", "finalized", "paid", "superseded"
 	GeneratedAt     time.Time          `json:"generated_at"`
 	DueDate         time.Time          `json:"due_date,omitempty"`
 	PaidAt          time.Time          `json:"paid_at,omitempty"`
+	PaymentReference string            `json:"payment_reference,omitempty"` // Bank/wire reference of the settling payment
 	
 	// Breakdown by event type
 	EventBreakdown  map[EventType]EventSummary `json:"event_breakdown"`
+	
+	// Versioning (regenerated invoices supersede prior versions)
+	Version           int       `json:"version"`
+	PreviousInvoiceID string    `json:"previous_invoice_id,omitempty"`
+	SupersededBy      string    `json:"superseded_by,omitempty"`
+	SupersededAt      time.Time `json:"superseded_at,omitempty"`
+}
+
+// TransactionIDs returns the settlement transaction of every line item
+func (mi *MonthlyInvoice) TransactionIDs() []string {
+	ids := make([]string, len(mi.LineItems))
+	for i, lineItem := range mi.LineItems {
+		ids[i] = lineItem.TransactionID
+	}
+	return ids
 }
 
 // EventSummary summarizes transactions by event type
@@ -60,16 +82,20 @@ type InvoiceGenerator struct {
 	// Multi-party settlement engine
 	settlement *MultiPartySettlement
 	
-	// Invoice index by node and period
+	// Invoice index by node and period (current version)
 	invoiceIndex map[string]string // key: "nodeID:period" -> invoiceID
+	
+	// All invoice versions by node and period (oldest first)
+	invoiceVersions map[string][]string // key: "nodeID:period" -> invoiceIDs
 }
 
 // NewInvoiceGenerator creates a new invoice generator
 func NewInvoiceGenerator(settlement *MultiPartySettlement) *InvoiceGenerator {
 	return &InvoiceGenerator{
-		invoices:     make(map[string]*MonthlyInvoice),
-		settlement:   settlement,
-		invoiceIndex: make(map[string]string),
+		invoices:        make(map[string]*MonthlyInvoice),
+		settlement:      settlement,
+		invoiceIndex:    make(map[string]string),
+		invoiceVersions: make(map[string][]string),
 	}
 }
 
@@ -90,8 +116,6 @@ func (ig *InvoiceGenerator) GenerateMonthlyInvoice(
 	}
 	
 	// Calculate billing period
-	startDate := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
-	endDate := startDate.AddDate(0, 1, 0).Add(-time.Second) // Last second of month
 	billingPeriod := fmt.Sprintf("%d-%02d", year, month)
 	
 	// Check if invoice already exists
@@ -100,6 +124,120 @@ func (ig *InvoiceGenerator) GenerateMonthlyInvoice(
 		return ig.invoices[existingInvoiceID], nil
 	}
 	
+	invoice, err := ig.buildInvoice(ctx, node, year, month)
+	if err != nil {
+		return nil, err
+	}
+	invoice.Version = 1
+	
+	// Store invoice
+	ig.invoices[invoice.InvoiceID] = invoice
+	ig.invoiceIndex[indexKey] = invoice.InvoiceID
+	ig.invoiceVersions[indexKey] = append(ig.invoiceVersions[indexKey], invoice.InvoiceID)
+	ig.settlement.recordInvoice(nodeID, invoice.InvoiceID, invoice.TransactionIDs())
+	
+	return invoice, nil
+}
+
+// RegenerateInvoice rebuilds a node's invoice for a billing period
+// Picks up transactions that settled after the previous version was generated.
+// The prior version is retained and marked "superseded" so corrections stay auditable.
+func (ig *InvoiceGenerator) RegenerateInvoice(
+	ctx context.Context,
+	nodeID string,
+	year int,
+	month time.Month,
+) (*MonthlyInvoice, error) {
+	ig.mu.Lock()
+	defer ig.mu.Unlock()
+	
+	// Get corporate node
+	node, err := ig.settlement.GetCorporateNode(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	
+	billingPeriod := fmt.Sprintf("%d-%02d", year, month)
+	indexKey := fmt.Sprintf("%s:%s", nodeID, billingPeriod)
+	
+	// Previous version must exist
+	previousID, exists := ig.invoiceIndex[indexKey]
+	if !exists {
+		return nil, fmt.Errorf("no invoice found for node %s in period %s", nodeID, billingPeriod)
+	}
+	previous := ig.invoices[previousID]
+	
+	if previous.Status == "paid" {
+		return nil, fmt.Errorf("invoice %s already paid: cannot regenerate", previousID)
+	}
+	
+	// Rebuild from current settlement state
+	invoice, err := ig.buildInvoice(ctx, node, year, month)
+	if err != nil {
+		return nil, err
+	}
+	invoice.Version = previous.Version + 1
+	invoice.PreviousInvoiceID = previousID
+	
+	// Supersede the prior version (retained for audit)
+	previous.Status = "superseded"
+	previous.SupersededBy = invoice.InvoiceID
+	previous.SupersededAt = time.Now()
+	
+	// Store new version
+	ig.invoices[invoice.InvoiceID] = invoice
+	ig.invoiceIndex[indexKey] = invoice.InvoiceID
+	ig.invoiceVersions[indexKey] = append(ig.invoiceVersions[indexKey], invoice.InvoiceID)
+	ig.settlement.recordInvoice(nodeID, invoice.InvoiceID, invoice.TransactionIDs())
+	
+	fmt.Printf("✅ Invoice Regenerated\n")
+	fmt.Printf("   Node: %s\n", nodeID)
+	fmt.Printf("   Period: %s (version %d)\n", billingPeriod, invoice.Version)
+	fmt.Printf("   Total: %.6f SOV (was %.6f SOV)\n", invoice.TotalSOV, previous.TotalSOV)
+	
+	return invoice, nil
+}
+
+// GetInvoiceVersions returns every version of a node's invoice for a period (oldest first)
+func (ig *InvoiceGenerator) GetInvoiceVersions(
+	ctx context.Context,
+	nodeID string,
+	year int,
+	month time.Month,
+) ([]*MonthlyInvoice, error) {
+	ig.mu.RLock()
+	defer ig.mu.RUnlock()
+	
+	billingPeriod := fmt.Sprintf("%d-%02d", year, month)
+	indexKey := fmt.Sprintf("%s:%s", nodeID, billingPeriod)
+	
+	invoiceIDs, exists := ig.invoiceVersions[indexKey]
+	if !exists {
+		return nil, fmt.Errorf("no invoice found for node %s in period %s", nodeID, billingPeriod)
+	}
+	
+	versions := make([]*MonthlyInvoice, 0, len(invoiceIDs))
+	for _, invoiceID := range invoiceIDs {
+		versions = append(versions, ig.invoices[invoiceID])
+	}
+	
+	return versions, nil
+}
+
+// buildInvoice builds an invoice from the node's settled transactions in the period
+func (ig *InvoiceGenerator) buildInvoice(
+	ctx context.Context,
+	node *CorporateNode,
+	year int,
+	month time.Month,
+) (*MonthlyInvoice, error) {
+	nodeID := node.NodeID
+	
+	// Calculate billing period
+	startDate := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 1, 0).Add(-time.Second) // Last second of month
+	billingPeriod := fmt.Sprintf("%d-%02d", year, month)
+	
 	// Get all transactions for this node
 	allTransactions, err := ig.settlement.GetNodeTransactions(ctx, nodeID)
 	if err != nil {
@@ -109,6 +247,7 @@ func (ig *InvoiceGenerator) GenerateMonthlyInvoice(
 	// Filter transactions for this billing period
 	lineItems := make([]InvoiceLineItem, 0)
 	totalAmount := int64(0)
+	totalFiat := 0.0
 	eventBreakdown := make(map[EventType]EventSummary)
 	
 	for _, txCtx := range allTransactions {
@@ -117,8 +256,8 @@ func (ig *InvoiceGenerator) GenerateMonthlyInvoice(
 			continue
 		}
 		
-		// Only include settled transactions
-		if txCtx.Status != "settled" {
+		// Only include settled transactions (refunded transactions are excluded)
+		if txCtx.Status != TransactionStatusSettled {
 			continue
 		}
 		
@@ -141,8 +280,15 @@ func (ig *InvoiceGenerator) GenerateMonthlyInvoice(
 				Timestamp:      txCtx.Timestamp,
 			}
 			
+			// Convert at the rate captured when the transaction settled
+			if snapshot, exists := txCtx.FXSnapshots[node.ReportingCurrency]; exists && snapshot.USOVPerUnit > 0 {
+				lineItem.FXRate = snapshot.USOVPerUnit
+				lineItem.AmountFiat = float64(payer.AmountUSOV) / snapshot.USOVPerUnit
+			}
+			
 			lineItems = append(lineItems, lineItem)
 			totalAmount += payer.AmountUSOV
+			totalFiat += lineItem.AmountFiat
 			
 			// Update event breakdown
 			summary, exists := eventBreakdown[payer.EventType]
@@ -171,16 +317,14 @@ func (ig *InvoiceGenerator) GenerateMonthlyInvoice(
 		TotalAmountUSOV:   totalAmount,
 		TotalSOV:          float64(totalAmount) / 1_000_000.0,
 		TotalTransactions: len(lineItems),
+		ReportingCurrency: node.ReportingCurrency,
+		TotalFiat:         totalFiat,
 		Status:            "finalized",
 		GeneratedAt:       time.Now(),
 		DueDate:           endDate.AddDate(0, 0, 15), // Due 15 days after month end
 		EventBreakdown:    eventBreakdown,
 	}
 	
-	// Store invoice
-	ig.invoices[invoice.InvoiceID] = invoice
-	ig.invoiceIndex[indexKey] = invoice.InvoiceID
-	
 	return invoice, nil
 }
 
@@ -248,26 +392,109 @@ func (ig *InvoiceGenerator) GetAllNodeInvoices(ctx context.Context, nodeID strin
 	return invoices, nil
 }
 
-// MarkInvoicePaid marks an invoice as paid
-func (ig *InvoiceGenerator) MarkInvoicePaid(ctx context.Context, invoiceID string) error {
+// MarkInvoicePaid marks an invoice as paid by the given payment reference
+// Idempotent: repeating with the same reference succeeds without changes,
+// while a different reference against a paid invoice is rejected.
+// Superseded invoices cannot be paid; pay the current version instead.
+func (ig *InvoiceGenerator) MarkInvoicePaid(ctx context.Context, invoiceID string, paymentReference string) error {
 	ig.mu.Lock()
 	defer ig.mu.Unlock()
 
+	if paymentReference == "" {
+		return fmt.Errorf("payment reference required")
+	}
+
 	invoice, exists := ig.invoices[invoiceID]
 	if !exists {
 		return fmt.Errorf("invoice not found: %s", invoiceID)
 	}
 
 	if invoice.Status == "paid" {
-		return fmt.Errorf("invoice already paid: %s", invoiceID)
+		if invoice.PaymentReference == paymentReference {
+			return nil // Retry of the same payment
+		}
+		return fmt.Errorf("invoice already paid: %s (payment reference %s)", invoiceID, invoice.PaymentReference)
+	}
+
+	if invoice.Status == "superseded" {
+		return fmt.Errorf("invoice %s superseded by %s: pay the current version", invoiceID, invoice.SupersededBy)
 	}
 
 	invoice.Status = "paid"
 	invoice.PaidAt = time.Now()
+	invoice.PaymentReference = paymentReference
 
 	return nil
 }
 
+// PayInvoice reconciles an invoice against its settlement debits, then marks it paid
+// Every line item was already debited from the node's wallet when its
+// transaction settled, so no funds move here. The invoice is only marked paid
+// if every line item's transaction is still settled and carries the node's
+// recorded debit; otherwise it keeps its prior status and should be regenerated.
+// Retrying with the same reference succeeds without changes.
+func (ig *InvoiceGenerator) PayInvoice(ctx context.Context, invoiceID string, paymentReference string) error {
+	ig.mu.Lock()
+	defer ig.mu.Unlock()
+
+	if paymentReference == "" {
+		return fmt.Errorf("payment reference required")
+	}
+
+	invoice, exists := ig.invoices[invoiceID]
+	if !exists {
+		return fmt.Errorf("invoice not found: %s", invoiceID)
+	}
+
+	switch invoice.Status {
+	case "paid":
+		if invoice.PaymentReference == paymentReference {
+			return nil // Retry of the same payment
+		}
+		return fmt.Errorf("invoice already paid: %s (payment reference %s)", invoiceID, invoice.PaymentReference)
+	case "superseded":
+		return fmt.Errorf("invoice %s superseded by %s", invoiceID, invoice.SupersededBy)
+	}
+
+	for _, lineItem := range invoice.LineItems {
+		if err := ig.reconcileLineItem(ctx, invoice.NodeID, lineItem); err != nil {
+			return fmt.Errorf("invoice %s does not reconcile: %w", invoiceID, err)
+		}
+	}
+
+	invoice.Status = "paid"
+	invoice.PaidAt = time.Now()
+	invoice.PaymentReference = paymentReference
+
+	fmt.Printf("✅ Invoice %s reconciled and marked paid for %s: %.6f SOV debited at settlement\n", invoiceID, invoice.NodeID, float64(invoice.TotalAmountUSOV)/1_000_000)
+
+	return nil
+}
+
+// reconcileLineItem checks that a line item's transaction is still settled and the node's share was debited
+func (ig *InvoiceGenerator) reconcileLineItem(ctx context.Context, nodeID string, lineItem InvoiceLineItem) error {
+	txCtx, err := ig.settlement.GetTransaction(ctx, lineItem.TransactionID)
+	if err != nil {
+		return err
+	}
+
+	if txCtx.Status != TransactionStatusSettled {
+		return fmt.Errorf("transaction %s is %s, not settled", lineItem.TransactionID, txCtx.Status)
+	}
+
+	for i, payer := range txCtx.Payers {
+		if payer.PayerID != nodeID || payer.EventType != lineItem.EventType {
+			continue
+		}
+		if txCtx.Metadata[fmt.Sprintf("payer_%d_tx_ids", i)] == "" {
+			return fmt.Errorf("transaction %s has no recorded debit for %s", lineItem.TransactionID, nodeID)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("transaction %s has no allocation for %s", lineItem.TransactionID, nodeID)
+}
+
 // GetInvoiceStats returns statistics about invoices
 func (ig *InvoiceGenerator) GetInvoiceStats(ctx context.Context) map[string]interface{} {
 	ig.mu.RLock()
@@ -282,6 +509,13 @@ func (ig *InvoiceGenerator) GetInvoiceStats(ctx context.Context) map[string]inte
 	invoicesByNodeType := make(map[NodeType]int)
 
 	for _, invoice := range ig.invoices {
+		invoicesByStatus[invoice.Status]++
+		
+		// Superseded versions are retained for audit but not counted as revenue
+		if invoice.Status == "superseded" {
+			continue
+		}
+		
 		totalRevenue += invoice.TotalAmountUSOV
 
 		if invoice.Status == "paid" {
@@ -290,7 +524,6 @@ func (ig *InvoiceGenerator) GetInvoiceStats(ctx context.Context) map[string]inte
 			unpaidRevenue += invoice.TotalAmountUSOV
 		}
 
-		invoicesByStatus[invoice.Status]++
 		invoicesByNodeType[invoice.NodeType]++
 	}
 
@@ -344,6 +577,7 @@ TRANSACTION SUMMARY:
 
 TOTAL TRANSACTIONS:  %d
 TOTAL AMOUNT:        %.6f SOV (%d uSOV)
+TOTAL (FIAT):        %.2f %s (at settlement FX rates)
 
 ────────────────────────────────────────────────────────────────
 
@@ -353,7 +587,7 @@ Payment Instructions:
 
 Questions? Contact: billing@sovrn-protocol.org
 
-`, invoice.TotalTransactions, invoice.TotalSOV, invoice.TotalAmountUSOV)
+`, invoice.TotalTransactions, invoice.TotalSOV, invoice.TotalAmountUSOV, invoice.TotalFiat, invoice.ReportingCurrency)
 
 	return summary
 }
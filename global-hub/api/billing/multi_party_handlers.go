@@ -1,7 +1,6 @@
 package billing
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -40,6 +39,8 @@ func (h *MultiPartyHandlers) RegisterRoutes(mux *http.ServeMux) {
 	
 	// Invoicing
 	mux.HandleFunc("/v1/billing/invoices/generate", h.HandleGenerateInvoice)
+	mux.HandleFunc("/v1/billing/invoices/regenerate", h.HandleRegenerateInvoice)
+	mux.HandleFunc("/v1/billing/invoices/versions", h.HandleGetInvoiceVersions)
 	mux.HandleFunc("/v1/billing/invoices/get", h.HandleGetInvoice)
 	mux.HandleFunc("/v1/billing/invoices/node", h.HandleGetNodeInvoices)
 	mux.HandleFunc("/v1/billing/invoices/pay", h.HandlePayInvoice)
@@ -59,7 +60,7 @@ func (h *MultiPartyHandlers) HandleRegisterNode(w http.ResponseWriter, r *http.R
 		return
 	}
 	
-	ctx := context.Background()
+	ctx := r.Context()
 	if err := h.settlement.RegisterCorporateNode(ctx, &node); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -82,7 +83,7 @@ func (h *MultiPartyHandlers) HandleGetNode(w http.ResponseWriter, r *http.Reques
 		return
 	}
 	
-	ctx := context.Background()
+	ctx := r.Context()
 	node, err := h.settlement.GetCorporateNode(ctx, nodeID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
@@ -112,7 +113,7 @@ func (h *MultiPartyHandlers) HandleCreateTransaction(w http.ResponseWriter, r *h
 		return
 	}
 	
-	ctx := context.Background()
+	ctx := r.Context()
 	txCtx, err := h.settlement.CreateTransaction(
 		ctx,
 		req.VerificationID,
@@ -145,7 +146,7 @@ func (h *MultiPartyHandlers) HandleSettleTransaction(w http.ResponseWriter, r *h
 		return
 	}
 	
-	ctx := context.Background()
+	ctx := r.Context()
 	if err := h.settlement.SettleTransaction(ctx, req.TransactionID); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
@@ -175,7 +176,7 @@ func (h *MultiPartyHandlers) HandleGetTransaction(w http.ResponseWriter, r *http
 		return
 	}
 	
-	ctx := context.Background()
+	ctx := r.Context()
 	txCtx, err := h.settlement.GetTransaction(ctx, transactionID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
@@ -199,7 +200,7 @@ func (h *MultiPartyHandlers) HandleGetNodeTransactions(w http.ResponseWriter, r
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 	transactions, err := h.settlement.GetNodeTransactions(ctx, nodeID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -241,7 +242,7 @@ func (h *MultiPartyHandlers) HandleGenerateInvoice(w http.ResponseWriter, r *htt
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 	invoice, err := h.invoiceGen.GenerateMonthlyInvoice(
 		ctx,
 		req.NodeID,
@@ -257,6 +258,66 @@ func (h *MultiPartyHandlers) HandleGenerateInvoice(w http.ResponseWriter, r *htt
 	json.NewEncoder(w).Encode(invoice)
 }
 
+// HandleRegenerateInvoice handles POST /v1/billing/invoices/regenerate
+func (h *MultiPartyHandlers) HandleRegenerateInvoice(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		NodeID string `json:"node_id"`
+		Year   int    `json:"year"`
+		Month  int    `json:"month"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	invoice, err := h.invoiceGen.RegenerateInvoice(
+		ctx,
+		req.NodeID,
+		req.Year,
+		time.Month(req.Month),
+	)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(invoice)
+}
+
+// HandleGetInvoiceVersions handles GET /v1/billing/invoices/versions?node_id=xxx&year=2026&month=1
+func (h *MultiPartyHandlers) HandleGetInvoiceVersions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	nodeID := r.URL.Query().Get("node_id")
+	year, yearErr := strconv.Atoi(r.URL.Query().Get("year"))
+	month, monthErr := strconv.Atoi(r.URL.Query().Get("month"))
+	if nodeID == "" || yearErr != nil || monthErr != nil {
+		http.Error(w, "node_id, year and month query parameters are required", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	versions, err := h.invoiceGen.GetInvoiceVersions(ctx, nodeID, year, time.Month(month))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(versions)
+}
+
 // HandleGetInvoice handles GET /v1/billing/invoices/get?invoice_id=xxx
 func (h *MultiPartyHandlers) HandleGetInvoice(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -273,7 +334,7 @@ func (h *MultiPartyHandlers) HandleGetInvoice(w http.ResponseWriter, r *http.Req
 	// Check for format parameter
 	format := r.URL.Query().Get("format")
 
-	ctx := context.Background()
+	ctx := r.Context()
 	invoice, err := h.invoiceGen.GetInvoice(ctx, invoiceID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusNotFound)
@@ -309,7 +370,7 @@ func (h *MultiPartyHandlers) HandleGetNodeInvoices(w http.ResponseWriter, r *htt
 	yearStr := r.URL.Query().Get("year")
 	monthStr := r.URL.Query().Get("month")
 
-	ctx := context.Background()
+	ctx := r.Context()
 
 	if yearStr != "" && monthStr != "" {
 		// Get specific invoice
@@ -345,7 +406,8 @@ func (h *MultiPartyHandlers) HandlePayInvoice(w http.ResponseWriter, r *http.Req
 	}
 
 	var req struct {
-		InvoiceID string `json:"invoice_id"`
+		InvoiceID        string `json:"invoice_id"`
+		PaymentReference string `json:"payment_reference"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -353,8 +415,8 @@ func (h *MultiPartyHandlers) HandlePayInvoice(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	ctx := context.Background()
-	if err := h.invoiceGen.MarkInvoicePaid(ctx, req.InvoiceID); err != nil {
+	ctx := r.Context()
+	if err := h.invoiceGen.PayInvoice(ctx, req.InvoiceID, req.PaymentReference); err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
@@ -364,7 +426,7 @@ func (h *MultiPartyHandlers) HandlePayInvoice(w http.ResponseWriter, r *http.Req
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(map[string]interface{}{
 		"status":  "success",
-		"message": "Invoice marked as paid",
+		"message": "Invoice reconciled against settlement debits and marked paid",
 		"invoice": invoice,
 	})
 }
@@ -376,7 +438,7 @@ func (h *MultiPartyHandlers) HandleGetInvoiceStats(w http.ResponseWriter, r *htt
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 	stats := h.invoiceGen.GetInvoiceStats(ctx)
 
 	w.Header().Set("Content-Type", "application/json")
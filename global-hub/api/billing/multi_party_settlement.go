@@ -3,10 +3,19 @@ package billing
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/sovrn-protocol/sovrn/chain/shared"
+	"github.com/sovrn-protocol/sovrn/hub/api/audit"
+	"github.com/sovrn-protocol/sovrn/hub/api/guard"
+	"github.com/sovrn-protocol/sovrn/hub/api/metrics"
+	"github.com/sovrn-protocol/sovrn/hub/api/pricing"
+	"github.com/sovrn-protocol/sovrn/hub/api/workerpool"
 )
 
 // EventType represents the type of verification event
@@ -36,6 +45,21 @@ type CorporateNode struct {
 	WalletID    string    `json:"wallet_id"`
 	CreatedAt   time.Time `json:"created_at"`
 	IsActive    bool      `json:"is_active"`
+	
+	// ReportingCurrency is the fiat currency the node is invoiced in (defaults to USD)
+	ReportingCurrency string `json:"reporting_currency"`
+}
+
+// DefaultReportingCurrency is used when a corporate node does not specify one
+const DefaultReportingCurrency = "USD"
+
+// FXSnapshot records the oracle exchange rate captured at settlement time
+type FXSnapshot struct {
+	Currency    string    `json:"currency"`
+	USOVPerUnit float64   `json:"usov_per_unit"` // uSOV per unit of fiat
+	Source      string    `json:"source"`
+	RateTime    time.Time `json:"rate_time"`   // When the oracle last updated the rate
+	CapturedAt  time.Time `json:"captured_at"` // When the snapshot was taken
 }
 
 // PayerAllocation represents a single payer's portion of a transaction
@@ -55,15 +79,48 @@ type TransactionContext struct {
 	TotalAmountUSOV int64              `json:"total_amount_usov"`
 	Payers          []PayerAllocation  `json:"payers"`
 	Timestamp       time.Time          `json:"timestamp"`
-	Status          string             `json:"status"` // "pending", "settled", "failed"
+	Status          string             `json:"status"` // "pending", "settled", "failed", "refunded"
 	Metadata        map[string]string  `json:"metadata,omitempty"`
+	
+	// Pricing rule in force when the transaction was created
+	AppliedRateUSOV      int64     `json:"applied_rate_usov"`
+	RateEffectiveFrom    time.Time `json:"rate_effective_from"`
+	
+	// FX rate snapshots taken at settlement, keyed by payer reporting currency
+	FXSnapshots     map[string]*FXSnapshot `json:"fx_snapshots,omitempty"`
+	
+	// Refund details (fraudulent or double-billed verifications)
+	RefundReason    string     `json:"refund_reason,omitempty"`
+	RefundedAt      *time.Time `json:"refunded_at,omitempty"`
+	
+	// Invoices that bill this transaction, keyed by payer node ID (set by InvoiceGenerator)
+	InvoiceIDs      map[string]string `json:"invoice_ids,omitempty"`
 }
 
+// Settlement transaction statuses
+const (
+	TransactionStatusPending  = "pending"
+	TransactionStatusSettled  = "settled"
+	TransactionStatusFailed   = "failed"
+	TransactionStatusRefunded = "refunded"
+)
+
+// TransactionTransitions defines the legal settlement transaction status changes
+// Failed transactions may be retried; settled transactions can only be refunded.
+var TransactionTransitions = shared.NewStateMachine("settlement transaction", map[string][]string{
+	TransactionStatusPending: {TransactionStatusSettled, TransactionStatusFailed},
+	TransactionStatusFailed:  {TransactionStatusSettled, TransactionStatusFailed},
+	TransactionStatusSettled: {TransactionStatusRefunded},
+})
+
 // EventPricingRule defines pricing for different event types
+// Rules are effective-dated: a rule applies from EffectiveFrom until the next
+// rule for the same event type takes effect.
 type EventPricingRule struct {
 	EventType      EventType `json:"event_type"`
-	BaseAmountUSOV int64     `json:"base_amount_usov"`
+	BaseAmountUSOV int64     `json:"base_amount_usov"` // Unset on genesis rules: priced from the pricing service
 	Description    string    `json:"description"`
+	EffectiveFrom  time.Time `json:"effective_from"` // Zero time = in force since genesis
 }
 
 // SplitRule defines how fees are split for dual-purpose events
@@ -78,8 +135,8 @@ type SplitRule struct {
 
 // MultiPartySettlement manages multi-party billing transactions
 type MultiPartySettlement struct {
-	// Pricing rules for different event types
-	pricingRules map[EventType]*EventPricingRule
+	// Pricing rule history per event type (sorted by EffectiveFrom, oldest first)
+	pricingRules map[EventType][]*EventPricingRule
 	
 	// Split rules for dual-purpose events
 	splitRules map[EventType]*SplitRule
@@ -93,16 +150,40 @@ type MultiPartySettlement struct {
 	
 	// Wallet manager for debiting corporate wallets
 	walletMgr *WalletManager
+	
+	// Optional hash-chained audit log for escrow movements
+	auditLog *audit.EscrowAuditLog
+	
+	// Optional price oracle for settlement-time FX snapshots
+	priceOracle *PriceOracle
+	
+	// Optional kill-switch checked before debiting payers
+	paymentGuard *guard.PaymentGuard
+	
+	// Optional metrics registry for settlement outcomes
+	metricsRegistry *metrics.Registry
+	
+	// Fee schedule genesis pricing rules are resolved from
+	pricing *pricing.PricingService
+	
+	// Bounds concurrent settlements in SettleTransactions
+	settlementPool *workerpool.Pool
+	
+	// Transactions with a settlement in progress (claimed by SettleTransaction)
+	settling map[string]bool
 }
 
 // NewMultiPartySettlement creates a new multi-party settlement engine
 func NewMultiPartySettlement(walletMgr *WalletManager) *MultiPartySettlement {
 	mps := &MultiPartySettlement{
-		pricingRules:   make(map[EventType]*EventPricingRule),
+		pricingRules:   make(map[EventType][]*EventPricingRule),
 		splitRules:     make(map[EventType]*SplitRule),
 		transactions:   make(map[string]*TransactionContext),
 		corporateNodes: make(map[string]*CorporateNode),
 		walletMgr:      walletMgr,
+		pricing:        pricing.NewPricingService(),
+		settlementPool: workerpool.New(workerpool.DefaultConcurrency),
+		settling:       make(map[string]bool),
 	}
 	
 	// Initialize default pricing rules
@@ -114,28 +195,105 @@ func NewMultiPartySettlement(walletMgr *WalletManager) *MultiPartySettlement {
 	return mps
 }
 
+// SetAuditLog attaches a hash-chained audit log that records every escrow settlement
+func (mps *MultiPartySettlement) SetAuditLog(auditLog *audit.EscrowAuditLog) {
+	mps.mu.Lock()
+	defer mps.mu.Unlock()
+
+	mps.auditLog = auditLog
+}
+
+// SetPriceOracle attaches the price oracle used to snapshot FX rates at settlement
+func (mps *MultiPartySettlement) SetPriceOracle(priceOracle *PriceOracle) {
+	mps.mu.Lock()
+	defer mps.mu.Unlock()
+
+	mps.priceOracle = priceOracle
+}
+
+// SetMetrics publishes settlement outcome metrics to the registry
+func (mps *MultiPartySettlement) SetMetrics(registry *metrics.Registry) {
+	mps.mu.Lock()
+	defer mps.mu.Unlock()
+
+	mps.metricsRegistry = registry
+}
+
+// recordSettlementOutcome counts a settlement attempt and, if settled, its volume
+// Caller must hold mps.mu
+func (mps *MultiPartySettlement) recordSettlementOutcome(outcome string, amountUSOV int64) {
+	if mps.metricsRegistry == nil {
+		return
+	}
+
+	mps.metricsRegistry.Counter("sovrn_settlements_total", "Multi-party settlement attempts by outcome", "outcome", outcome).Inc()
+	if outcome == "settled" {
+		mps.metricsRegistry.Counter("sovrn_settlement_volume_usov_total", "Total uSOV settled from corporate wallets").Add(float64(amountUSOV))
+	}
+}
+
+// SetPaymentGuard attaches the kill-switch that can halt settlement debits
+func (mps *MultiPartySettlement) SetPaymentGuard(paymentGuard *guard.PaymentGuard) {
+	mps.mu.Lock()
+	defer mps.mu.Unlock()
+
+	mps.paymentGuard = paymentGuard
+}
+
 // initializePricingRules sets up default pricing for event types
+// Genesis rules carry no amount: it is resolved from the checkpoint fees of the
+// pricing service each time the rule is applied.
 func (mps *MultiPartySettlement) initializePricingRules() {
-	// AIRPORT_CHECKPOINT: 1 SOV (1,000,000 uSOV)
-	mps.pricingRules[EventTypeAirportCheckpoint] = &EventPricingRule{
-		EventType:      EventTypeAirportCheckpoint,
-		BaseAmountUSOV: 1_000_000, // 1 SOV
-		Description:    "Security checkpoint verification - billed to airport",
-	}
+	// AIRPORT_CHECKPOINT: 1 SOV by default
+	mps.setGenesisPricingRule(EventTypeAirportCheckpoint, "Security checkpoint verification - billed to airport")
 	
-	// BOARDING_GATE: 10 SOV (10,000,000 uSOV)
-	mps.pricingRules[EventTypeBoardingGate] = &EventPricingRule{
-		EventType:      EventTypeBoardingGate,
-		BaseAmountUSOV: 10_000_000, // 10 SOV
-		Description:    "Boarding gate verification - billed to airline",
-	}
+	// BOARDING_GATE: 10 SOV by default
+	mps.setGenesisPricingRule(EventTypeBoardingGate, "Boarding gate verification - billed to airline")
 	
-	// DUAL_PURPOSE: 11 SOV total (1 + 10), split 20/80
-	mps.pricingRules[EventTypeDualPurpose] = &EventPricingRule{
-		EventType:      EventTypeDualPurpose,
-		BaseAmountUSOV: 11_000_000, // 11 SOV total
-		Description:    "Dual-purpose verification - split between airport and airline",
+	// DUAL_PURPOSE: 11 SOV total (1 + 10) by default, split 20/80
+	mps.setGenesisPricingRule(EventTypeDualPurpose, "Dual-purpose verification - split between airport and airline")
+}
+
+// setGenesisPricingRule sets the always-in-force rule for an event type
+// Caller must hold mps.mu (or be the constructor). Effective-dated rules added via SetPricingRule are kept.
+func (mps *MultiPartySettlement) setGenesisPricingRule(eventType EventType, description string) {
+	rule := &EventPricingRule{
+		EventType:   eventType,
+		Description: description,
+	}
+
+	history := mps.pricingRules[eventType]
+	if len(history) > 0 && history[0].EffectiveFrom.IsZero() {
+		history[0] = rule
+		return
+	}
+	mps.pricingRules[eventType] = append([]*EventPricingRule{rule}, history...)
+}
+
+// SetPricingService shares the hub-wide fee schedule genesis pricing rules are resolved from
+func (mps *MultiPartySettlement) SetPricingService(pricingService *pricing.PricingService) {
+	mps.mu.Lock()
+	defer mps.mu.Unlock()
+
+	mps.pricing = pricingService
+}
+
+// priceRuleLocked returns a rule with its amount filled in (caller holds mps.mu)
+// Genesis rules are priced from the pricing service's current checkpoint fee,
+// so fee schedule changes apply to the next settlement without re-wiring.
+func (mps *MultiPartySettlement) priceRuleLocked(rule *EventPricingRule) (*EventPricingRule, error) {
+	if !rule.EffectiveFrom.IsZero() {
+		return rule, nil
+	}
+
+	baseAmount, err := mps.pricing.CheckpointFee(string(rule.EventType))
+	if err != nil {
+		return nil, fmt.Errorf("no checkpoint fee for %s: %w", rule.EventType, err)
 	}
+
+	priced := *rule
+	priced.BaseAmountUSOV = baseAmount
+	return &priced, nil
 }
 
 // initializeSplitRules sets up default split rules
@@ -166,11 +324,23 @@ func (mps *MultiPartySettlement) RegisterCorporateNode(ctx context.Context, node
 	
 	node.IsActive = true
 	
+	if node.ReportingCurrency == "" {
+		node.ReportingCurrency = DefaultReportingCurrency
+	}
+	
+	// Reject currencies the oracle cannot price (settlement would fail later)
+	if mps.priceOracle != nil {
+		if _, err := mps.priceOracle.GetExchangeRate(ctx, node.ReportingCurrency); err != nil {
+			return fmt.Errorf("invalid reporting currency for node %s: %w", node.NodeID, err)
+		}
+	}
+	
 	// Create wallet for corporate node
 	walletID := fmt.Sprintf("wallet-%s", node.NodeID)
 	node.WalletID = walletID
 	
 	// Ensure corporate wallet exists (enterprise type for escrow)
+	// Under the strict creation policy the wallet must already have been created
 	_, err := mps.walletMgr.GetOrCreateWallet(ctx, walletID, "enterprise")
 	if err != nil {
 		return fmt.Errorf("failed to create wallet for node %s: %w", node.NodeID, err)
@@ -205,22 +375,26 @@ func (mps *MultiPartySettlement) CreateTransaction(
 	mps.mu.Lock()
 	defer mps.mu.Unlock()
 
-	// Get pricing rule
-	pricingRule, exists := mps.pricingRules[eventType]
-	if !exists {
-		return nil, fmt.Errorf("no pricing rule for event type: %s", eventType)
+	now := time.Now()
+
+	// Get pricing rule in force at the transaction timestamp
+	pricingRule, err := mps.resolvePricingRule(eventType, now)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create transaction context
 	txCtx := &TransactionContext{
-		TransactionID:   uuid.New().String(),
-		VerificationID:  verificationID,
-		EventType:       eventType,
-		TotalAmountUSOV: pricingRule.BaseAmountUSOV,
-		Payers:          make([]PayerAllocation, 0),
-		Timestamp:       time.Now(),
-		Status:          "pending",
-		Metadata:        make(map[string]string),
+		TransactionID:     uuid.New().String(),
+		VerificationID:    verificationID,
+		EventType:         eventType,
+		TotalAmountUSOV:   pricingRule.BaseAmountUSOV,
+		Payers:            make([]PayerAllocation, 0),
+		Timestamp:         now,
+		Status:            TransactionStatusPending,
+		Metadata:          make(map[string]string),
+		AppliedRateUSOV:   pricingRule.BaseAmountUSOV,
+		RateEffectiveFrom: pricingRule.EffectiveFrom,
 	}
 
 	// Allocate payers based on event type
@@ -292,7 +466,166 @@ func (mps *MultiPartySettlement) CreateTransaction(
 }
 
 // SettleTransaction processes payment from all payers
+//
+// SETTLEMENT LOGIC:
+// 1. Pending and failed transactions can be settled; settled ones are rejected
+// 2. The transaction is claimed, so a concurrent settlement of the same ID is refused
+// 3. Payers whose payment legs were recorded by an earlier (failed) attempt are not charged again
+// 4. Remaining payers are debited in order; a failure marks the transaction failed for retry
+// 5. Once every payer has paid the transaction is marked settled
+// FX capture and wallet debits run without holding the settlement lock, so
+// different transactions settle in parallel (see SettleTransactions).
 func (mps *MultiPartySettlement) SettleTransaction(ctx context.Context, transactionID string) error {
+	// 1-2. Validate and claim under the lock
+	mps.mu.Lock()
+	txCtx, nodes, charged, err := mps.beginSettlementLocked(transactionID)
+	if err != nil {
+		mps.mu.Unlock()
+		return err
+	}
+	walletMgr, auditLog, priceOracle := mps.walletMgr, mps.auditLog, mps.priceOracle
+	mps.mu.Unlock()
+
+	// Snapshot FX rates before debiting so invoices use the settlement-time rate
+	snapshots, err := captureFXSnapshots(ctx, priceOracle, nodes)
+	if err != nil {
+		mps.abortSettlement(txCtx)
+		return err
+	}
+
+	// 3-4. Debit each payer not yet charged
+	payments := make(map[int]*FeePayment)
+	for i, payer := range txCtx.Payers {
+		if charged[i] {
+			continue
+		}
+		node := nodes[i]
+
+		// Debit from corporate wallet (use PayPFFFeeSmart for smart escrow handling)
+		payment, err := walletMgr.PayPFFFeeSmart(ctx, node.WalletID, payer.AmountUSOV, txCtx.TransactionID)
+		if err != nil {
+			err = fmt.Errorf("failed to debit %s (%s): %w", node.Name, payer.PayerID, err)
+			mps.finishSettlement(txCtx, payments, nil, err)
+			return err
+		}
+		payments[i] = payment
+
+		// Audit escrow release for each leg of this payer's payment
+		if auditLog != nil {
+			for _, leg := range payment.Legs {
+				if _, err := auditLog.Record(audit.SourceSettlement, audit.EscrowActionRelease, transactionID, node.WalletID, leg.Amount, leg.TransactionID); err != nil {
+					fmt.Printf("Warning: failed to record escrow audit event: %v\n", err)
+				}
+			}
+		}
+	}
+
+	// 5. Mark as settled
+	mps.finishSettlement(txCtx, payments, snapshots, nil)
+
+	return nil
+}
+
+// beginSettlementLocked validates a settlement and claims the transaction (caller holds mps.mu)
+// Returns every payer's corporate node and which payers an earlier attempt
+// already charged, both indexed like txCtx.Payers.
+func (mps *MultiPartySettlement) beginSettlementLocked(transactionID string) (*TransactionContext, []*CorporateNode, []bool, error) {
+	txCtx, exists := mps.transactions[transactionID]
+	if !exists {
+		return nil, nil, nil, fmt.Errorf("transaction not found: %s", transactionID)
+	}
+
+	if txCtx.Status == TransactionStatusSettled {
+		return nil, nil, nil, fmt.Errorf("transaction already settled: %s", transactionID)
+	}
+
+	if err := TransactionTransitions.ValidateTransition(txCtx.Status, TransactionStatusSettled); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if mps.settling[transactionID] {
+		return nil, nil, nil, fmt.Errorf("transaction %s is already being settled", transactionID)
+	}
+
+	// Refuse to debit while the payment kill-switch is active (transaction stays pending)
+	if mps.paymentGuard != nil {
+		if err := mps.paymentGuard.Check(); err != nil {
+			mps.recordSettlementOutcome("halted", 0)
+			return nil, nil, nil, err
+		}
+
+		// Every payer charge must be within the fee ceiling before anyone is debited
+		for _, payer := range txCtx.Payers {
+			if err := mps.paymentGuard.CheckFee(payer.AmountUSOV); err != nil {
+				mps.recordSettlementOutcome("rejected", 0)
+				return nil, nil, nil, fmt.Errorf("payer %s: %w", payer.PayerID, err)
+			}
+		}
+	}
+
+	// Resolve every payer's corporate node up front
+	nodes := make([]*CorporateNode, len(txCtx.Payers))
+	charged := make([]bool, len(txCtx.Payers))
+	for i, payer := range txCtx.Payers {
+		node, exists := mps.corporateNodes[payer.PayerID]
+		if !exists {
+			txCtx.Status = TransactionStatusFailed
+			mps.recordSettlementOutcome("failed", 0)
+			return nil, nil, nil, fmt.Errorf("corporate node not found: %s", payer.PayerID)
+		}
+		nodes[i] = node
+		charged[i] = txCtx.Metadata[fmt.Sprintf("payer_%d_tx_ids", i)] != ""
+	}
+
+	mps.settling[transactionID] = true
+
+	return txCtx, nodes, charged, nil
+}
+
+// abortSettlement releases the claim on a settlement that failed before anyone was debited
+// The transaction keeps its status, as nothing was charged.
+func (mps *MultiPartySettlement) abortSettlement(txCtx *TransactionContext) {
+	mps.mu.Lock()
+	defer mps.mu.Unlock()
+
+	delete(mps.settling, txCtx.TransactionID)
+	mps.recordSettlementOutcome("failed", 0)
+}
+
+// finishSettlement records a settlement attempt's payments and outcome and releases the claim
+// Payments made before a failure stay recorded, so a retry does not charge those payers again.
+func (mps *MultiPartySettlement) finishSettlement(txCtx *TransactionContext, payments map[int]*FeePayment, snapshots map[string]*FXSnapshot, settleErr error) {
+	mps.mu.Lock()
+	defer mps.mu.Unlock()
+
+	delete(mps.settling, txCtx.TransactionID)
+
+	// Store every leg's transaction ID and the shared payment group in metadata
+	for i, payment := range payments {
+		txCtx.Metadata[fmt.Sprintf("payer_%d_tx_ids", i)] = strings.Join(payment.TransactionIDs(), ",")
+		txCtx.Metadata[fmt.Sprintf("payer_%d_payment_group_id", i)] = payment.PaymentGroupID
+	}
+
+	if settleErr != nil {
+		txCtx.Status = TransactionStatusFailed
+		mps.recordSettlementOutcome("failed", 0)
+		return
+	}
+
+	txCtx.FXSnapshots = snapshots
+	txCtx.Status = TransactionStatusSettled
+	mps.recordSettlementOutcome("settled", txCtx.TotalAmountUSOV)
+}
+
+// RefundTransaction credits every payer back their allocation for a settled transaction
+//
+// REFUND LOGIC:
+// 1. Only settled transactions can be refunded (refunded transactions are rejected)
+// 2. Transactions already on an invoice are refused; correct those with a credit note
+// 3. Each payment leg is credited back to the balance it was debited from (escrow or regular)
+// 4. Legs already refunded by an interrupted attempt are skipped on retry
+// 5. Transaction is marked "refunded" and excluded from future invoices
+func (mps *MultiPartySettlement) RefundTransaction(ctx context.Context, transactionID string, reason string) error {
 	mps.mu.Lock()
 	defer mps.mu.Unlock()
 
@@ -301,36 +634,119 @@ func (mps *MultiPartySettlement) SettleTransaction(ctx context.Context, transact
 		return fmt.Errorf("transaction not found: %s", transactionID)
 	}
 
-	if txCtx.Status == "settled" {
-		return fmt.Errorf("transaction already settled: %s", transactionID)
+	if txCtx.Status == TransactionStatusRefunded {
+		return fmt.Errorf("transaction already refunded: %s", transactionID)
+	}
+
+	if err := TransactionTransitions.ValidateTransition(txCtx.Status, TransactionStatusRefunded); err != nil {
+		return err
+	}
+
+	if reason == "" {
+		return fmt.Errorf("refund reason required")
+	}
+
+	// 2. Invoiced transactions are corrected with a credit note, not a refund
+	for _, payer := range txCtx.Payers {
+		if invoiceID, invoiced := txCtx.InvoiceIDs[payer.PayerID]; invoiced {
+			return fmt.Errorf("transaction %s already invoiced to %s (invoice %s): issue a credit note instead", transactionID, payer.PayerID, invoiceID)
+		}
+	}
+
+	// 3. Find every payment leg recorded at settlement
+	debits, err := mps.walletMgr.GetTransactionsByReference(ctx, txCtx.TransactionID)
+	if err != nil {
+		return fmt.Errorf("failed to load payment legs: %w", err)
+	}
+	legs := make(map[string]*WalletTransaction, len(debits))
+	for _, debit := range debits {
+		legs[debit.TransactionID] = debit
 	}
 
-	// Process each payer
 	for i, payer := range txCtx.Payers {
-		// Get corporate node
 		node, exists := mps.corporateNodes[payer.PayerID]
 		if !exists {
-			txCtx.Status = "failed"
 			return fmt.Errorf("corporate node not found: %s", payer.PayerID)
 		}
 
-		// Debit from corporate wallet (use PayPFFFeeSmart for smart escrow handling)
-		txID, err := mps.walletMgr.PayPFFFeeSmart(ctx, node.WalletID, payer.AmountUSOV)
-		if err != nil {
-			txCtx.Status = "failed"
-			return fmt.Errorf("failed to debit %s (%s): %w", node.Name, payer.PayerID, err)
+		legIDs := txCtx.Metadata[fmt.Sprintf("payer_%d_tx_ids", i)]
+		if legIDs == "" {
+			return fmt.Errorf("no payment legs recorded for %s (%s)", node.Name, payer.PayerID)
 		}
 
-		// Store transaction ID in metadata
-		txCtx.Metadata[fmt.Sprintf("payer_%d_tx_id", i)] = txID
+		for _, legID := range strings.Split(legIDs, ",") {
+			// 4. Skip legs refunded by an earlier attempt
+			refundKey := fmt.Sprintf("refund_%s", legID)
+			if _, refunded := txCtx.Metadata[refundKey]; refunded {
+				continue
+			}
+
+			leg, exists := legs[legID]
+			if !exists || leg.Type != "debit" || leg.UserID != node.WalletID {
+				return fmt.Errorf("payment leg %s for %s (%s) not found", legID, node.Name, payer.PayerID)
+			}
+
+			var txID string
+			if leg.WalletType == "escrow" {
+				txID, err = mps.walletMgr.CreditEscrow(ctx, node.WalletID, leg.Amount, "pff_fee_refund", txCtx.TransactionID)
+			} else {
+				txID, err = mps.walletMgr.CreditRegular(ctx, node.WalletID, leg.Amount, "pff_fee_refund", txCtx.TransactionID)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to refund %s (%s): %w", node.Name, payer.PayerID, err)
+			}
+
+			txCtx.Metadata[refundKey] = txID
+
+			if mps.auditLog != nil {
+				if _, err := mps.auditLog.Record(audit.SourceSettlement, audit.EscrowActionRefund, transactionID, node.WalletID, leg.Amount, txID); err != nil {
+					fmt.Printf("Warning: failed to record escrow audit event: %v\n", err)
+				}
+			}
+		}
 	}
 
-	// Mark as settled
-	txCtx.Status = "settled"
+	now := time.Now()
+	txCtx.Status = TransactionStatusRefunded
+	txCtx.RefundReason = reason
+	txCtx.RefundedAt = &now
+	mps.recordSettlementOutcome("refunded", txCtx.TotalAmountUSOV)
 
 	return nil
 }
 
+// captureFXSnapshots records the oracle rate for each payer's reporting currency
+// Returns nil snapshots when no price oracle is configured
+func captureFXSnapshots(ctx context.Context, priceOracle *PriceOracle, nodes []*CorporateNode) (map[string]*FXSnapshot, error) {
+	if priceOracle == nil {
+		return nil, nil
+	}
+
+	capturedAt := time.Now()
+	snapshots := make(map[string]*FXSnapshot)
+
+	for _, node := range nodes {
+		if _, captured := snapshots[node.ReportingCurrency]; captured {
+			continue
+		}
+
+		rate, err := priceOracle.GetExchangeRate(ctx, node.ReportingCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to snapshot %s rate for %s: %w", node.ReportingCurrency, node.NodeID, err)
+		}
+
+		snapshots[node.ReportingCurrency] = &FXSnapshot{
+			Currency:    rate.Currency,
+			USOVPerUnit: rate.USOVPerUnit,
+			Source:      rate.Source,
+			RateTime:    rate.LastUpdated,
+			CapturedAt:  capturedAt,
+		}
+	}
+
+	return snapshots, nil
+}
+
 // GetTransaction retrieves a transaction by ID
 func (mps *MultiPartySettlement) GetTransaction(ctx context.Context, transactionID string) (*TransactionContext, error) {
 	mps.mu.RLock()
@@ -363,30 +779,128 @@ func (mps *MultiPartySettlement) GetNodeTransactions(ctx context.Context, nodeID
 	return transactions, nil
 }
 
-// GetPricingRule returns the pricing rule for an event type
+// GetPricingRule returns the pricing rule currently in force for an event type
 func (mps *MultiPartySettlement) GetPricingRule(eventType EventType) (*EventPricingRule, error) {
+	return mps.GetPricingRuleAt(eventType, time.Now())
+}
+
+// GetPricingRuleAt returns the pricing rule that was in force at the given time
+func (mps *MultiPartySettlement) GetPricingRuleAt(eventType EventType, at time.Time) (*EventPricingRule, error) {
 	mps.mu.RLock()
 	defer mps.mu.RUnlock()
 
-	rule, exists := mps.pricingRules[eventType]
-	if !exists {
-		return nil, fmt.Errorf("no pricing rule for event type: %s", eventType)
-	}
-
-	return rule, nil
+	return mps.resolvePricingRule(eventType, at)
 }
 
-// GetAllPricingRules returns all pricing rules
+// GetAllPricingRules returns the pricing rules currently in force
 func (mps *MultiPartySettlement) GetAllPricingRules() map[EventType]*EventPricingRule {
 	mps.mu.RLock()
 	defer mps.mu.RUnlock()
 
+	now := time.Now()
 	rules := make(map[EventType]*EventPricingRule)
-	for k, v := range mps.pricingRules {
-		rules[k] = v
+	for eventType := range mps.pricingRules {
+		if rule, err := mps.resolvePricingRule(eventType, now); err == nil {
+			rules[eventType] = rule
+		}
 	}
 
 	return rules
 }
 
+// GetPricingHistory returns every pricing rule for an event type, oldest first
+func (mps *MultiPartySettlement) GetPricingHistory(eventType EventType) ([]*EventPricingRule, error) {
+	mps.mu.RLock()
+	defer mps.mu.RUnlock()
+
+	history, exists := mps.pricingRules[eventType]
+	if !exists {
+		return nil, fmt.Errorf("no pricing rule for event type: %s", eventType)
+	}
+
+	rules := make([]*EventPricingRule, 0, len(history))
+	for _, rule := range history {
+		priced, err := mps.priceRuleLocked(rule)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, priced)
+	}
+
+	return rules, nil
+}
+
+// SetPricingRule adds an effective-dated pricing rule for an event type
+// Earlier rules are kept so past transactions can still be priced at their rate.
+// A zero EffectiveFrom takes effect immediately; a rule with the same
+// EffectiveFrom as an existing one replaces it.
+func (mps *MultiPartySettlement) SetPricingRule(rule *EventPricingRule) error {
+	mps.mu.Lock()
+	defer mps.mu.Unlock()
 
+	if rule.EventType == "" {
+		return fmt.Errorf("event type required")
+	}
+
+	if rule.BaseAmountUSOV <= 0 {
+		return fmt.Errorf("base amount must be positive")
+	}
+
+	if rule.EffectiveFrom.IsZero() {
+		rule.EffectiveFrom = time.Now()
+	}
+
+	history := mps.pricingRules[rule.EventType]
+
+	// Insert keeping history sorted by EffectiveFrom
+	idx := sort.Search(len(history), func(i int) bool {
+		return !history[i].EffectiveFrom.Before(rule.EffectiveFrom)
+	})
+	if idx < len(history) && history[idx].EffectiveFrom.Equal(rule.EffectiveFrom) {
+		history[idx] = rule
+	} else {
+		history = append(history, nil)
+		copy(history[idx+1:], history[idx:])
+		history[idx] = rule
+	}
+
+	mps.pricingRules[rule.EventType] = history
+
+	return nil
+}
+
+// resolvePricingRule returns the latest rule whose EffectiveFrom is not after the given time
+// Genesis rules are priced from the pricing service at call time.
+// Caller must hold mps.mu
+func (mps *MultiPartySettlement) resolvePricingRule(eventType EventType, at time.Time) (*EventPricingRule, error) {
+	history, exists := mps.pricingRules[eventType]
+	if !exists || len(history) == 0 {
+		return nil, fmt.Errorf("no pricing rule for event type: %s", eventType)
+	}
+
+	for i := len(history) - 1; i >= 0; i-- {
+		if !history[i].EffectiveFrom.After(at) {
+			return mps.priceRuleLocked(history[i])
+		}
+	}
+
+	return nil, fmt.Errorf("no pricing rule for event type %s in force at %s", eventType, at.Format(time.RFC3339))
+}
+
+// recordInvoice marks transactions as billed to a node on an invoice (called by InvoiceGenerator)
+// Invoiced transactions can no longer be refunded.
+func (mps *MultiPartySettlement) recordInvoice(nodeID string, invoiceID string, transactionIDs []string) {
+	mps.mu.Lock()
+	defer mps.mu.Unlock()
+
+	for _, transactionID := range transactionIDs {
+		txCtx, exists := mps.transactions[transactionID]
+		if !exists {
+			continue
+		}
+		if txCtx.InvoiceIDs == nil {
+			txCtx.InvoiceIDs = make(map[string]string)
+		}
+		txCtx.InvoiceIDs[nodeID] = invoiceID
+	}
+}
@@ -0,0 +1,136 @@
+package billing
+
+import (
+	"context"
+	"testing"
+)
+
+// newTestSettlement returns a settlement engine with one airport and one airline node
+func newTestSettlement(t *testing.T) (*MultiPartySettlement, *WalletManager) {
+	t.Helper()
+
+	ctx := context.Background()
+	walletMgr := NewWalletManager()
+	mps := NewMultiPartySettlement(walletMgr)
+
+	for _, node := range []*CorporateNode{
+		{NodeID: "airport-lhr", NodeType: NodeTypeAirport, Name: "Heathrow"},
+		{NodeID: "airline-ba", NodeType: NodeTypeAirline, Name: "British Airways"},
+	} {
+		if err := mps.RegisterCorporateNode(ctx, node); err != nil {
+			t.Fatalf("RegisterCorporateNode(%s): %v", node.NodeID, err)
+		}
+	}
+
+	return mps, walletMgr
+}
+
+// fundNode credits a corporate node's wallet
+func fundNode(t *testing.T, walletMgr *WalletManager, nodeID string, amount int64) {
+	t.Helper()
+
+	if _, err := walletMgr.CreditRegular(context.Background(), "wallet-"+nodeID, amount, "fiat_purchase", "test-funding"); err != nil {
+		t.Fatalf("CreditRegular(%s): %v", nodeID, err)
+	}
+}
+
+// nodeBalance returns a corporate node's total wallet balance
+func nodeBalance(t *testing.T, walletMgr *WalletManager, nodeID string) int64 {
+	t.Helper()
+
+	wallet, err := walletMgr.GetWallet(context.Background(), "wallet-"+nodeID)
+	if err != nil {
+		t.Fatalf("GetWallet(%s): %v", nodeID, err)
+	}
+	return wallet.TotalBalance
+}
+
+func TestSettleTransactionRetryDoesNotDoubleDebit(t *testing.T) {
+	ctx := context.Background()
+	mps, walletMgr := newTestSettlement(t)
+
+	txCtx, err := mps.CreateTransaction(ctx, "verification-1", EventTypeDualPurpose, "airport-lhr", "airline-ba")
+	if err != nil {
+		t.Fatalf("CreateTransaction: %v", err)
+	}
+	airlineShare, airportShare := txCtx.Payers[0].AmountUSOV, txCtx.Payers[1].AmountUSOV
+
+	// Only the airline (first payer) can pay, so the first attempt fails on the airport
+	fundNode(t, walletMgr, "airline-ba", airlineShare)
+
+	if err := mps.SettleTransaction(ctx, txCtx.TransactionID); err == nil {
+		t.Fatal("SettleTransaction succeeded with an unfunded airport")
+	}
+	if txCtx.Status != TransactionStatusFailed {
+		t.Fatalf("status after failed attempt = %q, want %q", txCtx.Status, TransactionStatusFailed)
+	}
+	if got := nodeBalance(t, walletMgr, "airline-ba"); got != 0 {
+		t.Fatalf("airline balance after first attempt = %d, want 0", got)
+	}
+
+	// Retry once the airport is funded; the airline must not be charged again
+	fundNode(t, walletMgr, "airline-ba", airlineShare)
+	fundNode(t, walletMgr, "airport-lhr", airportShare)
+
+	if err := mps.SettleTransaction(ctx, txCtx.TransactionID); err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if txCtx.Status != TransactionStatusSettled {
+		t.Fatalf("status after retry = %q, want %q", txCtx.Status, TransactionStatusSettled)
+	}
+	if got := nodeBalance(t, walletMgr, "airline-ba"); got != airlineShare {
+		t.Errorf("airline balance after retry = %d, want %d (charged twice)", got, airlineShare)
+	}
+	if got := nodeBalance(t, walletMgr, "airport-lhr"); got != 0 {
+		t.Errorf("airport balance after retry = %d, want 0", got)
+	}
+
+	// A settled transaction cannot be settled again
+	if err := mps.SettleTransaction(ctx, txCtx.TransactionID); err == nil {
+		t.Error("settling a settled transaction succeeded")
+	}
+}
+
+func TestSettleTransactionsChargesEachTransactionOnce(t *testing.T) {
+	ctx := context.Background()
+	mps, walletMgr := newTestSettlement(t)
+
+	const count = 25
+	ids := make([]string, count)
+	var total int64
+	for i := range ids {
+		txCtx, err := mps.CreateTransaction(ctx, "verification", EventTypeBoardingGate, "", "airline-ba")
+		if err != nil {
+			t.Fatalf("CreateTransaction: %v", err)
+		}
+		ids[i] = txCtx.TransactionID
+		total += txCtx.TotalAmountUSOV
+	}
+
+	fundNode(t, walletMgr, "airline-ba", total)
+
+	// The same batch submitted twice at once: every transaction settles exactly once
+	results := make(chan []error, 2)
+	for i := 0; i < 2; i++ {
+		go func() { results <- mps.SettleTransactions(ctx, ids) }()
+	}
+	first, second := <-results, <-results
+
+	for i, id := range ids {
+		if (first[i] == nil) == (second[i] == nil) {
+			t.Errorf("transaction %s: errors %v and %v, want exactly one success", id, first[i], second[i])
+		}
+
+		txCtx, err := mps.GetTransaction(ctx, id)
+		if err != nil {
+			t.Fatalf("GetTransaction: %v", err)
+		}
+		if txCtx.Status != TransactionStatusSettled {
+			t.Errorf("transaction %s status = %q, want %q", id, txCtx.Status, TransactionStatusSettled)
+		}
+	}
+
+	if got := nodeBalance(t, walletMgr, "airline-ba"); got != 0 {
+		t.Errorf("airline balance = %d, want 0", got)
+	}
+}
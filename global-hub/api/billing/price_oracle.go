@@ -3,7 +3,9 @@ package billing
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"math/rand"
+	"strconv"
 	"sync"
 	"time"
 )
@@ -91,10 +93,51 @@ func (po *PriceOracle) CalculateSOVAmount(ctx context.Context, currency string,
 		return 0, err
 	}
 
-	// Calculate uSOV amount
-	uSOVAmount := fiatAmount * rate.USOVPerUnit
+	return ConvertFiatToUSOV(fiatAmount, rate.USOVPerUnit)
+}
+
+// ConvertFiatToUSOV converts a fiat amount to uSOV using exact decimal arithmetic
+//
+// ROUNDING RULE:
+// 1. Both operands are taken at their shortest decimal representation
+//    (e.g., 0.29 is treated as exactly 29/100, not 0.28999999999999998)
+// 2. The exact product is rounded DOWN (toward zero) to a whole uSOV
+//    so a purchase never credits more than was paid for
+//
+// The same inputs therefore always produce the same uSOV amount.
+func ConvertFiatToUSOV(fiatAmount float64, usovPerUnit float64) (int64, error) {
+	if fiatAmount < 0 || usovPerUnit < 0 {
+		return 0, fmt.Errorf("fiat amount and rate must not be negative")
+	}
+
+	amount, err := decimalRat(fiatAmount)
+	if err != nil {
+		return 0, fmt.Errorf("invalid fiat amount: %w", err)
+	}
 
-	return int64(uSOVAmount), nil
+	rate, err := decimalRat(usovPerUnit)
+	if err != nil {
+		return 0, fmt.Errorf("invalid exchange rate: %w", err)
+	}
+
+	product := new(big.Rat).Mul(amount, rate)
+
+	// Round down: integer division of numerator by denominator
+	uSOV := new(big.Int).Quo(product.Num(), product.Denom())
+	if !uSOV.IsInt64() {
+		return 0, fmt.Errorf("uSOV amount overflows int64")
+	}
+
+	return uSOV.Int64(), nil
+}
+
+// decimalRat returns the exact rational value of a float's shortest decimal form
+func decimalRat(value float64) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(value, 'f', -1, 64))
+	if !ok {
+		return nil, fmt.Errorf("cannot represent %v as a decimal", value)
+	}
+	return r, nil
 }
 
 // CalculateFiatAmount calculates how much fiat is needed for a SOV amount
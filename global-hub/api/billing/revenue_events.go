@@ -16,6 +16,7 @@ type BillingEvent struct {
 	AmountUSOV     int64     `json:"amount_usov"`
 	VerificationID string    `json:"verification_id"`
 	CheckpointType string    `json:"checkpoint_type"`
+	Lane           string    `json:"lane,omitempty"` // Fast-track lane, if charged at a lane rate
 	Timestamp      time.Time `json:"timestamp"`
 	Status         string    `json:"status"` // "pending", "charged", "failed"
 }
@@ -56,6 +57,22 @@ func (re *RevenueEventEngine) CreateBillingEvent(
 	verificationID string,
 	checkpointType string,
 ) (*BillingEvent, error) {
+	return re.CreateLaneBillingEvent(ctx, carrierID, verificationID, checkpointType, "", re.GetStandardCharge())
+}
+
+// CreateLaneBillingEvent creates a billing event charged at a fast-track lane's rate
+func (re *RevenueEventEngine) CreateLaneBillingEvent(
+	ctx context.Context,
+	carrierID string,
+	verificationID string,
+	checkpointType string,
+	lane string,
+	amountUSOV int64,
+) (*BillingEvent, error) {
+	if amountUSOV < 0 {
+		return nil, fmt.Errorf("billing amount cannot be negative: %d", amountUSOV)
+	}
+	
 	re.mu.Lock()
 	defer re.mu.Unlock()
 	
@@ -63,9 +80,10 @@ func (re *RevenueEventEngine) CreateBillingEvent(
 	event := &BillingEvent{
 		EventID:        uuid.New().String(),
 		CarrierID:      carrierID,
-		AmountUSOV:     re.standardCharge, // 1 SOV
+		AmountUSOV:     amountUSOV,
 		VerificationID: verificationID,
 		CheckpointType: checkpointType,
+		Lane:           lane,
 		Timestamp:      time.Now(),
 		Status:         "pending",
 	}
@@ -74,7 +92,7 @@ func (re *RevenueEventEngine) CreateBillingEvent(
 	re.events[event.EventID] = event
 	
 	// Update carrier balance
-	re.carrierBalances[carrierID] += re.standardCharge
+	re.carrierBalances[carrierID] += amountUSOV
 	
 	// Trigger event handlers asynchronously
 	go re.triggerEventHandlers(event)
@@ -113,6 +131,14 @@ func (re *RevenueEventEngine) UpdateEventStatus(
 	return nil
 }
 
+// GetStandardCharge returns the charge applied per verification in uSOV
+func (re *RevenueEventEngine) GetStandardCharge() int64 {
+	re.mu.RLock()
+	defer re.mu.RUnlock()
+	
+	return re.standardCharge
+}
+
 // GetCarrierBalance returns the outstanding balance for a carrier
 func (re *RevenueEventEngine) GetCarrierBalance(ctx context.Context, carrierID string) int64 {
 	re.mu.RLock()
@@ -139,6 +165,19 @@ func (re *RevenueEventEngine) GetCarrierEvents(
 	return events, nil
 }
 
+// GetAllBillingEvents returns every billing event (used for reconciliation)
+func (re *RevenueEventEngine) GetAllBillingEvents(ctx context.Context) ([]*BillingEvent, error) {
+	re.mu.RLock()
+	defer re.mu.RUnlock()
+	
+	events := make([]*BillingEvent, 0, len(re.events))
+	for _, event := range re.events {
+		events = append(events, event)
+	}
+	
+	return events, nil
+}
+
 // GetRevenueStats returns revenue statistics
 func (re *RevenueEventEngine) GetRevenueStats(ctx context.Context) map[string]interface{} {
 	re.mu.RLock()
@@ -35,7 +35,9 @@ CREATE TABLE wallet_transactions (
   amount BIGINT NOT NULL,                     -- uSOV
   balance_before BIGINT NOT NULL,
   balance_after BIGINT NOT NULL,
-  purpose TEXT NOT NULL,                      -- 'fiat_purchase', 'pff_fee', 'withdrawal', etc.
+  purpose TEXT NOT NULL,                      -- Registered purpose (DefaultWalletPurposes), e.g. 'fiat_purchase', 'pff_fee'
+  reference TEXT,                             -- Originating event ID (contract, settlement tx, verification)
+  payment_group_id TEXT,                      -- Shared by the escrow and regular legs of one split PFF fee payment
   metadata JSONB,                             -- Additional transaction data
   timestamp TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
   status TEXT NOT NULL DEFAULT 'success' CHECK (status IN ('success', 'failed', 'pending')),
@@ -48,6 +50,8 @@ CREATE INDEX idx_transactions_user_id ON wallet_transactions(user_id);
 CREATE INDEX idx_transactions_timestamp ON wallet_transactions(timestamp DESC);
 CREATE INDEX idx_transactions_purpose ON wallet_transactions(purpose);
 CREATE INDEX idx_transactions_wallet_type ON wallet_transactions(wallet_type);
+CREATE INDEX idx_transactions_reference ON wallet_transactions(reference);
+CREATE INDEX idx_transactions_payment_group ON wallet_transactions(payment_group_id);
 
 -- ============================================================================
 -- Purchase Orders
@@ -279,15 +283,27 @@ CREATE TABLE monthly_invoices (
   total_amount_usov BIGINT NOT NULL,
   total_sov DECIMAL(20, 6) NOT NULL,
   total_transactions INT NOT NULL,
-  status TEXT NOT NULL DEFAULT 'finalized' CHECK (status IN ('draft', 'finalized', 'paid')),
+  status TEXT NOT NULL DEFAULT 'finalized' CHECK (status IN ('draft', 'finalized', 'paid', 'superseded')),
   generated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
   due_date TIMESTAMP,
   paid_at TIMESTAMP,
-
-  CONSTRAINT unique_node_period UNIQUE (node_id, billing_period),
-  CONSTRAINT positive_total CHECK (total_amount_usov >= 0)
+  payment_reference TEXT, -- Settling payment reference (idempotent re-marking)
+
+  -- Versioning (regenerated invoices supersede prior versions, which are kept for audit)
+  version INT NOT NULL DEFAULT 1,
+  previous_invoice_id TEXT REFERENCES monthly_invoices(invoice_id),
+  superseded_by TEXT REFERENCES monthly_invoices(invoice_id),
+  superseded_at TIMESTAMP,
+
+  CONSTRAINT unique_node_period_version UNIQUE (node_id, billing_period, version),
+  CONSTRAINT positive_total CHECK (total_amount_usov >= 0),
+  CONSTRAINT positive_version CHECK (version >= 1),
+  CONSTRAINT superseded_consistency CHECK ((status = 'superseded') = (superseded_by IS NOT NULL))
 );
 
+-- One current (non-superseded) invoice per node and period
+CREATE UNIQUE INDEX idx_invoices_current ON monthly_invoices(node_id, billing_period) WHERE status <> 'superseded';
+
 CREATE INDEX idx_invoices_node ON monthly_invoices(node_id);
 CREATE INDEX idx_invoices_period ON monthly_invoices(billing_period);
 CREATE INDEX idx_invoices_status ON monthly_invoices(status);
@@ -344,7 +360,7 @@ SELECT
 FROM corporate_nodes cn
 LEFT JOIN payer_allocations pa ON cn.node_id = pa.payer_id
 LEFT JOIN multi_party_transactions mpt ON pa.transaction_id = mpt.transaction_id
-LEFT JOIN monthly_invoices mi ON cn.node_id = mi.node_id
+LEFT JOIN monthly_invoices mi ON cn.node_id = mi.node_id AND mi.status <> 'superseded'
 GROUP BY cn.node_id, cn.name, cn.node_type, cn.iata_code;
 
 -- Transaction summary by event type
@@ -0,0 +1,329 @@
+package billing
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Wallet transaction log compaction
+//
+// Every credit and debit stays in WalletManager.transactions, which history
+// and reference queries scan in full. Compaction moves transactions older than
+// a cutoff into a WalletArchive and snapshots each user's balances, keeping the
+// hot map bounded. Wallet balances are not touched, and history and reference
+// queries read the archive transparently for older ranges.
+
+// Compaction defaults for StartCompaction
+const (
+	DefaultCompactionInterval  = 24 * time.Hour
+	DefaultCompactionRetention = 90 * 24 * time.Hour // Transactions younger than this stay hot
+)
+
+// WalletSnapshot is a user's balances as of their last archived transactions
+type WalletSnapshot struct {
+	UserID           string    `json:"user_id"`
+	RegularBalance   int64     `json:"regular_balance"`   // uSOV after the last archived regular transaction
+	EscrowBalance    int64     `json:"escrow_balance"`    // uSOV after the last archived escrow transaction
+	AsOf             time.Time `json:"as_of"`             // Timestamp of the newest archived successful transaction
+	TransactionCount int       `json:"transaction_count"` // Transactions archived for the user so far
+	TakenAt          time.Time `json:"taken_at"`
+}
+
+// WalletArchive is cold storage for compacted wallet transactions and balance snapshots
+// Archived transactions are immutable; ArchiveTransactions skips IDs already archived.
+type WalletArchive interface {
+	// ArchiveTransactions stores transactions removed from the hot map
+	ArchiveTransactions(ctx context.Context, txs []*WalletTransaction) error
+
+	// ListArchivedTransactions returns a user's archived transactions ("" = every user)
+	ListArchivedTransactions(ctx context.Context, userID string) ([]*WalletTransaction, error)
+
+	// PutSnapshot creates or replaces a user's balance snapshot
+	PutSnapshot(ctx context.Context, snapshot *WalletSnapshot) error
+
+	// GetSnapshot returns a user's balance snapshot, or false if none exists
+	GetSnapshot(ctx context.Context, userID string) (*WalletSnapshot, bool, error)
+}
+
+// MemoryWalletArchive keeps archived transactions and snapshots in memory
+// Plug in a database or object store by implementing WalletArchive.
+type MemoryWalletArchive struct {
+	transactions map[string]*WalletTransaction
+	snapshots    map[string]*WalletSnapshot
+	mu           sync.RWMutex
+}
+
+// NewMemoryWalletArchive creates an empty in-memory archive
+func NewMemoryWalletArchive() *MemoryWalletArchive {
+	return &MemoryWalletArchive{
+		transactions: make(map[string]*WalletTransaction),
+		snapshots:    make(map[string]*WalletSnapshot),
+	}
+}
+
+// ArchiveTransactions stores transactions (already archived IDs are skipped)
+func (ma *MemoryWalletArchive) ArchiveTransactions(ctx context.Context, txs []*WalletTransaction) error {
+	ma.mu.Lock()
+	defer ma.mu.Unlock()
+
+	for _, tx := range txs {
+		if _, exists := ma.transactions[tx.TransactionID]; !exists {
+			ma.transactions[tx.TransactionID] = tx
+		}
+	}
+	return nil
+}
+
+// ListArchivedTransactions returns a user's archived transactions ("" = every user)
+func (ma *MemoryWalletArchive) ListArchivedTransactions(ctx context.Context, userID string) ([]*WalletTransaction, error) {
+	ma.mu.RLock()
+	defer ma.mu.RUnlock()
+
+	txs := make([]*WalletTransaction, 0)
+	for _, tx := range ma.transactions {
+		if userID == "" || tx.UserID == userID {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+// PutSnapshot creates or replaces a user's balance snapshot
+func (ma *MemoryWalletArchive) PutSnapshot(ctx context.Context, snapshot *WalletSnapshot) error {
+	ma.mu.Lock()
+	defer ma.mu.Unlock()
+
+	ma.snapshots[snapshot.UserID] = snapshot
+	return nil
+}
+
+// GetSnapshot returns a user's balance snapshot
+func (ma *MemoryWalletArchive) GetSnapshot(ctx context.Context, userID string) (*WalletSnapshot, bool, error) {
+	ma.mu.RLock()
+	defer ma.mu.RUnlock()
+
+	snapshot, exists := ma.snapshots[userID]
+	return snapshot, exists, nil
+}
+
+// CompactionResult summarizes a compaction run
+type CompactionResult struct {
+	Cutoff    time.Time `json:"cutoff"`
+	Archived  int       `json:"archived"`  // Transactions moved to the archive
+	Snapshots int       `json:"snapshots"` // Users whose balance snapshot was updated
+	Retained  int       `json:"retained"`  // Transactions left in the hot map
+}
+
+// SetTransactionArchive sets the archive that compaction moves old transactions into
+func (wm *WalletManager) SetTransactionArchive(archive WalletArchive) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	wm.archive = archive
+}
+
+// CompactTransactions archives every transaction older than cutoff
+//
+// COMPACTION LOGIC:
+// 1. Select hot transactions with Timestamp before cutoff
+// 2. Append them to the archive (oldest first)
+// 3. Update each affected user's snapshot from their last archived successful regular and escrow transactions
+// 4. Remove them from the hot map
+// Runs under the manager lock, so no wallet operation interleaves with a run.
+func (wm *WalletManager) CompactTransactions(ctx context.Context, cutoff time.Time) (*CompactionResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("compaction cancelled: %w", err)
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	if wm.archive == nil {
+		return nil, fmt.Errorf("no transaction archive configured")
+	}
+
+	// 1. Select
+	old := make([]*WalletTransaction, 0)
+	for _, tx := range wm.transactions {
+		if tx.Timestamp.Before(cutoff) {
+			old = append(old, tx)
+		}
+	}
+
+	result := &CompactionResult{Cutoff: cutoff, Retained: len(wm.transactions) - len(old)}
+	if len(old) == 0 {
+		return result, nil
+	}
+
+	sort.Slice(old, func(i, j int) bool {
+		if old[i].Timestamp.Equal(old[j].Timestamp) {
+			return old[i].TransactionID < old[j].TransactionID
+		}
+		return old[i].Timestamp.Before(old[j].Timestamp)
+	})
+
+	// 2. Archive
+	if err := wm.archive.ArchiveTransactions(ctx, old); err != nil {
+		return nil, fmt.Errorf("failed to archive transactions: %w", err)
+	}
+
+	// 3. Snapshots
+	snapshots, err := wm.snapshotLocked(ctx, old)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Prune the hot map
+	for _, tx := range old {
+		delete(wm.transactions, tx.TransactionID)
+	}
+	wm.archivedCount += len(old)
+
+	result.Archived = len(old)
+	result.Snapshots = snapshots
+	return result, nil
+}
+
+// snapshotLocked folds newly archived transactions (oldest first) into each user's snapshot (caller holds wm.mu)
+func (wm *WalletManager) snapshotLocked(ctx context.Context, archived []*WalletTransaction) (int, error) {
+	snapshots := make(map[string]*WalletSnapshot)
+	for _, tx := range archived {
+		snapshot, exists := snapshots[tx.UserID]
+		if !exists {
+			previous, found, err := wm.archive.GetSnapshot(ctx, tx.UserID)
+			if err != nil {
+				return 0, fmt.Errorf("failed to load snapshot for user %s: %w", tx.UserID, err)
+			}
+			snapshot = &WalletSnapshot{UserID: tx.UserID}
+			if found {
+				copied := *previous
+				snapshot = &copied
+			}
+			snapshots[tx.UserID] = snapshot
+		}
+
+		if tx.Status != "success" || tx.Timestamp.Before(snapshot.AsOf) {
+			continue
+		}
+		if tx.WalletType == "escrow" {
+			snapshot.EscrowBalance = tx.BalanceAfter
+		} else {
+			snapshot.RegularBalance = tx.BalanceAfter
+		}
+		snapshot.AsOf = tx.Timestamp
+	}
+
+	now := time.Now()
+	for userID, snapshot := range snapshots {
+		// Count from the archive rather than incrementing, so rerunning a
+		// partially failed compaction does not count its transactions twice
+		archivedTxs, err := wm.archive.ListArchivedTransactions(ctx, userID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count archived transactions for user %s: %w", userID, err)
+		}
+		snapshot.TransactionCount = len(archivedTxs)
+		snapshot.TakenAt = now
+		if err := wm.archive.PutSnapshot(ctx, snapshot); err != nil {
+			return 0, fmt.Errorf("failed to store snapshot for user %s: %w", userID, err)
+		}
+	}
+
+	return len(snapshots), nil
+}
+
+// GetBalanceSnapshot returns a user's balances as of their last archived transactions
+func (wm *WalletManager) GetBalanceSnapshot(ctx context.Context, userID string) (*WalletSnapshot, error) {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	if wm.archive == nil {
+		return nil, fmt.Errorf("no transaction archive configured")
+	}
+
+	snapshot, exists, err := wm.archive.GetSnapshot(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load snapshot for user %s: %w", userID, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("no snapshot for user: %s", userID)
+	}
+
+	return snapshot, nil
+}
+
+// StartCompaction compacts every interval, archiving transactions older than retention
+// Zero values use DefaultCompactionInterval and DefaultCompactionRetention.
+func (wm *WalletManager) StartCompaction(interval time.Duration, retention time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultCompactionInterval
+	}
+	if retention <= 0 {
+		retention = DefaultCompactionRetention
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	if wm.archive == nil {
+		return fmt.Errorf("no transaction archive configured")
+	}
+	if wm.stopCompaction != nil {
+		return fmt.Errorf("compaction already running")
+	}
+
+	stop := make(chan struct{})
+	wm.stopCompaction = stop
+	go wm.compactionLoop(stop, interval, retention)
+
+	return nil
+}
+
+// StopCompaction stops the loop started by StartCompaction
+func (wm *WalletManager) StopCompaction() {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	if wm.stopCompaction != nil {
+		close(wm.stopCompaction)
+		wm.stopCompaction = nil
+	}
+}
+
+// compactionLoop runs CompactTransactions every interval until stop is closed
+func (wm *WalletManager) compactionLoop(stop <-chan struct{}, interval time.Duration, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			result, err := wm.CompactTransactions(context.Background(), time.Now().Add(-retention))
+			if err != nil {
+				fmt.Printf("Warning: wallet transaction compaction failed: %v\n", err)
+				continue
+			}
+			if result.Archived > 0 {
+				fmt.Printf("✅ Archived %d wallet transactions older than %s (%d kept hot)\n",
+					result.Archived, result.Cutoff.Format(time.RFC3339), result.Retained)
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// archivedTransactionsLocked returns a user's archived transactions ("" = every user), none without an archive (caller holds wm.mu)
+func (wm *WalletManager) archivedTransactionsLocked(ctx context.Context, userID string) ([]*WalletTransaction, error) {
+	if wm.archive == nil {
+		return nil, nil
+	}
+
+	txs, err := wm.archive.ListArchivedTransactions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived transactions: %w", err)
+	}
+	return txs, nil
+}
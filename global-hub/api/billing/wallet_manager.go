@@ -3,10 +3,21 @@ package billing
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/historycursor"
+)
+
+// WalletCreationPolicy controls whether missing wallets are created on demand
+type WalletCreationPolicy string
+
+const (
+	WalletCreationLenient WalletCreationPolicy = "lenient" // Missing wallets are auto-created (default)
+	WalletCreationStrict  WalletCreationPolicy = "strict"  // Missing wallets are an error; use CreateWallet
 )
 
 // WalletManager manages Sovereign Wallets with regular and escrow balances
@@ -16,6 +27,18 @@ type WalletManager struct {
 
 	// Transaction history
 	transactions map[string]*WalletTransaction
+
+	// Wallet creation policy (lenient or strict)
+	creationPolicy WalletCreationPolicy
+
+	// Registered transaction purposes and whether others are rejected (see wallet_purposes.go)
+	purposes      map[string]bool
+	purposePolicy PurposePolicy
+
+	// Transaction log compaction (see wallet_compaction.go)
+	archive        WalletArchive // Cold storage for compacted transactions (nil = no compaction)
+	archivedCount  int           // Transactions this manager has archived
+	stopCompaction chan struct{} // Closes the StartCompaction loop
 }
 
 // SovereignWallet represents a user's wallet with regular and escrow balances
@@ -39,6 +62,8 @@ type WalletTransaction struct {
 	BalanceBefore   int64     `json:"balance_before"`
 	BalanceAfter    int64     `json:"balance_after"`
 	Purpose         string    `json:"purpose"`         // "fiat_purchase", "pff_fee", "withdrawal", etc.
+	Reference       string    `json:"reference,omitempty"` // Originating event ID (contract, settlement transaction, verification, swap request)
+	PaymentGroupID  string    `json:"payment_group_id,omitempty"` // Shared by every leg of one PayPFFFeeSmart payment
 	Metadata        map[string]interface{} `json:"metadata,omitempty"`
 	Timestamp       time.Time `json:"timestamp"`
 	Status          string    `json:"status"`          // "success", "failed", "pending"
@@ -46,13 +71,56 @@ type WalletTransaction struct {
 
 // NewWalletManager creates a new wallet manager
 func NewWalletManager() *WalletManager {
+	purposes := make(map[string]bool, len(DefaultWalletPurposes))
+	for _, purpose := range DefaultWalletPurposes {
+		purposes[purpose] = true
+	}
+
 	return &WalletManager{
-		wallets:      make(map[string]*SovereignWallet),
-		transactions: make(map[string]*WalletTransaction),
+		wallets:        make(map[string]*SovereignWallet),
+		transactions:   make(map[string]*WalletTransaction),
+		creationPolicy: WalletCreationLenient,
+		purposes:       purposes,
+		purposePolicy:  PurposePolicyStrict,
 	}
 }
 
+// SetCreationPolicy sets whether missing wallets are auto-created (lenient) or rejected (strict)
+func (wm *WalletManager) SetCreationPolicy(policy WalletCreationPolicy) error {
+	if policy != WalletCreationLenient && policy != WalletCreationStrict {
+		return fmt.Errorf("invalid wallet creation policy: %s", policy)
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	wm.creationPolicy = policy
+	return nil
+}
+
+// GetCreationPolicy returns the current wallet creation policy
+func (wm *WalletManager) GetCreationPolicy() WalletCreationPolicy {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	return wm.creationPolicy
+}
+
+// CreateWallet explicitly creates a wallet for a user
+// Returns an error if the wallet already exists
+func (wm *WalletManager) CreateWallet(ctx context.Context, userID string, userType string) (*SovereignWallet, error) {
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	if _, exists := wm.wallets[userID]; exists {
+		return nil, fmt.Errorf("wallet already exists for user: %s", userID)
+	}
+
+	return wm.createWalletLocked(userID, userType), nil
+}
+
 // GetOrCreateWallet gets or creates a wallet for a user
+// In strict mode, a missing wallet is an error instead of being created
 func (wm *WalletManager) GetOrCreateWallet(ctx context.Context, userID string, userType string) (*SovereignWallet, error) {
 	wm.mu.Lock()
 	defer wm.mu.Unlock()
@@ -62,7 +130,16 @@ func (wm *WalletManager) GetOrCreateWallet(ctx context.Context, userID string, u
 		return wallet, nil
 	}
 
-	// Create new wallet
+	// Strict policy: wallets must be created explicitly
+	if wm.creationPolicy == WalletCreationStrict {
+		return nil, fmt.Errorf("wallet not found for user: %s (strict creation policy, create it explicitly)", userID)
+	}
+
+	return wm.createWalletLocked(userID, userType), nil
+}
+
+// createWalletLocked creates a new empty wallet (caller must hold wm.mu)
+func (wm *WalletManager) createWalletLocked(userID string, userType string) *SovereignWallet {
 	wallet := &SovereignWallet{
 		UserID:         userID,
 		UserType:       userType,
@@ -74,7 +151,7 @@ func (wm *WalletManager) GetOrCreateWallet(ctx context.Context, userID string, u
 	}
 
 	wm.wallets[userID] = wallet
-	return wallet, nil
+	return wallet
 }
 
 // GetWallet gets a wallet by user ID
@@ -90,11 +167,85 @@ func (wm *WalletManager) GetWallet(ctx context.Context, userID string) (*Soverei
 	return wallet, nil
 }
 
+// GetWallets returns the wallets of many users under one read lock
+// Found wallets are keyed by user ID; IDs without a wallet are returned in missing, in request order.
+func (wm *WalletManager) GetWallets(ctx context.Context, userIDs []string) (map[string]*SovereignWallet, []string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, fmt.Errorf("bulk wallet query cancelled: %w", err)
+	}
+
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	found := make(map[string]*SovereignWallet, len(userIDs))
+	missing := make([]string, 0)
+	seen := make(map[string]bool, len(userIDs))
+
+	for _, userID := range userIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		wallet, exists := wm.wallets[userID]
+		if !exists {
+			missing = append(missing, userID)
+			continue
+		}
+		found[userID] = wallet
+	}
+
+	return found, missing, nil
+}
+
+// SnapshotWallets returns a copy of every wallet taken under the manager lock
+// Unlike GetWallet, the copies are safe to read while credits and debits run.
+func (wm *WalletManager) SnapshotWallets(ctx context.Context) ([]SovereignWallet, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("wallet snapshot cancelled: %w", err)
+	}
+
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	snapshots := make([]SovereignWallet, 0, len(wm.wallets))
+	for _, wallet := range wm.wallets {
+		snapshots = append(snapshots, *wallet)
+	}
+
+	return snapshots, nil
+}
+
+// SnapshotWallet returns a copy of a user's wallet taken under the manager lock, or false if none exists
+func (wm *WalletManager) SnapshotWallet(ctx context.Context, userID string) (SovereignWallet, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return SovereignWallet{}, false, fmt.Errorf("wallet snapshot cancelled: %w", err)
+	}
+
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	wallet, exists := wm.wallets[userID]
+	if !exists {
+		return SovereignWallet{}, false, nil
+	}
+
+	return *wallet, true, nil
+}
+
 // CreditRegular credits a user's regular wallet (unrestricted)
-func (wm *WalletManager) CreditRegular(ctx context.Context, userID string, amount int64, purpose string) (string, error) {
+func (wm *WalletManager) CreditRegular(ctx context.Context, userID string, amount int64, purpose string, reference string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("wallet operation for user %s cancelled: %w", userID, err)
+	}
+
 	wm.mu.Lock()
 	defer wm.mu.Unlock()
 
+	if err := wm.checkPurposeLocked(purpose); err != nil {
+		return "", err
+	}
+
 	wallet, exists := wm.wallets[userID]
 	if !exists {
 		return "", fmt.Errorf("wallet not found for user: %s", userID)
@@ -119,6 +270,7 @@ func (wm *WalletManager) CreditRegular(ctx context.Context, userID string, amoun
 		BalanceBefore: balanceBefore,
 		BalanceAfter:  wallet.RegularBalance,
 		Purpose:       purpose,
+		Reference:     reference,
 		Timestamp:     time.Now(),
 		Status:        "success",
 	}
@@ -129,10 +281,18 @@ func (wm *WalletManager) CreditRegular(ctx context.Context, userID string, amoun
 }
 
 // CreditEscrow credits a user's escrow wallet (restricted to PFF fees)
-func (wm *WalletManager) CreditEscrow(ctx context.Context, userID string, amount int64, purpose string) (string, error) {
+func (wm *WalletManager) CreditEscrow(ctx context.Context, userID string, amount int64, purpose string, reference string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("wallet operation for user %s cancelled: %w", userID, err)
+	}
+
 	wm.mu.Lock()
 	defer wm.mu.Unlock()
 
+	if err := wm.checkPurposeLocked(purpose); err != nil {
+		return "", err
+	}
+
 	wallet, exists := wm.wallets[userID]
 	if !exists {
 		return "", fmt.Errorf("wallet not found for user: %s", userID)
@@ -162,6 +322,7 @@ func (wm *WalletManager) CreditEscrow(ctx context.Context, userID string, amount
 		BalanceBefore: balanceBefore,
 		BalanceAfter:  wallet.EscrowBalance,
 		Purpose:       purpose,
+		Reference:     reference,
 		Timestamp:     time.Now(),
 		Status:        "success",
 	}
@@ -172,10 +333,18 @@ func (wm *WalletManager) CreditEscrow(ctx context.Context, userID string, amount
 }
 
 // DebitRegular debits a user's regular wallet (for withdrawals, transfers, etc.)
-func (wm *WalletManager) DebitRegular(ctx context.Context, userID string, amount int64, purpose string) (string, error) {
+func (wm *WalletManager) DebitRegular(ctx context.Context, userID string, amount int64, purpose string, reference string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("wallet operation for user %s cancelled: %w", userID, err)
+	}
+
 	wm.mu.Lock()
 	defer wm.mu.Unlock()
 
+	if err := wm.checkPurposeLocked(purpose); err != nil {
+		return "", err
+	}
+
 	wallet, exists := wm.wallets[userID]
 	if !exists {
 		return "", fmt.Errorf("wallet not found for user: %s", userID)
@@ -186,40 +355,23 @@ func (wm *WalletManager) DebitRegular(ctx context.Context, userID string, amount
 		return "", fmt.Errorf("insufficient regular balance: have %d uSOV, need %d uSOV", wallet.RegularBalance, amount)
 	}
 
-	// Record balance before
-	balanceBefore := wallet.RegularBalance
-
-	// Debit regular balance
-	wallet.RegularBalance -= amount
-	wallet.TotalBalance -= amount
-	wallet.UpdatedAt = time.Now()
-
-	// Create transaction record
-	txID := uuid.New().String()
-	tx := &WalletTransaction{
-		TransactionID: txID,
-		UserID:        userID,
-		Type:          "debit",
-		WalletType:    "regular",
-		Amount:        amount,
-		BalanceBefore: balanceBefore,
-		BalanceAfter:  wallet.RegularBalance,
-		Purpose:       purpose,
-		Timestamp:     time.Now(),
-		Status:        "success",
-	}
-
-	wm.transactions[txID] = tx
-
-	return txID, nil
+	return wm.debitLocked(wallet, "regular", amount, purpose, reference, ""), nil
 }
 
 // DebitEscrow debits a user's escrow wallet (ONLY for PFF fees)
 // This enforces the anti-dumping restriction for enterprise users
-func (wm *WalletManager) DebitEscrow(ctx context.Context, userID string, amount int64, purpose string) (string, error) {
+func (wm *WalletManager) DebitEscrow(ctx context.Context, userID string, amount int64, purpose string, reference string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", fmt.Errorf("wallet operation for user %s cancelled: %w", userID, err)
+	}
+
 	wm.mu.Lock()
 	defer wm.mu.Unlock()
 
+	if err := wm.checkPurposeLocked(purpose); err != nil {
+		return "", err
+	}
+
 	wallet, exists := wm.wallets[userID]
 	if !exists {
 		return "", fmt.Errorf("wallet not found for user: %s", userID)
@@ -235,99 +387,215 @@ func (wm *WalletManager) DebitEscrow(ctx context.Context, userID string, amount
 		return "", fmt.Errorf("insufficient escrow balance: have %d uSOV, need %d uSOV", wallet.EscrowBalance, amount)
 	}
 
-	// Record balance before
-	balanceBefore := wallet.EscrowBalance
+	return wm.debitLocked(wallet, "escrow", amount, purpose, reference, ""), nil
+}
+
+// FeePaymentLeg is one debit of a PayPFFFeeSmart payment
+type FeePaymentLeg struct {
+	TransactionID string `json:"transaction_id"`
+	WalletType    string `json:"wallet_type"` // "escrow" or "regular"
+	Amount        int64  `json:"amount"`      // uSOV
+}
+
+// FeePayment is the result of PayPFFFeeSmart
+// A fee split across escrow and regular balances has two legs; every leg's
+// transaction carries the same PaymentGroupID.
+type FeePayment struct {
+	PaymentGroupID string          `json:"payment_group_id"`
+	Legs           []FeePaymentLeg `json:"legs"` // Escrow leg first when split
+}
+
+// TransactionIDs returns the transaction ID of every leg
+func (p *FeePayment) TransactionIDs() []string {
+	ids := make([]string, len(p.Legs))
+	for i, leg := range p.Legs {
+		ids[i] = leg.TransactionID
+	}
+	return ids
+}
+
+// PayPFFFeeSmart pays a PFF verification fee using the optimal wallet strategy
+// For enterprise users: use escrow first, then regular
+// For individual users: use regular only
+// The reference (e.g., verification or settlement transaction ID) is recorded on every debit.
+// The split is decided and applied under one lock, so balances cannot change between legs.
+func (wm *WalletManager) PayPFFFeeSmart(ctx context.Context, userID string, feeAmount int64, reference string) (*FeePayment, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("wallet operation for user %s cancelled: %w", userID, err)
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	if err := wm.checkPurposeLocked("pff_fee"); err != nil {
+		return nil, err
+	}
+
+	wallet, exists := wm.wallets[userID]
+	if !exists {
+		return nil, fmt.Errorf("wallet not found for user: %s", userID)
+	}
+
+	// Check total balance
+	if wallet.TotalBalance < feeAmount {
+		return nil, fmt.Errorf("insufficient total balance: have %d uSOV, need %d uSOV", wallet.TotalBalance, feeAmount)
+	}
+
+	// Enterprise: Use escrow first (anti-dumping enforcement); individuals pay from regular only
+	escrowAmount := int64(0)
+	if wallet.UserType == "enterprise" {
+		escrowAmount = wallet.EscrowBalance
+		if escrowAmount > feeAmount {
+			escrowAmount = feeAmount
+		}
+	}
+	regularAmount := feeAmount - escrowAmount
+
+	if wallet.RegularBalance < regularAmount {
+		return nil, fmt.Errorf("insufficient regular balance: have %d uSOV, need %d uSOV", wallet.RegularBalance, regularAmount)
+	}
+
+	payment := &FeePayment{
+		PaymentGroupID: uuid.New().String(),
+		Legs:           make([]FeePaymentLeg, 0, 2),
+	}
 
-	// Debit escrow balance
-	wallet.EscrowBalance -= amount
+	if escrowAmount > 0 {
+		txID := wm.debitLocked(wallet, "escrow", escrowAmount, "pff_fee", reference, payment.PaymentGroupID)
+		payment.Legs = append(payment.Legs, FeePaymentLeg{TransactionID: txID, WalletType: "escrow", Amount: escrowAmount})
+	}
+
+	if regularAmount > 0 || escrowAmount == 0 {
+		txID := wm.debitLocked(wallet, "regular", regularAmount, "pff_fee", reference, payment.PaymentGroupID)
+		payment.Legs = append(payment.Legs, FeePaymentLeg{TransactionID: txID, WalletType: "regular", Amount: regularAmount})
+	}
+
+	return payment, nil
+}
+
+// debitLocked debits a balance the caller has already checked and records the transaction (caller must hold wm.mu)
+func (wm *WalletManager) debitLocked(wallet *SovereignWallet, walletType string, amount int64, purpose string, reference string, paymentGroupID string) string {
+	// Record balance before and debit
+	var balanceBefore, balanceAfter int64
+	if walletType == "escrow" {
+		balanceBefore = wallet.EscrowBalance
+		wallet.EscrowBalance -= amount
+		balanceAfter = wallet.EscrowBalance
+	} else {
+		balanceBefore = wallet.RegularBalance
+		wallet.RegularBalance -= amount
+		balanceAfter = wallet.RegularBalance
+	}
 	wallet.TotalBalance -= amount
 	wallet.UpdatedAt = time.Now()
 
 	// Create transaction record
 	txID := uuid.New().String()
 	tx := &WalletTransaction{
-		TransactionID: txID,
-		UserID:        userID,
-		Type:          "debit",
-		WalletType:    "escrow",
-		Amount:        amount,
-		BalanceBefore: balanceBefore,
-		BalanceAfter:  wallet.EscrowBalance,
-		Purpose:       purpose,
-		Timestamp:     time.Now(),
-		Status:        "success",
+		TransactionID:  txID,
+		UserID:         wallet.UserID,
+		Type:           "debit",
+		WalletType:     walletType,
+		Amount:         amount,
+		BalanceBefore:  balanceBefore,
+		BalanceAfter:   balanceAfter,
+		Purpose:        purpose,
+		Reference:      reference,
+		PaymentGroupID: paymentGroupID,
+		Timestamp:      time.Now(),
+		Status:         "success",
 	}
 
 	wm.transactions[txID] = tx
 
-	return txID, nil
+	return txID
 }
 
-// PayPFFFeeSmart pays a PFF verification fee using the optimal wallet strategy
-// For enterprise users: use escrow first, then regular
-// For individual users: use regular only
-func (wm *WalletManager) PayPFFFeeSmart(ctx context.Context, userID string, feeAmount int64) (string, error) {
-	wallet, err := wm.GetWallet(ctx, userID)
+// GetTransactionHistory gets a page of transaction history for a user, most recent first
+// Pass the returned cursor to fetch the next page; it is empty once history is exhausted.
+// A limit <= 0 returns everything after the cursor.
+func (wm *WalletManager) GetTransactionHistory(ctx context.Context, userID string, limit int, cursor string) ([]*WalletTransaction, string, error) {
+	after, err := historycursor.Decode(cursor)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
-	// Check total balance
-	if wallet.TotalBalance < feeAmount {
-		return "", fmt.Errorf("insufficient total balance: have %d uSOV, need %d uSOV", wallet.TotalBalance, feeAmount)
-	}
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
 
-	var txID string
+	var userTxs []*WalletTransaction
+	for _, tx := range wm.transactions {
+		if tx.UserID != userID {
+			continue
+		}
+		if after != nil && !after.Precedes(tx.Timestamp, tx.TransactionID) {
+			continue
+		}
+		userTxs = append(userTxs, tx)
+	}
 
-	if wallet.UserType == "enterprise" {
-		// Enterprise: Use escrow first (anti-dumping enforcement)
-		if wallet.EscrowBalance >= feeAmount {
-			// Pay entirely from escrow
-			txID, err = wm.DebitEscrow(ctx, userID, feeAmount, "pff_fee")
-		} else if wallet.EscrowBalance > 0 {
-			// Pay partially from escrow, rest from regular
-			escrowAmount := wallet.EscrowBalance
-			regularAmount := feeAmount - escrowAmount
-
-			// Debit escrow
-			_, err1 := wm.DebitEscrow(ctx, userID, escrowAmount, "pff_fee")
-			if err1 != nil {
-				return "", err1
+	// Hot transactions cannot fill the page -> continue into the archive (older than any hot transaction)
+	if limit <= 0 || len(userTxs) <= limit {
+		archived, err := wm.archivedTransactionsLocked(ctx, userID)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, tx := range archived {
+			if after == nil || after.Precedes(tx.Timestamp, tx.TransactionID) {
+				userTxs = append(userTxs, tx)
 			}
+		}
+	}
 
-			// Debit regular
-			txID, err = wm.DebitRegular(ctx, userID, regularAmount, "pff_fee")
-		} else {
-			// Pay entirely from regular
-			txID, err = wm.DebitRegular(ctx, userID, feeAmount, "pff_fee")
+	// Sort by timestamp (most recent first), ties by transaction ID
+	sort.Slice(userTxs, func(i, j int) bool {
+		if userTxs[i].Timestamp.Equal(userTxs[j].Timestamp) {
+			return userTxs[i].TransactionID > userTxs[j].TransactionID
 		}
-	} else {
-		// Individual: Use regular wallet only
-		txID, err = wm.DebitRegular(ctx, userID, feeAmount, "pff_fee")
+		return userTxs[i].Timestamp.After(userTxs[j].Timestamp)
+	})
+
+	if limit <= 0 || len(userTxs) <= limit {
+		return userTxs, "", nil
 	}
 
-	return txID, err
+	userTxs = userTxs[:limit]
+	last := userTxs[limit-1]
+
+	return userTxs, historycursor.Encode(last.Timestamp, last.TransactionID), nil
 }
 
-// GetTransactionHistory gets transaction history for a user
-func (wm *WalletManager) GetTransactionHistory(ctx context.Context, userID string, limit int) ([]*WalletTransaction, error) {
+// GetTransactionsByReference returns every transaction recorded with a reference, archived ones included (oldest first)
+func (wm *WalletManager) GetTransactionsByReference(ctx context.Context, reference string) ([]*WalletTransaction, error) {
+	if reference == "" {
+		return nil, fmt.Errorf("reference required")
+	}
+
 	wm.mu.RLock()
 	defer wm.mu.RUnlock()
 
-	var userTxs []*WalletTransaction
+	archived, err := wm.archivedTransactionsLocked(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*WalletTransaction, 0)
 	for _, tx := range wm.transactions {
-		if tx.UserID == userID {
-			userTxs = append(userTxs, tx)
+		if tx.Reference == reference {
+			txs = append(txs, tx)
 		}
 	}
-
-	// Sort by timestamp (most recent first)
-	// In production, use proper sorting
-	if len(userTxs) > limit {
-		userTxs = userTxs[:limit]
+	for _, tx := range archived {
+		if tx.Reference == reference {
+			txs = append(txs, tx)
+		}
 	}
 
-	return userTxs, nil
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].Timestamp.Before(txs[j].Timestamp)
+	})
+
+	return txs, nil
 }
 
 // GetWalletStats returns wallet statistics
@@ -359,6 +627,8 @@ func (wm *WalletManager) GetWalletStats() map[string]interface{} {
 		"total_regular_balance": totalRegularBalance,
 		"total_escrow_balance":  totalEscrowBalance,
 		"total_balance":         totalRegularBalance + totalEscrowBalance,
-		"total_transactions":    len(wm.transactions),
+		"total_transactions":    len(wm.transactions) + wm.archivedCount,
+		"hot_transactions":      len(wm.transactions),
+		"archived_transactions": wm.archivedCount,
 	}
 }
@@ -0,0 +1,84 @@
+package billing
+
+import (
+	"fmt"
+	"sort"
+)
+
+// PurposePolicy controls whether credits and debits may use unregistered purposes
+type PurposePolicy string
+
+const (
+	PurposePolicyStrict  PurposePolicy = "strict"  // Unregistered purposes are rejected (default)
+	PurposePolicyLenient PurposePolicy = "lenient" // Any purpose is accepted
+)
+
+// DefaultWalletPurposes is the vocabulary registered on every new wallet manager
+var DefaultWalletPurposes = []string{
+	"fiat_purchase",
+	"pff_fee",
+	"pff_fee_refund",
+	"integrity_dividend",
+	"withdrawal_to_exchange",
+	"consultation_escrow",
+	"consultation_payment",
+	"consultation_refund",
+	"consultation_holdback_release",
+	"consultation_dispute_refund",
+	"consultation_dispute_clawback",
+	"consultation_dispute_clawback_reversal",
+	"chain_reconciliation",
+}
+
+// RegisterPurpose adds a purpose to the known vocabulary
+func (wm *WalletManager) RegisterPurpose(purpose string) error {
+	if purpose == "" {
+		return fmt.Errorf("purpose required")
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	wm.purposes[purpose] = true
+	return nil
+}
+
+// SetPurposePolicy sets whether unregistered purposes are rejected (strict) or accepted (lenient)
+func (wm *WalletManager) SetPurposePolicy(policy PurposePolicy) error {
+	if policy != PurposePolicyStrict && policy != PurposePolicyLenient {
+		return fmt.Errorf("invalid purpose policy: %s", policy)
+	}
+
+	wm.mu.Lock()
+	defer wm.mu.Unlock()
+
+	wm.purposePolicy = policy
+	return nil
+}
+
+// GetRegisteredPurposes returns the known purposes in sorted order
+func (wm *WalletManager) GetRegisteredPurposes() []string {
+	wm.mu.RLock()
+	defer wm.mu.RUnlock()
+
+	purposes := make([]string, 0, len(wm.purposes))
+	for purpose := range wm.purposes {
+		purposes = append(purposes, purpose)
+	}
+	sort.Strings(purposes)
+
+	return purposes
+}
+
+// checkPurposeLocked rejects unregistered purposes under the strict policy (caller must hold wm.mu)
+func (wm *WalletManager) checkPurposeLocked(purpose string) error {
+	if purpose == "" {
+		return fmt.Errorf("purpose required")
+	}
+
+	if wm.purposePolicy == PurposePolicyStrict && !wm.purposes[purpose] {
+		return fmt.Errorf("unregistered purpose: %s (register it or set the lenient purpose policy)", purpose)
+	}
+
+	return nil
+}
@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/workerpool"
 )
 
 // TrustCacheEntry represents a cached traveler trust record
@@ -19,8 +22,15 @@ type TrustCacheEntry struct {
 	VerificationCount int              `json:"verification_count"`
 	Checkpoints      []string          `json:"checkpoints"`
 	CarrierIDs       []string          `json:"carrier_ids"`
+	
+	// Negative entries record a "not found in registry" result (short TTL)
+	Negative bool `json:"negative"`
 }
 
+// DefaultNegativeTTL is how long a "not found" result is cached by default
+// Much shorter than the positive TTL: the traveler may register moments later.
+const DefaultNegativeTTL = 5 * time.Minute
+
 // TemporalTrustCache provides 24-hour trust caching for sub-second verifications
 type TemporalTrustCache struct {
 	// In-memory cache for development
@@ -31,8 +41,18 @@ type TemporalTrustCache struct {
 	// TTL for cache entries (24 hours)
 	ttl time.Duration
 	
+	// TTL for negative ("not found") entries
+	negativeTTL time.Duration
+	
 	// Cleanup interval for expired entries
 	cleanupInterval time.Duration
+	
+	// Lookup counters for hit ratio reporting
+	hits   uint64
+	misses uint64
+	
+	// Bounds parallel registry loads in Warm
+	warmPool *workerpool.Pool
 }
 
 // NewTemporalTrustCache creates a new temporal trust cache
@@ -40,7 +60,9 @@ func NewTemporalTrustCache(ttl time.Duration) *TemporalTrustCache {
 	cache := &TemporalTrustCache{
 		cache:           make(map[string]*TrustCacheEntry),
 		ttl:             ttl,
+		negativeTTL:     DefaultNegativeTTL,
 		cleanupInterval: 5 * time.Minute,
+		warmPool:        workerpool.New(workerpool.DefaultConcurrency),
 	}
 	
 	// Start background cleanup goroutine
@@ -54,13 +76,35 @@ func NewDefaultTemporalTrustCache() *TemporalTrustCache {
 	return NewTemporalTrustCache(24 * time.Hour)
 }
 
+// SetNegativeTTL sets how long negative ("not found") entries are cached
+func (tc *TemporalTrustCache) SetNegativeTTL(ttl time.Duration) error {
+	if ttl <= 0 {
+		return fmt.Errorf("negative TTL must be positive")
+	}
+	
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	
+	if ttl > tc.ttl {
+		return fmt.Errorf("negative TTL %s exceeds positive TTL %s", ttl, tc.ttl)
+	}
+	
+	tc.negativeTTL = ttl
+	return nil
+}
+
 // Set stores a trust entry in the cache
+// Negative entries expire after the negative TTL, all others after the positive TTL.
 func (tc *TemporalTrustCache) Set(ctx context.Context, entry *TrustCacheEntry) error {
 	tc.mu.Lock()
 	defer tc.mu.Unlock()
 	
 	// Set expiration time
-	entry.ExpiresAt = time.Now().Add(tc.ttl)
+	if entry.Negative {
+		entry.ExpiresAt = time.Now().Add(tc.negativeTTL)
+	} else {
+		entry.ExpiresAt = time.Now().Add(tc.ttl)
+	}
 	
 	// Store in cache
 	tc.cache[entry.BiometricHash] = entry
@@ -75,17 +119,32 @@ func (tc *TemporalTrustCache) Get(ctx context.Context, biometricHash string) (*T
 	
 	entry, exists := tc.cache[biometricHash]
 	if !exists {
+		atomic.AddUint64(&tc.misses, 1)
 		return nil, false
 	}
 	
 	// Check if expired
 	if time.Now().After(entry.ExpiresAt) {
+		atomic.AddUint64(&tc.misses, 1)
 		return nil, false
 	}
 	
+	atomic.AddUint64(&tc.hits, 1)
 	return entry, true
 }
 
+// HitRatio returns the fraction of lookups served from the cache (0 if no lookups yet)
+func (tc *TemporalTrustCache) HitRatio() float64 {
+	hits := atomic.LoadUint64(&tc.hits)
+	misses := atomic.LoadUint64(&tc.misses)
+	
+	if hits+misses == 0 {
+		return 0
+	}
+	
+	return float64(hits) / float64(hits+misses)
+}
+
 // Update increments verification count and adds checkpoint
 func (tc *TemporalTrustCache) Update(
 	ctx context.Context,
@@ -106,6 +165,11 @@ func (tc *TemporalTrustCache) Update(
 		return fmt.Errorf("entry expired")
 	}
 	
+	// Negative entries carry no trust to extend
+	if entry.Negative {
+		return fmt.Errorf("entry is negative")
+	}
+	
 	// Increment verification count
 	entry.VerificationCount++
 	
@@ -149,21 +213,29 @@ func (tc *TemporalTrustCache) GetStats() map[string]interface{} {
 	totalEntries := len(tc.cache)
 	validEntries := 0
 	expiredEntries := 0
+	negativeEntries := 0
 	
 	now := time.Now()
 	for _, entry := range tc.cache {
-		if now.Before(entry.ExpiresAt) {
-			validEntries++
-		} else {
+		if !now.Before(entry.ExpiresAt) {
 			expiredEntries++
+		} else if entry.Negative {
+			negativeEntries++
+		} else {
+			validEntries++
 		}
 	}
 	
 	return map[string]interface{}{
-		"total_entries":   totalEntries,
-		"valid_entries":   validEntries,
-		"expired_entries": expiredEntries,
-		"ttl_hours":       tc.ttl.Hours(),
+		"total_entries":        totalEntries,
+		"valid_entries":        validEntries,
+		"expired_entries":      expiredEntries,
+		"negative_entries":     negativeEntries,
+		"ttl_hours":            tc.ttl.Hours(),
+		"negative_ttl_seconds": tc.negativeTTL.Seconds(),
+		"hits":                 atomic.LoadUint64(&tc.hits),
+		"misses":               atomic.LoadUint64(&tc.misses),
+		"hit_ratio":            tc.HitRatio(),
 	}
 }
 
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/workerpool"
+)
+
+// TrustLoader loads a traveler's trust record from the registry for cache warming
+// Returns (nil, nil) when the traveler is not registered.
+type TrustLoader func(ctx context.Context, biometricHash string) (*TrustCacheEntry, error)
+
+// SetWarmConcurrency sets how many trust records Warm loads in parallel
+func (tc *TemporalTrustCache) SetWarmConcurrency(concurrency int) {
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+
+	tc.warmPool = workerpool.New(concurrency)
+}
+
+// Warm preloads trust records (e.g. the travelers expected at a checkpoint) before peak traffic
+// Records are loaded through the bounded worker pool so the registry is not
+// flooded. Travelers already cached are skipped; unregistered travelers get a
+// negative entry. Returns one error per hash, in input order.
+func (tc *TemporalTrustCache) Warm(ctx context.Context, biometricHashes []string, load TrustLoader) []error {
+	tc.mu.RLock()
+	pool := tc.warmPool
+	tc.mu.RUnlock()
+
+	return pool.Run(ctx, len(biometricHashes), func(ctx context.Context, i int) error {
+		biometricHash := biometricHashes[i]
+		if tc.isCached(biometricHash) {
+			return nil
+		}
+
+		entry, err := load(ctx, biometricHash)
+		if err != nil {
+			return fmt.Errorf("failed to load trust record for %s: %w", biometricHash, err)
+		}
+		if entry == nil {
+			entry = &TrustCacheEntry{BiometricHash: biometricHash, Negative: true}
+		}
+		entry.BiometricHash = biometricHash
+
+		return tc.Set(ctx, entry)
+	})
+}
+
+// isCached reports whether an unexpired entry exists without counting a hit or miss
+func (tc *TemporalTrustCache) isCached(biometricHash string) bool {
+	tc.mu.RLock()
+	defer tc.mu.RUnlock()
+
+	entry, exists := tc.cache[biometricHash]
+	return exists && time.Now().Before(entry.ExpiresAt)
+}
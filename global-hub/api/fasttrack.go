@@ -3,11 +3,13 @@ package api
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/sovrn-protocol/sovrn/hub/api/billing"
 	"github.com/sovrn-protocol/sovrn/hub/api/cache"
+	"github.com/sovrn-protocol/sovrn/hub/api/metrics"
 	"github.com/sovrn-protocol/sovrn/hub/api/zkproof"
 )
 
@@ -25,29 +27,79 @@ type FastTrackService struct {
 	
 	// Performance target: sub-second response time
 	targetResponseTime time.Duration
+	
+	// Optional metrics registry for verification latency and outcomes
+	metricsRegistry *metrics.Registry
+	
+	// sandboxEngine verifies simulated requests against a mock spoke (nil = sandbox disabled)
+	sandboxEngine *zkproof.ZKProofEngine
+	
+	// webhooks delivers signed outcomes to carriers that registered a callback (nil = disabled)
+	webhooks *WebhookDispatcher
+	
+	// nationalitySpokes routes nationality hints and DID countries to spokes
+	nationalitySpokes map[string]string
+	routingMu         sync.RWMutex
+	
+	// spokeRouter routes carriers without a DID or nationality signal to spokes
+	spokeRouter *SpokeRouter
+	
+	// lanes sets trust threshold, cache max age and charge per fast-track lane
+	lanes   map[string]*FastTrackLane
+	lanesMu sync.RWMutex
+}
+
+// SandboxSpokeID is the spoke ID used for all simulated verifications
+const SandboxSpokeID = "sandbox"
+
+// carrierKey is the context key carrying the authenticated carrier's ID
+type carrierKey struct{}
+
+// WithCarrier returns a context acting on behalf of the carrier with this ID
+// Set by the layer that authenticated the carrier (e.g. API key or mTLS middleware).
+func WithCarrier(ctx context.Context, carrierID string) context.Context {
+	return context.WithValue(ctx, carrierKey{}, carrierID)
+}
+
+// carrierFromContext returns the carrier ID attached with WithCarrier
+func carrierFromContext(ctx context.Context) (string, bool) {
+	carrierID, ok := ctx.Value(carrierKey{}).(string)
+	return carrierID, ok && carrierID != ""
 }
 
 // VerifyTravelerRequest contains biometric hash and carrier information
 type VerifyTravelerRequest struct {
 	BiometricHash  string
-	CarrierID      string
+	CarrierID      string // Optional; must match the authenticated carrier (WithCarrier), which is what gets billed and notified
 	CheckpointType string
 	FlightNumber   string
 	RequestID      string
+	Simulate       bool   // Run end-to-end against the sandbox spoke with no charges
+	Lane           string // Fast-track lane (premium, standard, economy); empty = standard
+	
+	// Optional spoke routing signals (precedence: TravelerDID > NationalityHint > carrier default)
+	TravelerDID     string // did:sovra:{country}:{id}, if the traveler has one
+	NationalityHint string // Claimed nationality (e.g., "NG") for walk-up travelers without a DID
 }
 
 // VerifyTravelerResponse contains verification result and trust information
 type VerifyTravelerResponse struct {
-	Success          bool
-	TrustScore       int32
-	TrustLevel       string
-	Cached           bool
-	CacheExpiresAt   string
-	VerificationID   string
-	ResponseTimeMs   int64
-	ZKPProofValid    bool
-	BillingEventID   string
-	Message          string
+	Success             bool
+	TrustScore          int32
+	TrustLevel          string
+	Cached              bool
+	CacheExpiresAt      string
+	VerificationID      string
+	ResponseTimeMs      int64
+	ZKPProofValid       bool
+	BillingEventID      string
+	Message             string
+	Simulated           bool   // True if no real spoke lookup or charge took place
+	SimulatedChargeUSOV int64  // Charge that would have been billed to the carrier
+	SpokeID             string // Spoke queried on a cache miss
+	SpokeRouting        string // Which signal chose the spoke (did, nationality_hint, carrier_default)
+	Lane                string // Fast-track lane the traveler was verified for
+	LanePriority        int    // Lane priority (higher is served first)
 }
 
 // NewFastTrackService creates a new fast-track service
@@ -61,35 +113,190 @@ func NewFastTrackService(
 		trustCache:         trustCache,
 		revenueEngine:      revenueEngine,
 		targetResponseTime: 1 * time.Second, // Sub-second target
+		nationalitySpokes:  DefaultNationalitySpokes(),
+		spokeRouter:        DefaultSpokeRouter(),
+		lanes:              DefaultFastTrackLanes(),
+	}
+}
+
+// EnableSandbox enables simulated verifications against the given mock spoke
+// Partners register test hashes on the spoke; simulated requests never touch
+// the real spokes, the temporal trust cache or the revenue engine.
+func (fts *FastTrackService) EnableSandbox(spoke zkproof.SpokeClient) {
+	fts.sandboxEngine = zkproof.NewZKProofEngine(map[string]zkproof.SpokeClient{
+		SandboxSpokeID: spoke,
+	})
+}
+
+// SetMetrics publishes verification and trust cache metrics to the registry
+func (fts *FastTrackService) SetMetrics(registry *metrics.Registry) {
+	fts.metricsRegistry = registry
+	
+	registry.GaugeFunc("sovrn_trust_cache_hit_ratio", "Fraction of verifications served from the temporal trust cache", fts.trustCache.HitRatio)
+}
+
+// SetWebhookDispatcher enables asynchronous outcome callbacks to registered carriers
+func (fts *FastTrackService) SetWebhookDispatcher(dispatcher *WebhookDispatcher) {
+	fts.webhooks = dispatcher
+}
+
+// recordVerification records latency and outcome of a single verification
+func (fts *FastTrackService) recordVerification(startTime time.Time, cached bool, result string) {
+	if fts.metricsRegistry == nil {
+		return
 	}
+	
+	fts.metricsRegistry.Histogram("sovrn_verification_latency_seconds", "VerifyTraveler response time in seconds", metrics.DefaultLatencyBuckets,
+		"cached", fmt.Sprintf("%t", cached)).Observe(time.Since(startTime).Seconds())
+	fts.metricsRegistry.Counter("sovrn_verifications_total", "Traveler verifications by result", "result", result).Inc()
 }
 
 // VerifyTraveler performs privacy-preserving biometric verification
-// This is the main entry point for fast-track verification
+// This is the main entry point for fast-track verification. ctx must carry the
+// authenticated carrier (WithCarrier): it is billed and receives the webhook,
+// so a request body cannot charge or notify another carrier.
 func (fts *FastTrackService) VerifyTraveler(
 	ctx context.Context,
 	req *VerifyTravelerRequest,
+) (*VerifyTravelerResponse, error) {
+	carrierID, ok := carrierFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("authenticated carrier required")
+	}
+	if req.CarrierID != "" && req.CarrierID != carrierID {
+		return nil, fmt.Errorf("request carrier %s does not match authenticated carrier %s", req.CarrierID, carrierID)
+	}
+	authenticated := *req
+	authenticated.CarrierID = carrierID
+	req = &authenticated
+	
+	resp, err := fts.verifyTraveler(ctx, req)
+	
+	// Notify the carrier asynchronously (simulated requests never leave the sandbox)
+	if err == nil && resp != nil && !req.Simulate && fts.webhooks != nil {
+		fts.webhooks.Dispatch(&VerificationOutcome{
+			VerificationID: resp.VerificationID,
+			RequestID:      req.RequestID,
+			CarrierID:      req.CarrierID,
+			CheckpointType: req.CheckpointType,
+			FlightNumber:   req.FlightNumber,
+			Success:        resp.Success,
+			TrustScore:     resp.TrustScore,
+			TrustLevel:     resp.TrustLevel,
+			Cached:         resp.Cached,
+			Message:        resp.Message,
+			CompletedAt:    time.Now(),
+		})
+	}
+	
+	return resp, err
+}
+
+// verifyTraveler runs the cache, spoke and billing steps of a verification
+func (fts *FastTrackService) verifyTraveler(
+	ctx context.Context,
+	req *VerifyTravelerRequest,
 ) (*VerifyTravelerResponse, error) {
 	startTime := time.Now()
 	
 	// Generate verification ID
 	verificationID := uuid.New().String()
 	
-	// 1. Check Temporal Trust Cache (24-hour cache)
-	if cachedEntry, exists := fts.trustCache.Get(ctx, req.BiometricHash); exists {
+	// Resolve the lane's trust threshold, cache max age and charge
+	lane, err := fts.resolveLane(req.Lane)
+	if err != nil {
+		fts.recordVerification(startTime, false, "error")
+		return &VerifyTravelerResponse{
+			Success:        false,
+			TrustScore:     0,
+			TrustLevel:     "very_low",
+			Cached:         false,
+			VerificationID: verificationID,
+			ResponseTimeMs: time.Since(startTime).Milliseconds(),
+			ZKPProofValid:  false,
+			Message:        fmt.Sprintf("Lane resolution failed: %v", err),
+			Simulated:      req.Simulate,
+		}, err
+	}
+	
+	// Simulated requests take the sandbox path (no cache, no real spoke, no billing)
+	if req.Simulate {
+		return fts.simulateVerification(ctx, req, lane, verificationID, startTime)
+	}
+	
+	// 1. Check Temporal Trust Cache (24-hour cache; lanes may accept only fresher trust)
+	cachedEntry, exists := fts.trustCache.Get(ctx, req.BiometricHash)
+	if exists && !cachedEntry.Negative && time.Since(cachedEntry.VerifiedAt) > lane.CacheMaxAge {
+		exists = false
+	}
+	
+	if exists && cachedEntry.Negative {
+		// NEGATIVE HIT: Recently not found in the registry (short negative TTL)
+		fts.recordVerification(startTime, true, "not_found")
+		return &VerifyTravelerResponse{
+			Success:        false,
+			TrustScore:     0,
+			TrustLevel:     "very_low",
+			Cached:         true,
+			CacheExpiresAt: cachedEntry.ExpiresAt.Format(time.RFC3339),
+			VerificationID: verificationID,
+			ResponseTimeMs: time.Since(startTime).Milliseconds(),
+			ZKPProofValid:  true,
+			Message:        "Traveler not found in registry (cached)",
+			Lane:           lane.Name,
+			LanePriority:   lane.Priority,
+		}, nil
+	} else if exists {
 		// CACHE HIT: Sub-millisecond response!
+		if refusal := laneRefusal(lane, cachedEntry.TrustScore); refusal != "" {
+			fts.recordVerification(startTime, true, "below_lane_threshold")
+			return &VerifyTravelerResponse{
+				Success:        false,
+				TrustScore:     cachedEntry.TrustScore,
+				TrustLevel:     cachedEntry.TrustLevel,
+				Cached:         true,
+				CacheExpiresAt: cachedEntry.ExpiresAt.Format(time.RFC3339),
+				VerificationID: verificationID,
+				ResponseTimeMs: time.Since(startTime).Milliseconds(),
+				ZKPProofValid:  true,
+				Message:        refusal,
+				Lane:           lane.Name,
+				LanePriority:   lane.Priority,
+			}, nil
+		}
+		
 		responseTime := time.Since(startTime).Milliseconds()
 		
 		// Update cache with new checkpoint
 		fts.trustCache.Update(ctx, req.BiometricHash, req.CheckpointType, req.CarrierID)
 		
-		// Create billing event (1 SOV charge)
-		billingEvent, _ := fts.revenueEngine.CreateBillingEvent(
+		// Create billing event (charged at the lane's rate)
+		billingEvent, err := fts.revenueEngine.CreateLaneBillingEvent(
 			ctx,
 			req.CarrierID,
 			verificationID,
 			req.CheckpointType,
+			lane.Name,
+			lane.ChargeUSOV,
 		)
+		if err != nil {
+			fts.recordVerification(startTime, true, "error")
+			return &VerifyTravelerResponse{
+				Success:        false,
+				TrustScore:     cachedEntry.TrustScore,
+				TrustLevel:     cachedEntry.TrustLevel,
+				Cached:         true,
+				CacheExpiresAt: cachedEntry.ExpiresAt.Format(time.RFC3339),
+				VerificationID: verificationID,
+				ResponseTimeMs: time.Since(startTime).Milliseconds(),
+				ZKPProofValid:  true,
+				Message:        fmt.Sprintf("Billing event creation failed: %v", err),
+				Lane:           lane.Name,
+				LanePriority:   lane.Priority,
+			}, err
+		}
+		
+		fts.recordVerification(startTime, true, "verified")
 		
 		return &VerifyTravelerResponse{
 			Success:          true,
@@ -102,15 +309,34 @@ func (fts *FastTrackService) VerifyTraveler(
 			ZKPProofValid:    true, // Already validated in initial verification
 			BillingEventID:   billingEvent.EventID,
 			Message:          fmt.Sprintf("Verified from temporal cache (zero-latency, checkpoint: %s)", req.CheckpointType),
+			Lane:             lane.Name,
+			LanePriority:     lane.Priority,
 		}, nil
 	}
 	
 	// 2. CACHE MISS: Perform ZK-Proof Handshake with National Spoke
 	// This asks: "Does this hash exist?" WITHOUT revealing identity
-	spokeID := fts.determineSpokeID(req.CarrierID)
+	spokeID, spokeRouting, err := fts.resolveSpoke(req)
+	if err != nil {
+		fts.recordVerification(startTime, false, "error")
+		return &VerifyTravelerResponse{
+			Success:        false,
+			TrustScore:     0,
+			TrustLevel:     "very_low",
+			Cached:         false,
+			VerificationID: verificationID,
+			ResponseTimeMs: time.Since(startTime).Milliseconds(),
+			ZKPProofValid:  false,
+			Message:        fmt.Sprintf("Spoke routing failed: %v", err),
+			SpokeRouting:   spokeRouting,
+			Lane:           lane.Name,
+			LanePriority:   lane.Priority,
+		}, err
+	}
 	
 	zkResponse, err := fts.zkEngine.VerifyWithSpoke(req.BiometricHash, spokeID)
 	if err != nil {
+		fts.recordVerification(startTime, false, "error")
 		return &VerifyTravelerResponse{
 			Success:        false,
 			TrustScore:     0,
@@ -120,11 +346,30 @@ func (fts *FastTrackService) VerifyTraveler(
 			ResponseTimeMs: time.Since(startTime).Milliseconds(),
 			ZKPProofValid:  false,
 			Message:        fmt.Sprintf("ZK-proof verification failed: %v", err),
+			SpokeID:        spokeID,
+			SpokeRouting:   spokeRouting,
+			Lane:           lane.Name,
+			LanePriority:   lane.Priority,
 		}, nil
 	}
 	
 	// 3. Check if hash exists in spoke registry
 	if !zkResponse.Exists {
+		// Cache the miss briefly so repeated scans skip the spoke
+		negativeEntry := &cache.TrustCacheEntry{
+			BiometricHash:  req.BiometricHash,
+			TrustLevel:     "very_low",
+			VerificationID: verificationID,
+			VerifiedAt:     time.Now(),
+			Checkpoints:    []string{req.CheckpointType},
+			CarrierIDs:     []string{req.CarrierID},
+			Negative:       true,
+		}
+		if err := fts.trustCache.Set(ctx, negativeEntry); err != nil {
+			fmt.Printf("Failed to cache negative trust entry: %v\n", err)
+		}
+		
+		fts.recordVerification(startTime, false, "not_found")
 		return &VerifyTravelerResponse{
 			Success:        false,
 			TrustScore:     0,
@@ -134,6 +379,10 @@ func (fts *FastTrackService) VerifyTraveler(
 			ResponseTimeMs: time.Since(startTime).Milliseconds(),
 			ZKPProofValid:  true,
 			Message:        "Traveler not found in registry",
+			SpokeID:        spokeID,
+			SpokeRouting:   spokeRouting,
+			Lane:           lane.Name,
+			LanePriority:   lane.Priority,
 		}, nil
 	}
 	
@@ -157,27 +406,66 @@ func (fts *FastTrackService) VerifyTraveler(
 		fmt.Printf("Failed to cache trust entry: %v\n", err)
 	}
 	
-	// 6. Create Billing Event (1 SOV charge to carrier)
-	billingEvent, err := fts.revenueEngine.CreateBillingEvent(
+	// 6. Refuse the lane if the traveler's trust is below its threshold (not billed;
+	// the trust stays cached so a lower lane can still serve the traveler)
+	if refusal := laneRefusal(lane, trustScore); refusal != "" {
+		fts.recordVerification(startTime, false, "below_lane_threshold")
+		return &VerifyTravelerResponse{
+			Success:        false,
+			TrustScore:     trustScore,
+			TrustLevel:     trustLevel,
+			Cached:         false,
+			CacheExpiresAt: cacheEntry.ExpiresAt.Format(time.RFC3339),
+			VerificationID: verificationID,
+			ResponseTimeMs: time.Since(startTime).Milliseconds(),
+			ZKPProofValid:  true,
+			Message:        refusal,
+			SpokeID:        spokeID,
+			SpokeRouting:   spokeRouting,
+			Lane:           lane.Name,
+			LanePriority:   lane.Priority,
+		}, nil
+	}
+	
+	// 7. Create Billing Event (charged to carrier at the lane's rate)
+	billingEvent, err := fts.revenueEngine.CreateLaneBillingEvent(
 		ctx,
 		req.CarrierID,
 		verificationID,
 		req.CheckpointType,
+		lane.Name,
+		lane.ChargeUSOV,
 	)
 	if err != nil {
-		// Log error but don't fail the verification
-		fmt.Printf("Failed to create billing event: %v\n", err)
+		fts.recordVerification(startTime, false, "error")
+		return &VerifyTravelerResponse{
+			Success:        false,
+			TrustScore:     trustScore,
+			TrustLevel:     trustLevel,
+			Cached:         false,
+			CacheExpiresAt: cacheEntry.ExpiresAt.Format(time.RFC3339),
+			VerificationID: verificationID,
+			ResponseTimeMs: time.Since(startTime).Milliseconds(),
+			ZKPProofValid:  true,
+			Message:        fmt.Sprintf("Billing event creation failed: %v", err),
+			SpokeID:        spokeID,
+			SpokeRouting:   spokeRouting,
+			Lane:           lane.Name,
+			LanePriority:   lane.Priority,
+		}, err
 	}
 	
-	// 7. Calculate total response time
+	// 8. Calculate total response time
 	responseTime := time.Since(startTime).Milliseconds()
 	
-	// 8. Check if we met performance target
+	// 9. Check if we met performance target
 	if responseTime > fts.targetResponseTime.Milliseconds() {
 		fmt.Printf("WARNING: Response time %dms exceeded target %dms\n", 
 			responseTime, fts.targetResponseTime.Milliseconds())
 	}
 	
+	fts.recordVerification(startTime, false, "verified")
+	
 	return &VerifyTravelerResponse{
 		Success:          true,
 		TrustScore:       trustScore,
@@ -189,9 +477,71 @@ func (fts *FastTrackService) VerifyTraveler(
 		ZKPProofValid:    true,
 		BillingEventID:   billingEvent.EventID,
 		Message:          fmt.Sprintf("Live verification completed and cached (checkpoint: %s)", req.CheckpointType),
+		SpokeID:          spokeID,
+		SpokeRouting:     spokeRouting,
+		Lane:             lane.Name,
+		LanePriority:     lane.Priority,
 	}, nil
 }
 
+// simulateVerification runs the verification logic against the sandbox spoke
+// Records the would-be carrier charge instead of creating a billing event.
+func (fts *FastTrackService) simulateVerification(
+	ctx context.Context,
+	req *VerifyTravelerRequest,
+	lane *FastTrackLane,
+	verificationID string,
+	startTime time.Time,
+) (*VerifyTravelerResponse, error) {
+	if fts.sandboxEngine == nil {
+		return nil, fmt.Errorf("sandbox mode not enabled")
+	}
+	
+	response := &VerifyTravelerResponse{
+		TrustLevel:     "very_low",
+		VerificationID: verificationID,
+		Simulated:      true,
+		Lane:           lane.Name,
+		LanePriority:   lane.Priority,
+	}
+	
+	// 1. ZK-proof handshake with the mock spoke
+	zkResponse, err := fts.sandboxEngine.VerifyWithSpoke(req.BiometricHash, SandboxSpokeID)
+	if err != nil {
+		response.ResponseTimeMs = time.Since(startTime).Milliseconds()
+		response.Message = fmt.Sprintf("[SIMULATED] ZK-proof verification failed: %v", err)
+		return response, nil
+	}
+	
+	response.ZKPProofValid = true
+	
+	// 2. Check if hash exists in sandbox registry
+	if !zkResponse.Exists {
+		response.ResponseTimeMs = time.Since(startTime).Milliseconds()
+		response.Message = "[SIMULATED] Traveler not found in sandbox registry"
+		return response, nil
+	}
+	
+	// 3. Calculate Trust Score exactly as a live verification would
+	response.TrustScore, response.TrustLevel = fts.calculateTrustScore(zkResponse.TrustIndicators)
+	
+	// 4. Apply the lane's trust threshold
+	if refusal := laneRefusal(lane, response.TrustScore); refusal != "" {
+		response.ResponseTimeMs = time.Since(startTime).Milliseconds()
+		response.Message = "[SIMULATED] " + refusal
+		return response, nil
+	}
+	
+	// 5. Record the would-be charge at the lane's rate (no billing event created)
+	response.Success = true
+	response.CacheExpiresAt = time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+	response.SimulatedChargeUSOV = lane.ChargeUSOV
+	response.ResponseTimeMs = time.Since(startTime).Milliseconds()
+	response.Message = fmt.Sprintf("[SIMULATED] Verification completed, no charge applied (checkpoint: %s)", req.CheckpointType)
+	
+	return response, nil
+}
+
 // GetTrustStatus checks if a traveler has valid cached trust
 func (fts *FastTrackService) GetTrustStatus(
 	ctx context.Context,
@@ -200,7 +550,7 @@ func (fts *FastTrackService) GetTrustStatus(
 ) (*TrustStatusResponse, error) {
 	// Check cache
 	entry, exists := fts.trustCache.Get(ctx, biometricHash)
-	if !exists {
+	if !exists || entry.Negative {
 		return &TrustStatusResponse{
 			HasValidTrust: false,
 			Message:       "No cached trust found",
@@ -308,19 +658,13 @@ func (fts *FastTrackService) calculateTrustScore(indicators map[string]string) (
 }
 
 // determineSpokeID determines which National Spoke to query based on carrier
-// In production, this would use a routing table or DID resolution
-func (fts *FastTrackService) determineSpokeID(carrierID string) string {
-	// Mock implementation: Extract spoke from carrier ID
-	// Format: "airline:AA" -> "nigeria", "airport:LOS" -> "nigeria"
-
-	// In production, use a routing table:
-	// - "airline:AA" (American Airlines) -> "usa"
-	// - "airline:BA" (British Airways) -> "uk"
-	// - "airport:LOS" (Lagos) -> "nigeria"
-	// - "airport:NBO" (Nairobi) -> "kenya"
-
-	// For now, default to Nigeria
-	return "nigeria"
+// Routed through the SpokeRouter; unknown carriers get ErrNoSpokeRoute.
+func (fts *FastTrackService) determineSpokeID(carrierID string) (string, error) {
+	fts.routingMu.RLock()
+	router := fts.spokeRouter
+	fts.routingMu.RUnlock()
+
+	return router.Route(carrierID)
 }
 
 // GetServiceStats returns service statistics
@@ -331,6 +675,7 @@ func (fts *FastTrackService) GetServiceStats(ctx context.Context) map[string]int
 	return map[string]interface{}{
 		"cache":   cacheStats,
 		"revenue": revenueStats,
+		"lanes":   fts.GetLanes(),
 		"performance": map[string]interface{}{
 			"target_response_time_ms": fts.targetResponseTime.Milliseconds(),
 		},
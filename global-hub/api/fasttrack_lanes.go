@@ -0,0 +1,121 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Fast-track lane names
+const (
+	LanePremium  = "premium"
+	LaneStandard = "standard" // Used when a request names no lane
+	LaneEconomy  = "economy"
+)
+
+// FastTrackLane configures how travelers in one lane are verified and billed
+type FastTrackLane struct {
+	Name          string        `json:"name"`
+	MinTrustScore int32         `json:"min_trust_score"` // Travelers scoring lower are refused the lane
+	CacheMaxAge   time.Duration `json:"cache_max_age"`   // Cached trust older than this is re-verified live
+	ChargeUSOV    int64         `json:"charge_usov"`     // Carrier charge per verification
+	Priority      int           `json:"priority"`        // Higher lanes are served first at the checkpoint
+}
+
+// DefaultFastTrackLanes returns the built-in premium, standard and economy lanes
+// The standard lane matches verification before lanes existed: no trust
+// threshold, the full 24-hour cache and the 1 SOV standard charge.
+func DefaultFastTrackLanes() map[string]*FastTrackLane {
+	return map[string]*FastTrackLane{
+		LanePremium: {
+			Name:          LanePremium,
+			MinTrustScore: 70,
+			CacheMaxAge:   24 * time.Hour,
+			ChargeUSOV:    2500000, // 2.5 SOV
+			Priority:      2,
+		},
+		LaneStandard: {
+			Name:          LaneStandard,
+			MinTrustScore: 0,
+			CacheMaxAge:   24 * time.Hour,
+			ChargeUSOV:    1000000, // 1 SOV
+			Priority:      1,
+		},
+		LaneEconomy: {
+			Name:          LaneEconomy,
+			MinTrustScore: 0,
+			CacheMaxAge:   6 * time.Hour,
+			ChargeUSOV:    500000, // 0.5 SOV
+			Priority:      0,
+		},
+	}
+}
+
+// SetLane adds or replaces a fast-track lane
+func (fts *FastTrackService) SetLane(lane *FastTrackLane) error {
+	if lane == nil || strings.TrimSpace(lane.Name) == "" {
+		return fmt.Errorf("lane name required")
+	}
+	if lane.MinTrustScore < 0 || lane.MinTrustScore > 100 {
+		return fmt.Errorf("lane %s: minimum trust score must be 0-100, got %d", lane.Name, lane.MinTrustScore)
+	}
+	if lane.CacheMaxAge <= 0 {
+		return fmt.Errorf("lane %s: cache max age must be positive", lane.Name)
+	}
+	if lane.ChargeUSOV < 0 {
+		return fmt.Errorf("lane %s: charge cannot be negative", lane.Name)
+	}
+
+	fts.lanesMu.Lock()
+	defer fts.lanesMu.Unlock()
+
+	fts.lanes[strings.ToLower(lane.Name)] = lane
+	return nil
+}
+
+// GetLanes returns the configured lanes, highest priority first
+func (fts *FastTrackService) GetLanes() []*FastTrackLane {
+	fts.lanesMu.RLock()
+	defer fts.lanesMu.RUnlock()
+
+	lanes := make([]*FastTrackLane, 0, len(fts.lanes))
+	for _, lane := range fts.lanes {
+		lanes = append(lanes, lane)
+	}
+	sort.Slice(lanes, func(i, j int) bool {
+		if lanes[i].Priority != lanes[j].Priority {
+			return lanes[i].Priority > lanes[j].Priority
+		}
+		return lanes[i].Name < lanes[j].Name
+	})
+
+	return lanes
+}
+
+// resolveLane returns the lane a request asked for (standard if none)
+func (fts *FastTrackService) resolveLane(name string) (*FastTrackLane, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		name = LaneStandard
+	}
+
+	fts.lanesMu.RLock()
+	defer fts.lanesMu.RUnlock()
+
+	lane, exists := fts.lanes[name]
+	if !exists {
+		return nil, fmt.Errorf("unknown fast-track lane: %s", name)
+	}
+
+	return lane, nil
+}
+
+// laneRefusal returns why a trust score does not qualify for a lane ("" if it does)
+func laneRefusal(lane *FastTrackLane, trustScore int32) string {
+	if trustScore >= lane.MinTrustScore {
+		return ""
+	}
+
+	return fmt.Sprintf("Trust score %d below %s lane minimum %d", trustScore, lane.Name, lane.MinTrustScore)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"sync"
 	"time"
 )
 
@@ -13,8 +14,34 @@ type AILivenessScoring struct {
 	// Deepfake detection threshold (99.9% = 0.999)
 	confidenceThreshold float64
 	
-	// Random challenge generator
-	rng *rand.Rand
+	// Random challenge generator (guarded by rngMu; *rand.Rand is not goroutine-safe)
+	rng   *rand.Rand
+	rngMu sync.Mutex
+	
+	// Required liveness signals (default and per attested device profile)
+	defaultRequirements *LivenessRequirements
+	deviceRequirements  map[string]*LivenessRequirements
+	mu                  sync.RWMutex
+}
+
+// LivenessRequirements defines which liveness signals a scan must present
+// Capture hardware without infrared sensors cannot detect blood flow, so
+// attested device profiles may relax it and rely on other signals instead.
+type LivenessRequirements struct {
+	// RequireBloodFlow rejects scans without infrared blood flow detection
+	RequireBloodFlow bool
+	
+	// MinSignalScores sets a floor for individual analysis signals
+	// Keys match LivenessResult.AnalysisDetails (e.g., "micro_movement_score": 0.95)
+	MinSignalScores map[string]float64
+}
+
+// DefaultLivenessRequirements returns the requirements applied to unattested devices
+func DefaultLivenessRequirements() *LivenessRequirements {
+	return &LivenessRequirements{
+		RequireBloodFlow: true,
+		MinSignalScores:  make(map[string]float64),
+	}
 }
 
 // LivenessData contains biometric scan analysis
@@ -51,6 +78,7 @@ type LivenessResult struct {
 	DeepfakeRisk          string // "none", "low", "medium", "high", "critical"
 	Challenge             *LivenessChallenge
 	AnalysisDetails       map[string]float64
+	RequirementsProfile   string // Device profile whose requirements were applied ("default" if none)
 }
 
 // LivenessChallenge contains a random challenge for the user
@@ -64,10 +92,60 @@ type LivenessChallenge struct {
 
 // NewAILivenessScoring creates a new AI liveness scorer
 func NewAILivenessScoring() *AILivenessScoring {
+	return NewAILivenessScoringWithSource(rand.NewSource(time.Now().UnixNano()))
+}
+
+// NewAILivenessScoringWithSeed creates a scorer with a fixed seed
+// Scorers with the same seed select the same challenge sequence (for reproducible tests)
+func NewAILivenessScoringWithSeed(seed int64) *AILivenessScoring {
+	return NewAILivenessScoringWithSource(rand.NewSource(seed))
+}
+
+// NewAILivenessScoringWithSource creates a scorer drawing challenges from the given source
+func NewAILivenessScoringWithSource(source rand.Source) *AILivenessScoring {
 	return &AILivenessScoring{
 		confidenceThreshold: 0.999, // 99.9% threshold
-		rng:                 rand.New(rand.NewSource(time.Now().UnixNano())),
+		rng:                 rand.New(source),
+		defaultRequirements: DefaultLivenessRequirements(),
+		deviceRequirements:  make(map[string]*LivenessRequirements),
+	}
+}
+
+// SetDefaultRequirements sets the requirements for scans from unattested or unknown devices
+func (als *AILivenessScoring) SetDefaultRequirements(requirements *LivenessRequirements) {
+	als.mu.Lock()
+	defer als.mu.Unlock()
+	
+	als.defaultRequirements = requirements
+}
+
+// SetDeviceRequirements sets the requirements for an attested device profile (keyed by DeviceAttestation.DeviceModel)
+func (als *AILivenessScoring) SetDeviceRequirements(deviceProfile string, requirements *LivenessRequirements) error {
+	if deviceProfile == "" {
+		return fmt.Errorf("device profile required")
 	}
+	
+	als.mu.Lock()
+	defer als.mu.Unlock()
+	
+	als.deviceRequirements[deviceProfile] = requirements
+	return nil
+}
+
+// requirementsFor resolves the requirements for a scan
+// attestedProfile must come from a server-verified hardware attestation ("" = unattested);
+// device-specific requirements never apply to a profile the client merely claims.
+func (als *AILivenessScoring) requirementsFor(attestedProfile string) (*LivenessRequirements, string) {
+	als.mu.RLock()
+	defer als.mu.RUnlock()
+	
+	if attestedProfile != "" {
+		if requirements, exists := als.deviceRequirements[attestedProfile]; exists {
+			return requirements, attestedProfile
+		}
+	}
+	
+	return als.defaultRequirements, "default"
 }
 
 // AnalyzeLiveness performs AI-powered deepfake detection
@@ -75,6 +153,33 @@ func (als *AILivenessScoring) AnalyzeLiveness(
 	ctx context.Context,
 	data *LivenessData,
 ) (*LivenessResult, error) {
+	return als.AnalyzeLivenessWithThreshold(ctx, data, 0)
+}
+
+// AnalyzeLivenessWithThreshold performs deepfake detection against a given confidence threshold
+// A threshold of 0 uses the scorer's default (99.9%), e.g. for spokes without their own policy.
+// The default requirements apply; use AnalyzeAttestedLiveness for attested devices.
+func (als *AILivenessScoring) AnalyzeLivenessWithThreshold(
+	ctx context.Context,
+	data *LivenessData,
+	threshold float64,
+) (*LivenessResult, error) {
+	return als.AnalyzeAttestedLiveness(ctx, data, threshold, "")
+}
+
+// AnalyzeAttestedLiveness performs deepfake detection with device-specific requirements
+// attestedProfile is the device model from an attestation that passed server-side
+// verification ("" = unattested, default requirements).
+func (als *AILivenessScoring) AnalyzeAttestedLiveness(
+	ctx context.Context,
+	data *LivenessData,
+	threshold float64,
+	attestedProfile string,
+) (*LivenessResult, error) {
+	
+	if threshold <= 0 {
+		threshold = als.confidenceThreshold
+	}
 	
 	// Calculate overall confidence score
 	confidenceScore := als.calculateConfidenceScore(data)
@@ -95,7 +200,7 @@ func (als *AILivenessScoring) AnalyzeLiveness(
 	}
 	
 	// CRITICAL: Reject if confidence is below threshold
-	if confidenceScore < als.confidenceThreshold {
+	if confidenceScore < threshold {
 		// Generate random challenge
 		challenge := als.generateRandomChallenge()
 		
@@ -103,7 +208,7 @@ func (als *AILivenessScoring) AnalyzeLiveness(
 			Passed:            false,
 			RequiresChallenge: true,
 			Rejected:          false,
-			Reason:            fmt.Sprintf("Liveness confidence %.2f%% below threshold %.2f%% - random challenge required", confidenceScore*100, als.confidenceThreshold*100),
+			Reason:            fmt.Sprintf("Liveness confidence %.2f%% below threshold %.2f%% - random challenge required", confidenceScore*100, threshold*100),
 			ConfidenceScore:   confidenceScore,
 			DeepfakeRisk:      deepfakeRisk,
 			Challenge:         challenge,
@@ -111,8 +216,10 @@ func (als *AILivenessScoring) AnalyzeLiveness(
 		}, nil
 	}
 	
-	// CRITICAL: Reject if blood flow not detected
-	if !data.BloodFlowDetected {
+	requirements, profile := als.requirementsFor(attestedProfile)
+	
+	// CRITICAL: Reject if blood flow not detected (unless the device profile relaxes it)
+	if requirements.RequireBloodFlow && !data.BloodFlowDetected {
 		return &LivenessResult{
 			Passed:          false,
 			RequiresChallenge: false,
@@ -121,18 +228,36 @@ func (als *AILivenessScoring) AnalyzeLiveness(
 			ConfidenceScore: confidenceScore,
 			DeepfakeRisk:    "critical",
 			AnalysisDetails: analysisDetails,
+			RequirementsProfile: profile,
 		}, nil
 	}
 	
+	// Reject if any required signal falls below its floor
+	for signal, minScore := range requirements.MinSignalScores {
+		if analysisDetails[signal] < minScore {
+			return &LivenessResult{
+				Passed:              false,
+				RequiresChallenge:   false,
+				Rejected:            true,
+				Reason:              fmt.Sprintf("Required signal %s %.3f below minimum %.3f", signal, analysisDetails[signal], minScore),
+				ConfidenceScore:     confidenceScore,
+				DeepfakeRisk:        "high",
+				AnalysisDetails:     analysisDetails,
+				RequirementsProfile: profile,
+			}, nil
+		}
+	}
+	
 	// Pass: High confidence, real human detected
 	return &LivenessResult{
 		Passed:          true,
 		RequiresChallenge: false,
 		Rejected:        false,
-		Reason:          fmt.Sprintf("Liveness verified - %.2f%% confidence (threshold: %.2f%%)", confidenceScore*100, als.confidenceThreshold*100),
+		Reason:          fmt.Sprintf("Liveness verified - %.2f%% confidence (threshold: %.2f%%)", confidenceScore*100, threshold*100),
 		ConfidenceScore: confidenceScore,
 		DeepfakeRisk:    deepfakeRisk,
 		AnalysisDetails: analysisDetails,
+		RequirementsProfile: profile,
 	}, nil
 }
 
@@ -192,7 +317,9 @@ func (als *AILivenessScoring) generateRandomChallenge() *LivenessChallenge {
 		{"smile_neutral", "Smile, then return to neutral expression", 10},
 	}
 	
+	als.rngMu.Lock()
 	selected := challenges[als.rng.Intn(len(challenges))]
+	als.rngMu.Unlock()
 	
 	return &LivenessChallenge{
 		ChallengeID:   fmt.Sprintf("challenge-%d", time.Now().UnixNano()),
@@ -0,0 +1,74 @@
+package fraud
+
+import (
+	"fmt"
+)
+
+// VerificationStage identifies a security stage run by the fraud orchestrator
+type VerificationStage string
+
+const (
+	StageVelocity            VerificationStage = "velocity"             // Impossible travel detection
+	StageHardwareAttestation VerificationStage = "hardware_attestation" // Secure hardware verification
+	StageLiveness            VerificationStage = "liveness"             // AI deepfake detection
+)
+
+// AllVerificationStages lists every stage (the default for unconfigured checkpoints)
+var AllVerificationStages = []VerificationStage{StageVelocity, StageHardwareAttestation, StageLiveness}
+
+// CheckpointPolicy specifies which stages are mandatory at a checkpoint type
+// A boarding gate may require every stage, while an internal checkpoint that
+// already relies on cached trust can deliberately skip the expensive ones.
+type CheckpointPolicy struct {
+	CheckpointType string
+	RequiredStages []VerificationStage
+}
+
+// Requires returns true if the stage must run at this checkpoint
+func (cp *CheckpointPolicy) Requires(stage VerificationStage) bool {
+	for _, required := range cp.RequiredStages {
+		if required == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// SetCheckpointPolicy sets the mandatory stages for a checkpoint type
+func (fo *FraudOrchestrator) SetCheckpointPolicy(checkpointType string, stages []VerificationStage) error {
+	if checkpointType == "" {
+		return fmt.Errorf("checkpoint type required")
+	}
+
+	for _, stage := range stages {
+		if stage != StageVelocity && stage != StageHardwareAttestation && stage != StageLiveness {
+			return fmt.Errorf("unknown verification stage: %s", stage)
+		}
+	}
+
+	fo.mu.Lock()
+	defer fo.mu.Unlock()
+
+	fo.checkpointPolicies[checkpointType] = &CheckpointPolicy{
+		CheckpointType: checkpointType,
+		RequiredStages: stages,
+	}
+
+	return nil
+}
+
+// GetCheckpointPolicy returns the policy for a checkpoint type
+// Unconfigured checkpoint types run every stage
+func (fo *FraudOrchestrator) GetCheckpointPolicy(checkpointType string) *CheckpointPolicy {
+	fo.mu.RLock()
+	defer fo.mu.RUnlock()
+
+	if policy, exists := fo.checkpointPolicies[checkpointType]; exists {
+		return policy
+	}
+
+	return &CheckpointPolicy{
+		CheckpointType: checkpointType,
+		RequiredStages: AllVerificationStages,
+	}
+}
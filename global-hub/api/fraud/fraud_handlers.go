@@ -1,7 +1,6 @@
 package fraud
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -27,6 +26,7 @@ func (h *FraudHandlers) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/v1/fraud/hardware", h.HandleHardwareAttestation)
 	mux.HandleFunc("/v1/fraud/liveness", h.HandleLivenessCheck)
 	mux.HandleFunc("/v1/fraud/challenge/verify", h.HandleVerifyChallenge)
+	mux.HandleFunc("/v1/fraud/context", h.HandleGetVerificationContext)
 }
 
 // HandleFraudCheck handles POST /v1/fraud/check
@@ -47,7 +47,7 @@ func (h *FraudHandlers) HandleFraudCheck(w http.ResponseWriter, r *http.Request)
 		req.Timestamp = time.Now()
 	}
 	
-	ctx := context.Background()
+	ctx := r.Context()
 	result, err := h.orchestrator.PerformFraudCheck(ctx, &req)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -77,7 +77,7 @@ func (h *FraudHandlers) HandleVelocityCheck(w http.ResponseWriter, r *http.Reque
 		return
 	}
 	
-	ctx := context.Background()
+	ctx := r.Context()
 	result, err := h.orchestrator.velocityCheck.CheckVelocity(
 		ctx,
 		req.DID,
@@ -107,7 +107,7 @@ func (h *FraudHandlers) HandleHardwareAttestation(w http.ResponseWriter, r *http
 		return
 	}
 	
-	ctx := context.Background()
+	ctx := r.Context()
 	result, err := h.orchestrator.hardwareAttestation.VerifyAttestation(ctx, &attestation)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -131,7 +131,7 @@ func (h *FraudHandlers) HandleLivenessCheck(w http.ResponseWriter, r *http.Reque
 		return
 	}
 	
-	ctx := context.Background()
+	ctx := r.Context()
 	result, err := h.orchestrator.aiLiveness.AnalyzeLiveness(ctx, &livenessData)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -164,7 +164,7 @@ func (h *FraudHandlers) HandleVerifyChallenge(w http.ResponseWriter, r *http.Req
 	req.LivenessData.ChallengeCompleted = true
 	req.LivenessData.ChallengeResponse = req.ChallengeResponse
 	
-	ctx := context.Background()
+	ctx := r.Context()
 	result, err := h.orchestrator.aiLiveness.AnalyzeLiveness(ctx, &req.LivenessData)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -179,3 +179,25 @@ func (h *FraudHandlers) HandleVerifyChallenge(w http.ResponseWriter, r *http.Req
 	})
 }
 
+// HandleGetVerificationContext handles GET /v1/fraud/context?verification_id=...
+func (h *FraudHandlers) HandleGetVerificationContext(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	
+	verificationID := r.URL.Query().Get("verification_id")
+	if verificationID == "" {
+		http.Error(w, "verification_id is required", http.StatusBadRequest)
+		return
+	}
+	
+	vc, err := h.orchestrator.GetVerificationContext(r.Context(), verificationID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(vc)
+}
@@ -3,6 +3,7 @@ package fraud
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -12,6 +13,17 @@ type FraudOrchestrator struct {
 	velocityCheck       *VelocityCheck
 	hardwareAttestation *HardwareAttestation
 	aiLiveness          *AILivenessScoring
+	
+	// Mandatory stages per checkpoint type (unconfigured = all stages)
+	checkpointPolicies  map[string]*CheckpointPolicy
+	
+	// Per-spoke verification policies and DID country -> spoke routing
+	spokePolicies       map[string]*SpokePolicy
+	countrySpokes       map[string]string
+	
+	// Durable record of how each verification was decided
+	contextStore         VerificationContextStore
+	mu                   sync.RWMutex
 }
 
 // VerificationRequest contains all data needed for fraud detection
@@ -32,6 +44,9 @@ type VerificationRequest struct {
 	LivenessData      *LivenessData
 	
 	// Context
+	CheckpointType    string // Selects the checkpoint policy (e.g., "boarding_gate")
+	SpokeID           string // Spoke the verification is routed to (empty = resolved from the DID country)
+	CacheStatus       string // "hit" or "miss" on the temporal trust cache (recorded in the verification context)
 	VerificationID    string
 	Timestamp         time.Time
 }
@@ -56,6 +71,11 @@ type FraudCheckResult struct {
 	OverallRiskLevel  string // "none", "low", "medium", "high", "critical"
 	FraudFlags        []string
 	
+	// Stages skipped by the checkpoint and spoke policies
+	CheckpointType    string
+	SpokeID           string
+	SkippedStages     []VerificationStage
+	
 	// Metadata
 	VerificationID    string
 	Timestamp         time.Time
@@ -65,13 +85,20 @@ type FraudCheckResult struct {
 // NewFraudOrchestrator creates a new fraud orchestrator
 func NewFraudOrchestrator() *FraudOrchestrator {
 	return &FraudOrchestrator{
-		velocityCheck:       NewVelocityCheck(),
-		hardwareAttestation: NewHardwareAttestation(),
-		aiLiveness:          NewAILivenessScoring(),
+		velocityCheck:        NewVelocityCheck(),
+		hardwareAttestation:  NewHardwareAttestation(),
+		aiLiveness:           NewAILivenessScoring(),
+		checkpointPolicies:   make(map[string]*CheckpointPolicy),
+		spokePolicies:        make(map[string]*SpokePolicy),
+		countrySpokes:        make(map[string]string),
+		contextStore:         NewMemoryVerificationContextStore(),
 	}
 }
 
-// PerformFraudCheck runs all fraud detection checks
+// PerformFraudCheck runs the fraud detection checks required at the request's checkpoint
+// The spoke policy (resolved from SpokeID or the DID country) adds its mandatory stages
+// and liveness threshold. Stages neither policy requires are skipped and reported as passed.
+// The stages, scores and cache status are recorded as a VerificationContext.
 func (fo *FraudOrchestrator) PerformFraudCheck(
 	ctx context.Context,
 	req *VerificationRequest,
@@ -79,17 +106,26 @@ func (fo *FraudOrchestrator) PerformFraudCheck(
 	
 	startTime := time.Now()
 	fraudFlags := []string{}
+	policy := fo.GetCheckpointPolicy(req.CheckpointType)
+	spokePolicy := fo.resolveSpokePolicy(req)
+	skippedStages := []VerificationStage{}
 	
 	// 1. VELOCITY CHECK: Detect impossible travel
-	velocityResult, err := fo.velocityCheck.CheckVelocity(
-		ctx,
-		req.DID,
-		req.Latitude,
-		req.Longitude,
-		req.Location,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("velocity check failed: %w", err)
+	velocityResult := &VelocityCheckResult{Passed: true, Reason: "Skipped by checkpoint policy"}
+	if policy.Requires(StageVelocity) || spokePolicy.Requires(StageVelocity) {
+		var err error
+		velocityResult, err = fo.velocityCheck.CheckVelocity(
+			ctx,
+			req.DID,
+			req.Latitude,
+			req.Longitude,
+			req.Location,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("velocity check failed: %w", err)
+		}
+	} else {
+		skippedStages = append(skippedStages, StageVelocity)
 	}
 	
 	if velocityResult.RequiresStepUp {
@@ -97,12 +133,33 @@ func (fo *FraudOrchestrator) PerformFraudCheck(
 	}
 	
 	// 2. HARDWARE ATTESTATION: Verify secure hardware
-	hardwareResult, err := fo.hardwareAttestation.VerifyAttestation(
-		ctx,
-		req.DeviceAttestation,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("hardware attestation failed: %w", err)
+	hardwareResult := &AttestationResult{Passed: true, Reason: "Skipped by checkpoint policy"}
+	attestedProfile := ""
+	if (policy.Requires(StageHardwareAttestation) || spokePolicy.Requires(StageHardwareAttestation)) && req.DeviceAttestation == nil {
+		// A mandatory attestation that was never presented cannot pass
+		hardwareResult = &AttestationResult{
+			Passed:        false,
+			Rejected:      true,
+			Reason:        "Hardware attestation required but not provided",
+			TrustLevel:    "untrusted",
+			SecurityFlags: []string{"MISSING_ATTESTATION"},
+		}
+	} else if policy.Requires(StageHardwareAttestation) || spokePolicy.Requires(StageHardwareAttestation) {
+		var err error
+		hardwareResult, err = fo.hardwareAttestation.VerifyAttestation(
+			ctx,
+			req.DeviceAttestation,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("hardware attestation failed: %w", err)
+		}
+		
+		// Device-specific liveness requirements only apply to a device verified here
+		if hardwareResult.Passed {
+			attestedProfile = req.DeviceAttestation.DeviceModel
+		}
+	} else {
+		skippedStages = append(skippedStages, StageHardwareAttestation)
 	}
 	
 	if hardwareResult.Rejected {
@@ -110,12 +167,20 @@ func (fo *FraudOrchestrator) PerformFraudCheck(
 	}
 	
 	// 3. AI LIVENESS SCORING: Detect deepfakes
-	livenessResult, err := fo.aiLiveness.AnalyzeLiveness(
-		ctx,
-		req.LivenessData,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("liveness analysis failed: %w", err)
+	livenessResult := &LivenessResult{Passed: true, Reason: "Skipped by checkpoint policy", DeepfakeRisk: "none"}
+	if policy.Requires(StageLiveness) || spokePolicy.Requires(StageLiveness) {
+		var err error
+		livenessResult, err = fo.aiLiveness.AnalyzeAttestedLiveness(
+			ctx,
+			req.LivenessData,
+			spokePolicy.MinLivenessConfidence,
+			attestedProfile,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("liveness analysis failed: %w", err)
+		}
+	} else {
+		skippedStages = append(skippedStages, StageLiveness)
 	}
 	
 	if livenessResult.RequiresChallenge {
@@ -148,7 +213,7 @@ func (fo *FraudOrchestrator) PerformFraudCheck(
 	
 	processingTime := time.Since(startTime).Milliseconds()
 	
-	return &FraudCheckResult{
+	result := &FraudCheckResult{
 		Passed:           passed,
 		Rejected:         rejected,
 		RequiresStepUp:   requiresStepUp,
@@ -159,10 +224,18 @@ func (fo *FraudOrchestrator) PerformFraudCheck(
 		Challenge:        challenge,
 		OverallRiskLevel: overallRisk,
 		FraudFlags:       fraudFlags,
+		CheckpointType:   req.CheckpointType,
+		SpokeID:          spokePolicy.SpokeID,
+		SkippedStages:    skippedStages,
 		VerificationID:   req.VerificationID,
 		Timestamp:        time.Now(),
 		ProcessingTimeMs: processingTime,
-	}, nil
+	}
+	
+	// Persist how this verification was decided for later audit
+	fo.recordVerificationContext(ctx, req, result)
+	
+	return result, nil
 }
 
 // calculateOverallRisk determines the highest risk level from all checks
@@ -28,6 +28,30 @@ CREATE TABLE fraud_events (
   INDEX idx_fraud_events_timestamp (timestamp DESC)
 );
 
+-- Verification Contexts (how each verification was decided, for dispute review)
+CREATE TABLE verification_contexts (
+  verification_id TEXT PRIMARY KEY,
+  did TEXT NOT NULL,
+  checkpoint_type TEXT,
+  spoke_id TEXT,
+  stages_run JSONB NOT NULL,
+  skipped_stages JSONB,
+  velocity_passed BOOLEAN NOT NULL,
+  impossible_travel BOOLEAN NOT NULL DEFAULT FALSE,
+  device_trust_level TEXT,
+  device_fingerprint_hash TEXT,
+  liveness_confidence DECIMAL(5, 4),
+  deepfake_risk TEXT,
+  cache_status TEXT NOT NULL CHECK (cache_status IN ('hit', 'miss', 'unknown')),
+  outcome TEXT NOT NULL CHECK (outcome IN ('passed', 'rejected', 'requires_step_up', 'failed')),
+  overall_risk_level TEXT NOT NULL,
+  fraud_flags JSONB,
+  timestamp TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  
+  INDEX idx_verification_contexts_did (did),
+  INDEX idx_verification_contexts_timestamp (timestamp DESC)
+);
+
 -- Velocity Tracking
 CREATE TABLE velocity_tracking (
   tracking_id SERIAL PRIMARY KEY,
@@ -0,0 +1,118 @@
+package fraud
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SpokePolicy specifies what a National Spoke requires of a valid verification
+// Spokes follow different national regulations: one may mandate hardware
+// attestation, another may accept a lower liveness confidence. A verification
+// routed to a spoke is held to that spoke's policy on top of the checkpoint policy.
+type SpokePolicy struct {
+	SpokeID string
+
+	// RequiredStages are mandatory for this spoke even where the checkpoint policy skips them
+	RequiredStages []VerificationStage
+
+	// MinLivenessConfidence replaces the scorer's liveness threshold (0 = scorer default, 99.9%)
+	MinLivenessConfidence float64
+}
+
+// Requires returns true if the spoke mandates the stage
+func (sp *SpokePolicy) Requires(stage VerificationStage) bool {
+	for _, required := range sp.RequiredStages {
+		if required == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSpokePolicy sets the verification policy for a National Spoke
+func (fo *FraudOrchestrator) SetSpokePolicy(policy *SpokePolicy) error {
+	if policy == nil || policy.SpokeID == "" {
+		return fmt.Errorf("spoke ID required")
+	}
+
+	for _, stage := range policy.RequiredStages {
+		if stage != StageVelocity && stage != StageHardwareAttestation && stage != StageLiveness {
+			return fmt.Errorf("unknown verification stage: %s", stage)
+		}
+	}
+
+	if policy.MinLivenessConfidence < 0 || policy.MinLivenessConfidence > 1 {
+		return fmt.Errorf("liveness confidence must be between 0 and 1")
+	}
+
+	fo.mu.Lock()
+	defer fo.mu.Unlock()
+
+	fo.spokePolicies[policy.SpokeID] = policy
+
+	return nil
+}
+
+// SetCountrySpoke routes DIDs of a country (did:sovra:{country}:{id}, case-insensitive) to a spoke
+// Countries without an entry use the country code itself as the spoke ID.
+func (fo *FraudOrchestrator) SetCountrySpoke(country string, spokeID string) error {
+	if country == "" || spokeID == "" {
+		return fmt.Errorf("country and spoke ID required")
+	}
+
+	fo.mu.Lock()
+	defer fo.mu.Unlock()
+
+	fo.countrySpokes[strings.ToLower(country)] = spokeID
+
+	return nil
+}
+
+// GetSpokePolicy returns the policy for a spoke
+// Unconfigured spokes add no requirements and keep the default liveness threshold
+func (fo *FraudOrchestrator) GetSpokePolicy(spokeID string) *SpokePolicy {
+	fo.mu.RLock()
+	defer fo.mu.RUnlock()
+
+	if policy, exists := fo.spokePolicies[spokeID]; exists {
+		return policy
+	}
+
+	return &SpokePolicy{SpokeID: spokeID}
+}
+
+// resolveSpokePolicy picks the spoke policy for a verification
+//
+// PRECEDENCE:
+// 1. VerificationRequest.SpokeID (the caller already routed the verification)
+// 2. DID country (did:sovra:{country}:{id}) -> SetCountrySpoke mapping, else the country itself
+// 3. Neither -> the unconfigured policy (no extra requirements)
+func (fo *FraudOrchestrator) resolveSpokePolicy(req *VerificationRequest) *SpokePolicy {
+	if req.SpokeID != "" {
+		return fo.GetSpokePolicy(req.SpokeID)
+	}
+
+	country := didCountry(req.DID)
+	if country == "" {
+		return &SpokePolicy{}
+	}
+
+	fo.mu.RLock()
+	spokeID, exists := fo.countrySpokes[country]
+	fo.mu.RUnlock()
+
+	if !exists {
+		spokeID = country
+	}
+
+	return fo.GetSpokePolicy(spokeID)
+}
+
+// didCountry extracts the lowercased country segment of a did:sovra:{country}:{id} DID ("" if malformed)
+func didCountry(did string) string {
+	parts := strings.Split(did, ":")
+	if len(parts) < 4 || parts[0] != "did" || parts[2] == "" {
+		return ""
+	}
+	return strings.ToLower(parts[2])
+}
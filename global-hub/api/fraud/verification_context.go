@@ -0,0 +1,156 @@
+package fraud
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Cache status values reported by callers in VerificationRequest.CacheStatus
+const (
+	CacheStatusHit     = "hit"     // Served from the temporal trust cache
+	CacheStatusMiss    = "miss"    // Fresh ZK-proof verification with the spoke
+	CacheStatusUnknown = "unknown" // Caller did not report cache status
+)
+
+// VerificationContext is the durable record of how a verification was decided
+// Kept so later disputes can check which due-diligence stages actually applied.
+type VerificationContext struct {
+	VerificationID string
+	DID            string
+	CheckpointType string
+	SpokeID        string
+
+	// Stages
+	StagesRun     []VerificationStage
+	SkippedStages []VerificationStage
+
+	// Scores and signals
+	VelocityPassed        bool
+	ImpossibleTravel      bool
+	DeviceTrustLevel      string
+	DeviceFingerprintHash string // SHA-256 of the device fingerprint (raw fingerprint is not retained)
+	LivenessConfidence    float64
+	DeepfakeRisk          string
+
+	// Outcome
+	CacheStatus      string
+	Outcome          string // "passed", "rejected", "requires_step_up", "failed"
+	OverallRiskLevel string
+	FraudFlags       []string
+	Timestamp        time.Time
+}
+
+// SetVerificationContextStore replaces where verification contexts are persisted (default: in-memory)
+func (fo *FraudOrchestrator) SetVerificationContextStore(store VerificationContextStore) {
+	fo.mu.Lock()
+	defer fo.mu.Unlock()
+
+	fo.contextStore = store
+}
+
+// GetVerificationContext returns the recorded context for a verification
+func (fo *FraudOrchestrator) GetVerificationContext(ctx context.Context, verificationID string) (*VerificationContext, error) {
+	fo.mu.RLock()
+	store := fo.contextStore
+	fo.mu.RUnlock()
+
+	vc, err := store.Load(ctx, verificationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load verification context: %w", err)
+	}
+	if vc == nil {
+		return nil, fmt.Errorf("verification context not found: %s", verificationID)
+	}
+
+	return vc, nil
+}
+
+// GetVerificationContextsForDID returns every recorded context for a DID
+func (fo *FraudOrchestrator) GetVerificationContextsForDID(ctx context.Context, did string) ([]*VerificationContext, error) {
+	fo.mu.RLock()
+	store := fo.contextStore
+	fo.mu.RUnlock()
+
+	contexts, err := store.ListByDID(ctx, did)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load verification contexts: %w", err)
+	}
+
+	return contexts, nil
+}
+
+// recordVerificationContext stores the context of a completed fraud check
+// Requests without a verification ID cannot be looked up later and are not recorded.
+func (fo *FraudOrchestrator) recordVerificationContext(ctx context.Context, req *VerificationRequest, result *FraudCheckResult) {
+	if req.VerificationID == "" {
+		return
+	}
+
+	stagesRun := []VerificationStage{}
+	for _, stage := range AllVerificationStages {
+		skipped := false
+		for _, s := range result.SkippedStages {
+			if s == stage {
+				skipped = true
+				break
+			}
+		}
+		if !skipped {
+			stagesRun = append(stagesRun, stage)
+		}
+	}
+
+	cacheStatus := req.CacheStatus
+	if cacheStatus == "" {
+		cacheStatus = CacheStatusUnknown
+	}
+
+	vc := &VerificationContext{
+		VerificationID:     req.VerificationID,
+		DID:                req.DID,
+		CheckpointType:     req.CheckpointType,
+		SpokeID:            result.SpokeID,
+		StagesRun:          stagesRun,
+		SkippedStages:      result.SkippedStages,
+		VelocityPassed:     result.VelocityCheck.Passed,
+		ImpossibleTravel:   result.VelocityCheck.ImpossibleTravel,
+		DeviceTrustLevel:   result.HardwareCheck.TrustLevel,
+		LivenessConfidence: result.LivenessCheck.ConfidenceScore,
+		DeepfakeRisk:       result.LivenessCheck.DeepfakeRisk,
+		CacheStatus:        cacheStatus,
+		Outcome:            verificationOutcome(result),
+		OverallRiskLevel:   result.OverallRiskLevel,
+		FraudFlags:         result.FraudFlags,
+		Timestamp:          result.Timestamp,
+	}
+
+	if req.DeviceAttestation != nil && req.DeviceAttestation.DeviceFingerprint != "" {
+		hash := sha256.Sum256([]byte(req.DeviceAttestation.DeviceFingerprint))
+		vc.DeviceFingerprintHash = hex.EncodeToString(hash[:])
+	}
+
+	fo.mu.RLock()
+	store := fo.contextStore
+	fo.mu.RUnlock()
+
+	if err := store.Save(ctx, vc); err != nil {
+		fmt.Printf("Warning: failed to persist verification context %s: %v\n", req.VerificationID, err)
+	}
+}
+
+// verificationOutcome summarizes a fraud check result as a single outcome
+func verificationOutcome(result *FraudCheckResult) string {
+	switch {
+	case result.Rejected:
+		return "rejected"
+	case result.RequiresStepUp:
+		return "requires_step_up"
+	case result.Passed:
+		return "passed"
+	default:
+		return "failed"
+	}
+}
@@ -0,0 +1,164 @@
+package fraud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// VerificationContextStore persists verification contexts so disputes can be checked after a restart
+// Production deployments back it with the verification_contexts table.
+type VerificationContextStore interface {
+	Save(ctx context.Context, vc *VerificationContext) error
+	Load(ctx context.Context, verificationID string) (*VerificationContext, error) // nil if none stored
+	ListByDID(ctx context.Context, did string) ([]*VerificationContext, error)
+}
+
+// MemoryVerificationContextStore keeps verification contexts in memory (lost on restart)
+type MemoryVerificationContextStore struct {
+	contexts map[string]*VerificationContext // verificationID -> context
+	mu       sync.RWMutex
+}
+
+// NewMemoryVerificationContextStore creates an in-memory verification context store
+func NewMemoryVerificationContextStore() *MemoryVerificationContextStore {
+	return &MemoryVerificationContextStore{
+		contexts: make(map[string]*VerificationContext),
+	}
+}
+
+// Save stores a verification context
+func (ms *MemoryVerificationContextStore) Save(ctx context.Context, vc *VerificationContext) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.contexts[vc.VerificationID] = vc
+	return nil
+}
+
+// Load returns the context for a verification, or nil if none
+func (ms *MemoryVerificationContextStore) Load(ctx context.Context, verificationID string) (*VerificationContext, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	return ms.contexts[verificationID], nil
+}
+
+// ListByDID returns every stored context for a DID
+func (ms *MemoryVerificationContextStore) ListByDID(ctx context.Context, did string) ([]*VerificationContext, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	return contextsForDID(ms.contexts, did), nil
+}
+
+// FileVerificationContextStore persists verification contexts to a JSON file so they survive restarts
+// Suitable for a single hub process; the file is rewritten atomically on every change.
+type FileVerificationContextStore struct {
+	path     string
+	contexts map[string]*VerificationContext // verificationID -> context
+	mu       sync.RWMutex
+}
+
+// NewFileVerificationContextStore opens (or creates) a file-backed verification context store
+func NewFileVerificationContextStore(path string) (*FileVerificationContextStore, error) {
+	fs := &FileVerificationContextStore{
+		path:     path,
+		contexts: make(map[string]*VerificationContext),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read verification context store: %w", err)
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &fs.contexts); err != nil {
+			return nil, fmt.Errorf("failed to decode verification context store: %w", err)
+		}
+	}
+
+	return fs, nil
+}
+
+// Save stores a verification context and rewrites the file
+func (fs *FileVerificationContextStore) Save(ctx context.Context, vc *VerificationContext) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	previous, existed := fs.contexts[vc.VerificationID]
+	fs.contexts[vc.VerificationID] = vc
+
+	if err := fs.persistLocked(); err != nil {
+		// Roll back so memory and disk agree
+		if existed {
+			fs.contexts[vc.VerificationID] = previous
+		} else {
+			delete(fs.contexts, vc.VerificationID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Load returns the context for a verification, or nil if none
+func (fs *FileVerificationContextStore) Load(ctx context.Context, verificationID string) (*VerificationContext, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	return fs.contexts[verificationID], nil
+}
+
+// ListByDID returns every stored context for a DID
+func (fs *FileVerificationContextStore) ListByDID(ctx context.Context, did string) ([]*VerificationContext, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	return contextsForDID(fs.contexts, did), nil
+}
+
+// persistLocked writes all contexts via a temp file and rename (caller holds fs.mu)
+func (fs *FileVerificationContextStore) persistLocked() error {
+	data, err := json.Marshal(fs.contexts)
+	if err != nil {
+		return fmt.Errorf("failed to encode verification context store: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fs.path), filepath.Base(fs.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write verification context store: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write verification context store: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write verification context store: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), fs.path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to replace verification context store: %w", err)
+	}
+
+	return nil
+}
+
+// contextsForDID filters stored contexts by DID
+func contextsForDID(contexts map[string]*VerificationContext, did string) []*VerificationContext {
+	matches := make([]*VerificationContext, 0)
+	for _, vc := range contexts {
+		if vc.DID == did {
+			matches = append(matches, vc)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,110 @@
+package geofence
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+/**
+ * SOVRA_Sovereign_Kernel - Security Alert Decryption
+ *
+ * Counterpart to encryptAlert for security forces holding the alert key
+ * Authenticates the AES-256-GCM payload and recovers the subject and location
+ */
+
+// AlertPayload is the sensitive part of a SecurityAlert, as encrypted into EncryptedPayload
+type AlertPayload struct {
+	DID            string  `json:"did"`
+	ThreatLevel    string  `json:"threat_level"`
+	Latitude       float64 `json:"latitude"`
+	Longitude      float64 `json:"longitude"`
+	LocationName   string  `json:"location_name"`
+	SecurityZone   string  `json:"security_zone"`
+	Reason         string  `json:"reason"`
+	VerificationID string  `json:"verification_id"`
+	Timestamp      string  `json:"timestamp"` // RFC3339
+}
+
+// DecryptAlert decrypts and authenticates an alert payload into its raw fields
+func (ws *WatchlistService) DecryptAlert(encryptedPayload, iv string) (map[string]interface{}, error) {
+	plaintext, err := ws.openAlert(encryptedPayload, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(plaintext, &fields); err != nil {
+		return nil, fmt.Errorf("failed to decode alert payload: %w", err)
+	}
+
+	return fields, nil
+}
+
+// DecryptAlertPayload decrypts and authenticates an alert payload into an AlertPayload
+func (ws *WatchlistService) DecryptAlertPayload(encryptedPayload, iv string) (*AlertPayload, error) {
+	plaintext, err := ws.openAlert(encryptedPayload, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	var payload AlertPayload
+	if err := json.Unmarshal(plaintext, &payload); err != nil {
+		return nil, fmt.Errorf("failed to decode alert payload: %w", err)
+	}
+
+	return &payload, nil
+}
+
+// openAlert reverses encryptAlert
+//
+// DECRYPTION LOGIC:
+// 1. Base64-decode the payload (nonce || ciphertext) and the IV
+// 2. IV must be exactly the GCM nonce size and match the nonce prefixed to the payload
+// 3. GCM open authenticates the ciphertext (wrong key or tampering fails here)
+func (ws *WatchlistService) openAlert(encryptedPayload, iv string) ([]byte, error) {
+	// 1. Decode
+	sealed, err := base64.StdEncoding.DecodeString(encryptedPayload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alert payload encoding: %w", err)
+	}
+
+	nonce, err := base64.StdEncoding.DecodeString(iv)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alert IV encoding: %w", err)
+	}
+
+	block, err := aes.NewCipher(ws.encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Nonce checks
+	if len(nonce) != gcm.NonceSize() {
+		return nil, fmt.Errorf("invalid alert IV: %d bytes, expected %d", len(nonce), gcm.NonceSize())
+	}
+
+	if len(sealed) < gcm.NonceSize()+gcm.Overhead() {
+		return nil, fmt.Errorf("alert payload too short")
+	}
+
+	if !bytes.Equal(sealed[:gcm.NonceSize()], nonce) {
+		return nil, fmt.Errorf("alert IV does not match payload nonce")
+	}
+
+	// 3. Authenticate and decrypt
+	plaintext, err := gcm.Open(nil, nonce, sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("alert authentication failed: %w", err)
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,158 @@
+package geofence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+/**
+ * SOVRA_Sovereign_Kernel - Security Alert Overflow
+ *
+ * Core ledger security function for keeping alerts that don't fit the alert channel
+ * A full channel hands surplus alerts to an OverflowHandler instead of dropping them
+ */
+
+// DefaultAlertBufferSize is the alert channel capacity used when none is configured
+const DefaultAlertBufferSize = 100
+
+// OverflowHandler receives alerts that did not fit in the alert channel
+type OverflowHandler interface {
+	// HandleOverflow keeps an alert the channel could not accept
+	HandleOverflow(ctx context.Context, alert SecurityAlert) error
+}
+
+// MemoryOverflowQueue keeps overflowed alerts in memory until drained (default handler)
+type MemoryOverflowQueue struct {
+	alerts []SecurityAlert
+	mu     sync.Mutex
+}
+
+// NewMemoryOverflowQueue creates an empty in-memory overflow queue
+func NewMemoryOverflowQueue() *MemoryOverflowQueue {
+	return &MemoryOverflowQueue{}
+}
+
+// HandleOverflow queues an alert
+func (mq *MemoryOverflowQueue) HandleOverflow(ctx context.Context, alert SecurityAlert) error {
+	mq.mu.Lock()
+	defer mq.mu.Unlock()
+
+	mq.alerts = append(mq.alerts, alert)
+	return nil
+}
+
+// Drain returns and removes every queued alert, oldest first
+func (mq *MemoryOverflowQueue) Drain() []SecurityAlert {
+	mq.mu.Lock()
+	defer mq.mu.Unlock()
+
+	alerts := mq.alerts
+	mq.alerts = nil
+	return alerts
+}
+
+// Len returns the number of queued alerts
+func (mq *MemoryOverflowQueue) Len() int {
+	mq.mu.Lock()
+	defer mq.mu.Unlock()
+
+	return len(mq.alerts)
+}
+
+// overflowRecord is the persisted form of an alert (encrypted payload only, no plaintext subject or location)
+type overflowRecord struct {
+	AlertID          string    `json:"alert_id"`
+	AlertType        string    `json:"alert_type"`
+	Timestamp        time.Time `json:"timestamp"`
+	EncryptedPayload string    `json:"encrypted_payload"`
+	IV               string    `json:"iv"`
+}
+
+// FileOverflowHandler appends overflowed alerts to a JSON-lines file
+// Only the encrypted payload is written; security forces decrypt it as they would a channel alert.
+type FileOverflowHandler struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileOverflowHandler creates a handler appending to path (created if missing)
+func NewFileOverflowHandler(path string) *FileOverflowHandler {
+	return &FileOverflowHandler{path: path}
+}
+
+// HandleOverflow appends an alert to the file and syncs it to disk
+func (fh *FileOverflowHandler) HandleOverflow(ctx context.Context, alert SecurityAlert) error {
+	if alert.EncryptedPayload == "" {
+		return fmt.Errorf("alert %s has no encrypted payload", alert.AlertID)
+	}
+
+	line, err := json.Marshal(overflowRecord{
+		AlertID:          alert.AlertID,
+		AlertType:        alert.AlertType,
+		Timestamp:        alert.Timestamp,
+		EncryptedPayload: alert.EncryptedPayload,
+		IV:               alert.IV,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode alert %s: %w", alert.AlertID, err)
+	}
+
+	fh.mu.Lock()
+	defer fh.mu.Unlock()
+
+	file, err := os.OpenFile(fh.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open alert overflow file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write alert %s: %w", alert.AlertID, err)
+	}
+
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync alert %s: %w", alert.AlertID, err)
+	}
+
+	return nil
+}
+
+// deliverAlert sends an alert on the channel, handing it to the overflow handler if the channel is full
+//
+// DELIVERY LOGIC:
+// 1. Non-blocking send on the alert channel
+// 2. Channel full -> OverflowHandler keeps the alert
+// 3. Handler failed and the alert is critical -> block on the channel until a subscriber takes it or ctx ends
+// Only a non-critical alert whose overflow handling failed is reported as lost.
+func (ws *WatchlistService) deliverAlert(ctx context.Context, alert SecurityAlert) error {
+	// 1. Channel
+	select {
+	case ws.alertChannel <- alert:
+		return nil
+	default:
+	}
+
+	// 2. Overflow handler
+	err := ws.overflowHandler.HandleOverflow(ctx, alert)
+	if err == nil {
+		return nil
+	}
+
+	// 3. Critical alerts wait for channel capacity rather than being dropped
+	if alert.ThreatLevel != "critical" {
+		return fmt.Errorf("alert channel full and overflow handling failed - alert %s lost: %w", alert.AlertID, err)
+	}
+
+	fmt.Printf("⚠️  Overflow handling failed for critical alert %s, waiting for channel capacity: %v\n", alert.AlertID, err)
+
+	select {
+	case ws.alertChannel <- alert:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("critical alert %s undelivered: overflow failed (%v) and %w", alert.AlertID, err, ctx.Err())
+	}
+}
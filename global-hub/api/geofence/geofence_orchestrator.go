@@ -57,6 +57,10 @@ type GeofenceCheckResult struct {
 	AlertTriggered  bool
 	AlertID         string
 	
+	// Impossible travel since the DID's last scan
+	ImpossibleTravel bool
+	TravelCheck      *TravelCheckResult
+	
 	// Overall result
 	Passed          bool
 	Reason          string
@@ -67,7 +71,8 @@ type GeofenceCheckResult struct {
 func NewGeofenceOrchestrator(encryptionKey []byte) *GeofenceOrchestrator {
 	geofenceService := NewGeofenceService()
 	stepUpService := NewStepUpAuthService(geofenceService)
-	watchlistService := NewWatchlistService(encryptionKey)
+	watchlistService := NewWatchlistService(encryptionKey, DefaultAlertBufferSize, nil)
+	watchlistService.SetZoneRegistry(geofenceService.GetZoneRegistry())
 	
 	return &GeofenceOrchestrator{
 		geofenceService:  geofenceService,
@@ -122,6 +127,24 @@ func (go_orch *GeofenceOrchestrator) PerformGeofenceCheck(
 		}
 	}
 	
+	// 4b. IMPOSSIBLE TRAVEL: Compare against the DID's last scan (alerts are raised by the watchlist service)
+	scannedAt := req.Timestamp
+	if scannedAt.IsZero() {
+		scannedAt = time.Now()
+	}
+	
+	travelCheck, err := go_orch.watchlistService.CheckImpossibleTravel(
+		ctx,
+		req.DID,
+		req.Latitude,
+		req.Longitude,
+		scannedAt,
+	)
+	if err != nil {
+		fmt.Printf("⚠️  Impossible travel check failed for %s: %v\n", req.VerificationID, err)
+	}
+	impossibleTravel := travelCheck != nil && travelCheck.ImpossibleTravel
+	
 	// 5. DETERMINE OVERALL RESULT
 	passed := true
 	reason := "Geofence check passed"
@@ -154,6 +177,8 @@ func (go_orch *GeofenceOrchestrator) PerformGeofenceCheck(
 		WatchlistEntry:   watchlistEntry,
 		AlertTriggered:   alertTriggered,
 		AlertID:          alertID,
+		ImpossibleTravel: impossibleTravel,
+		TravelCheck:      travelCheck,
 		Passed:           passed,
 		Reason:           reason,
 		ProcessingTimeMs: processingTime,
@@ -165,3 +190,13 @@ func (go_orch *GeofenceOrchestrator) GetAlertChannel() <-chan SecurityAlert {
 	return go_orch.watchlistService.GetAlertChannel()
 }
 
+// GetZoneRegistry returns the security zones shared by zone checks and the watchlist
+func (go_orch *GeofenceOrchestrator) GetZoneRegistry() *ZoneRegistry {
+	return go_orch.geofenceService.GetZoneRegistry()
+}
+
+// GetOverflowHandler returns the handler keeping security alerts that overflowed the alert channel
+func (go_orch *GeofenceOrchestrator) GetOverflowHandler() OverflowHandler {
+	return go_orch.watchlistService.GetOverflowHandler()
+}
+
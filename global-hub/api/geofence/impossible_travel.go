@@ -0,0 +1,158 @@
+package geofence
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+/**
+ * SOVRA_Sovereign_Kernel - Impossible Travel Alerts
+ *
+ * Core ledger security function for detecting cloned or shared identities
+ * A DID scanned farther from its last scan than any aircraft could fly raises an encrypted impossible_travel alert
+ */
+
+// Impossible travel defaults
+const (
+	DefaultMaxTravelSpeedKmh = 1000.0 // Faster than any commercial flight
+	TravelJitterToleranceKm  = 1.0    // Shorter hops are GPS jitter, not travel
+)
+
+// LastSeenLocation is where and when a DID was last scanned
+type LastSeenLocation struct {
+	Latitude  float64
+	Longitude float64
+	Timestamp time.Time
+}
+
+// TravelCheckResult contains the result of an impossible travel check
+type TravelCheckResult struct {
+	ImpossibleTravel bool
+	FirstSighting    bool // No previous scan to compare against
+	DistanceKm       float64
+	Elapsed          time.Duration
+	RequiredSpeedKmh float64 // 0 when the hop is within jitter tolerance
+	MaxSpeedKmh      float64
+	AlertID          string // Set when an alert was raised
+}
+
+// SetMaxTravelSpeed sets the speed above which travel between scans is impossible
+func (ws *WatchlistService) SetMaxTravelSpeed(kmh float64) error {
+	if kmh <= 0 {
+		return fmt.Errorf("max travel speed must be positive")
+	}
+
+	ws.travelMu.Lock()
+	defer ws.travelMu.Unlock()
+
+	ws.maxTravelSpeedKmh = kmh
+	return nil
+}
+
+// GetLastSeen returns the last recorded scan location of a DID
+func (ws *WatchlistService) GetLastSeen(did string) (LastSeenLocation, bool) {
+	ws.travelMu.Lock()
+	defer ws.travelMu.Unlock()
+
+	location, exists := ws.lastSeen[did]
+	return location, exists
+}
+
+// CheckImpossibleTravel compares a scan against the DID's last known scan
+//
+// TRAVEL LOGIC:
+// 1. No previous scan -> record it (first sighting, no alert)
+// 2. Distance (Haversine) and elapsed time between the two scans, in either order
+// 3. Required speed = distance / elapsed (a non-zero distance in zero time is infinitely fast)
+// 4. Required speed above the max -> encrypted impossible_travel alert
+// 5. The newer of the two scans becomes the last known location
+// Hops within TravelJitterToleranceKm never alert.
+func (ws *WatchlistService) CheckImpossibleTravel(
+	ctx context.Context,
+	did string,
+	latitude float64,
+	longitude float64,
+	timestamp time.Time,
+) (*TravelCheckResult, error) {
+	current := LastSeenLocation{Latitude: latitude, Longitude: longitude, Timestamp: timestamp}
+
+	ws.travelMu.Lock()
+	previous, exists := ws.lastSeen[did]
+	maxSpeed := ws.maxTravelSpeedKmh
+
+	// 1 & 5. Record the newest scan (a late-arriving older scan is still compared)
+	if !exists || timestamp.After(previous.Timestamp) {
+		ws.lastSeen[did] = current
+	}
+	ws.travelMu.Unlock()
+
+	if !exists {
+		return &TravelCheckResult{FirstSighting: true, MaxSpeedKmh: maxSpeed}, nil
+	}
+
+	// 2. Distance and time between scans
+	distance := CalculateDistance(previous.Latitude, previous.Longitude, latitude, longitude)
+	elapsed := timestamp.Sub(previous.Timestamp)
+	if elapsed < 0 {
+		elapsed = -elapsed
+	}
+
+	result := &TravelCheckResult{
+		DistanceKm:  distance,
+		Elapsed:     elapsed,
+		MaxSpeedKmh: maxSpeed,
+	}
+
+	if distance <= TravelJitterToleranceKm {
+		return result, nil
+	}
+
+	// 3-4. Required speed
+	if elapsed > 0 {
+		result.RequiredSpeedKmh = distance / elapsed.Hours()
+	}
+	result.ImpossibleTravel = elapsed == 0 || result.RequiredSpeedKmh > maxSpeed
+
+	if !result.ImpossibleTravel {
+		return result, nil
+	}
+
+	threatLevel := "high"
+	if onWatchlist, entry := ws.IsOnWatchlist(did); onWatchlist {
+		threatLevel = entry.ThreatLevel
+	}
+
+	speed := "instantaneous"
+	if elapsed > 0 {
+		speed = fmt.Sprintf("%.0f km/h", result.RequiredSpeedKmh)
+	}
+
+	alert := SecurityAlert{
+		AlertID:     fmt.Sprintf("alert_%d", time.Now().UnixNano()),
+		AlertType:   "impossible_travel",
+		Timestamp:   time.Now(),
+		DID:         did,
+		ThreatLevel: threatLevel,
+		Latitude:    latitude,
+		Longitude:   longitude,
+		Reason: fmt.Sprintf("Impossible travel: %.0f km in %s (%s, max %.0f km/h) from %.4f,%.4f",
+			distance, elapsed, speed, maxSpeed, previous.Latitude, previous.Longitude),
+	}
+
+	// Encrypt the alert
+	encryptedAlert, err := ws.encryptAlert(&alert)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt alert: %w", err)
+	}
+
+	alert.EncryptedPayload = encryptedAlert.EncryptedPayload
+	alert.IV = encryptedAlert.IV
+
+	if err := ws.deliverAlert(ctx, alert); err != nil {
+		return nil, err
+	}
+
+	result.AlertID = alert.AlertID
+	return result, nil
+}
@@ -25,6 +25,10 @@ type SecurityZone struct {
 	// Geographic boundaries (polygon defined by vertices)
 	Boundaries  []GeoPoint
 	
+	// Circular zones (used when Boundaries is empty)
+	Center      GeoPoint
+	RadiusKm    float64
+	
 	// Security level
 	RiskLevel   string // "low", "medium", "high", "critical"
 	
@@ -48,44 +52,32 @@ type GeoPoint struct {
 
 // GeofenceService manages security zones and geographic risk assessment
 type GeofenceService struct {
-	securityZones []SecurityZone
+	zones *ZoneRegistry
 }
 
 // NewGeofenceService creates a new geofence service with predefined zones
 func NewGeofenceService() *GeofenceService {
+	zones := NewZoneRegistry()
+	for _, zone := range loadSecurityZones() {
+		if err := zones.RegisterZone(zone); err != nil {
+			fmt.Printf("Warning: skipping security zone: %v\n", err)
+		}
+	}
+	
 	return &GeofenceService{
-		securityZones: loadSecurityZones(),
+		zones: zones,
 	}
 }
 
+// GetZoneRegistry returns the registry of security zones (register additional zones here)
+func (gs *GeofenceService) GetZoneRegistry() *ZoneRegistry {
+	return gs.zones
+}
+
 // CheckSecurityZone determines if GPS coordinates fall within a security zone
 // Returns the most restrictive zone if coordinates fall in multiple zones
 func (gs *GeofenceService) CheckSecurityZone(latitude, longitude float64) (*SecurityZone, bool) {
-	point := GeoPoint{Latitude: latitude, Longitude: longitude}
-	
-	var matchedZone *SecurityZone
-	highestRiskLevel := 0
-	
-	for i := range gs.securityZones {
-		zone := &gs.securityZones[i]
-		
-		if gs.isPointInPolygon(point, zone.Boundaries) {
-			// Calculate risk level priority
-			riskPriority := getRiskPriority(zone.RiskLevel)
-			
-			// Keep the highest risk zone
-			if riskPriority > highestRiskLevel {
-				highestRiskLevel = riskPriority
-				matchedZone = zone
-			}
-		}
-	}
-	
-	if matchedZone != nil {
-		return matchedZone, true
-	}
-	
-	return nil, false
+	return gs.zones.ResolveZone(latitude, longitude)
 }
 
 // GetRequiredPFFLevel returns the PFF level required for given coordinates
@@ -112,7 +104,7 @@ func (gs *GeofenceService) IsWatchlistMonitoringRequired(latitude, longitude flo
 
 // isPointInPolygon uses ray-casting algorithm to determine if point is inside polygon
 // Reference: https://en.wikipedia.org/wiki/Point_in_polygon
-func (gs *GeofenceService) isPointInPolygon(point GeoPoint, polygon []GeoPoint) bool {
+func isPointInPolygon(point GeoPoint, polygon []GeoPoint) bool {
 	if len(polygon) < 3 {
 		return false
 	}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sync"
 	"time"
 )
 
@@ -19,12 +20,27 @@ import (
  * Implements encrypted Signal_Security_Forces event system
  */
 
+// DefaultWatchlistPruneInterval is how often expired watchlist entries are removed
+const DefaultWatchlistPruneInterval = 5 * time.Minute
+
 // WatchlistService manages DID watchlists and security alerts
 type WatchlistService struct {
 	// In production, load from secure database
 	watchlist       map[string]WatchlistEntry
 	encryptionKey   []byte // AES-256 key for encrypting alerts
 	alertChannel    chan SecurityAlert
+	overflowHandler OverflowHandler // Keeps alerts the channel has no room for
+	mu              sync.RWMutex // Guards watchlist and zones (CheckAndAlert runs concurrently with add/remove)
+	stopPruning     chan struct{}
+	stopOnce        sync.Once
+	
+	// Impossible travel detection
+	lastSeen          map[string]LastSeenLocation // Last scan location per DID
+	maxTravelSpeedKmh float64
+	travelMu          sync.Mutex
+	
+	// Zones CheckLocation resolves coordinates against (see zone_registry.go)
+	zones           *ZoneRegistry
 }
 
 // WatchlistEntry represents a flagged DID
@@ -64,21 +80,41 @@ type SecurityAlert struct {
 }
 
 // NewWatchlistService creates a new watchlist service
-func NewWatchlistService(encryptionKey []byte) *WatchlistService {
+// bufferSize is the alert channel capacity (<= 0 uses DefaultAlertBufferSize).
+// Alerts that don't fit go to overflowHandler (nil uses a MemoryOverflowQueue).
+func NewWatchlistService(encryptionKey []byte, bufferSize int, overflowHandler OverflowHandler) *WatchlistService {
 	if len(encryptionKey) != 32 {
 		panic("encryption key must be 32 bytes for AES-256")
 	}
 	
-	return &WatchlistService{
-		watchlist:     loadWatchlist(),
-		encryptionKey: encryptionKey,
-		alertChannel:  make(chan SecurityAlert, 100),
+	if bufferSize <= 0 {
+		bufferSize = DefaultAlertBufferSize
+	}
+	if overflowHandler == nil {
+		overflowHandler = NewMemoryOverflowQueue()
 	}
+	
+	ws := &WatchlistService{
+		watchlist:       loadWatchlist(),
+		encryptionKey:   encryptionKey,
+		alertChannel:    make(chan SecurityAlert, bufferSize),
+		overflowHandler: overflowHandler,
+		stopPruning:     make(chan struct{}),
+		lastSeen:          make(map[string]LastSeenLocation),
+		maxTravelSpeedKmh: DefaultMaxTravelSpeedKmh,
+	}
+	
+	// Start background sweeper for expired entries
+	go ws.pruneExpiredLoop(DefaultWatchlistPruneInterval)
+	
+	return ws
 }
 
 // IsOnWatchlist checks if a DID is on the watchlist
 func (ws *WatchlistService) IsOnWatchlist(did string) (bool, *WatchlistEntry) {
+	ws.mu.RLock()
 	entry, exists := ws.watchlist[did]
+	ws.mu.RUnlock()
 	
 	if !exists {
 		return false, nil
@@ -141,31 +177,23 @@ func (ws *WatchlistService) CheckAndAlert(
 	alert.EncryptedPayload = encryptedAlert.EncryptedPayload
 	alert.IV = encryptedAlert.IV
 	
-	// Send to alert channel (async)
-	select {
-	case ws.alertChannel <- alert:
-		// Alert sent successfully
-	default:
-		// Channel full - log error but don't block
-		return fmt.Errorf("alert channel full - alert may be lost")
-	}
-	
-	return nil
+	// Send to alert channel (async); a full channel hands the alert to the overflow handler
+	return ws.deliverAlert(ctx, alert)
 }
 
 // encryptAlert encrypts sensitive alert data using AES-256-GCM
 func (ws *WatchlistService) encryptAlert(alert *SecurityAlert) (*SecurityAlert, error) {
 	// Create payload with sensitive data
-	payload := map[string]interface{}{
-		"did":             alert.DID,
-		"threat_level":    alert.ThreatLevel,
-		"latitude":        alert.Latitude,
-		"longitude":       alert.Longitude,
-		"location_name":   alert.LocationName,
-		"security_zone":   alert.SecurityZone,
-		"reason":          alert.Reason,
-		"verification_id": alert.VerificationID,
-		"timestamp":       alert.Timestamp.Format(time.RFC3339),
+	payload := AlertPayload{
+		DID:            alert.DID,
+		ThreatLevel:    alert.ThreatLevel,
+		Latitude:       alert.Latitude,
+		Longitude:      alert.Longitude,
+		LocationName:   alert.LocationName,
+		SecurityZone:   alert.SecurityZone,
+		Reason:         alert.Reason,
+		VerificationID: alert.VerificationID,
+		Timestamp:      alert.Timestamp.Format(time.RFC3339),
 	}
 	
 	payloadJSON, err := json.Marshal(payload)
@@ -202,20 +230,75 @@ func (ws *WatchlistService) encryptAlert(alert *SecurityAlert) (*SecurityAlert,
 
 // GetAlertChannel returns the channel for receiving security alerts
 // Security forces can subscribe to this channel for real-time alerts
+// Alerts that arrive while it is full are kept by the overflow handler.
 func (ws *WatchlistService) GetAlertChannel() <-chan SecurityAlert {
 	return ws.alertChannel
 }
 
+// GetOverflowHandler returns the handler keeping alerts the channel had no room for
+// With the default MemoryOverflowQueue, subscribers Drain it to catch up after a backlog.
+func (ws *WatchlistService) GetOverflowHandler() OverflowHandler {
+	return ws.overflowHandler
+}
+
 // AddToWatchlist adds a DID to the watchlist
+// Entries with ExpiresAt stop matching once it passes and are removed by PruneExpired.
 func (ws *WatchlistService) AddToWatchlist(entry WatchlistEntry) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
 	ws.watchlist[entry.DID] = entry
 }
 
 // RemoveFromWatchlist removes a DID from the watchlist
 func (ws *WatchlistService) RemoveFromWatchlist(did string) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
 	delete(ws.watchlist, did)
 }
 
+// PruneExpired removes entries whose ExpiresAt has passed and returns how many were removed
+func (ws *WatchlistService) PruneExpired() int {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	now := time.Now()
+	removed := 0
+	for did, entry := range ws.watchlist {
+		if entry.ExpiresAt != nil && now.After(*entry.ExpiresAt) {
+			delete(ws.watchlist, did)
+			removed++
+		}
+	}
+
+	return removed
+}
+
+// Stop stops the background sweeper started by NewWatchlistService
+func (ws *WatchlistService) Stop() {
+	ws.stopOnce.Do(func() {
+		close(ws.stopPruning)
+	})
+}
+
+// pruneExpiredLoop calls PruneExpired every interval until Stop is called
+func (ws *WatchlistService) pruneExpiredLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if removed := ws.PruneExpired(); removed > 0 {
+				fmt.Printf("✅ Pruned %d expired watchlist entries\n", removed)
+			}
+		case <-ws.stopPruning:
+			return
+		}
+	}
+}
+
 // loadWatchlist loads the watchlist from database
 // In production, this would query a secure database
 func loadWatchlist() map[string]WatchlistEntry {
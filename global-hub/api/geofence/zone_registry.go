@@ -0,0 +1,149 @@
+package geofence
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+/**
+ * SOVRA_Sovereign_Kernel - Security Zone Registry
+ *
+ * Core ledger security function for registering security zones and resolving GPS coordinates to them
+ * Zones are polygons (Boundaries) or circles (Center + RadiusKm)
+ */
+
+// ZoneRegistry holds the security zones coordinates are resolved against
+type ZoneRegistry struct {
+	zones map[string]*SecurityZone
+	order []string // Registration order (first registered wins ties between equally risky zones)
+	mu    sync.RWMutex
+}
+
+// NewZoneRegistry creates an empty zone registry
+func NewZoneRegistry() *ZoneRegistry {
+	return &ZoneRegistry{
+		zones: make(map[string]*SecurityZone),
+	}
+}
+
+// RegisterZone adds a zone, or replaces the zone with the same ID
+// A zone needs a polygon of at least 3 vertices or a positive radius around Center.
+func (zr *ZoneRegistry) RegisterZone(zone SecurityZone) error {
+	if zone.ID == "" {
+		return fmt.Errorf("zone ID required")
+	}
+
+	if len(zone.Boundaries) < 3 && zone.RadiusKm <= 0 {
+		return fmt.Errorf("zone %s needs at least 3 boundary points or a positive radius", zone.ID)
+	}
+
+	if getRiskPriority(zone.RiskLevel) == 0 {
+		return fmt.Errorf("zone %s has unknown risk level: %s", zone.ID, zone.RiskLevel)
+	}
+
+	zr.mu.Lock()
+	defer zr.mu.Unlock()
+
+	if _, exists := zr.zones[zone.ID]; !exists {
+		zr.order = append(zr.order, zone.ID)
+	}
+	zr.zones[zone.ID] = &zone
+
+	return nil
+}
+
+// GetZone returns a zone by ID
+func (zr *ZoneRegistry) GetZone(zoneID string) (*SecurityZone, bool) {
+	zr.mu.RLock()
+	defer zr.mu.RUnlock()
+
+	zone, exists := zr.zones[zoneID]
+	return zone, exists
+}
+
+// ListZones returns every zone in registration order
+func (zr *ZoneRegistry) ListZones() []*SecurityZone {
+	zr.mu.RLock()
+	defer zr.mu.RUnlock()
+
+	zones := make([]*SecurityZone, 0, len(zr.order))
+	for _, zoneID := range zr.order {
+		zones = append(zones, zr.zones[zoneID])
+	}
+	return zones
+}
+
+// ResolveZone returns the zone containing the coordinates
+// Returns the most restrictive zone if coordinates fall in multiple zones
+func (zr *ZoneRegistry) ResolveZone(latitude, longitude float64) (*SecurityZone, bool) {
+	point := GeoPoint{Latitude: latitude, Longitude: longitude}
+
+	zr.mu.RLock()
+	defer zr.mu.RUnlock()
+
+	var matchedZone *SecurityZone
+	highestRiskLevel := 0
+
+	for _, zoneID := range zr.order {
+		zone := zr.zones[zoneID]
+		if !zone.Contains(point) {
+			continue
+		}
+
+		// Keep the highest risk zone
+		if riskPriority := getRiskPriority(zone.RiskLevel); riskPriority > highestRiskLevel {
+			highestRiskLevel = riskPriority
+			matchedZone = zone
+		}
+	}
+
+	return matchedZone, matchedZone != nil
+}
+
+// Contains reports whether a point lies inside the zone
+// Polygon zones use ray casting; circle zones (no Boundaries) use Haversine distance from Center.
+func (zone *SecurityZone) Contains(point GeoPoint) bool {
+	if len(zone.Boundaries) >= 3 {
+		return isPointInPolygon(point, zone.Boundaries)
+	}
+
+	if zone.RadiusKm > 0 {
+		return CalculateDistance(zone.Center.Latitude, zone.Center.Longitude, point.Latitude, point.Longitude) <= zone.RadiusKm
+	}
+
+	return false
+}
+
+// SetZoneRegistry sets the registry CheckLocation resolves zones from
+func (ws *WatchlistService) SetZoneRegistry(zones *ZoneRegistry) {
+	ws.mu.Lock()
+	defer ws.mu.Unlock()
+
+	ws.zones = zones
+}
+
+// CheckLocation resolves the zone containing the coordinates and delegates to CheckAndAlert
+// Coordinates outside every registered zone raise no alert.
+func (ws *WatchlistService) CheckLocation(
+	ctx context.Context,
+	did string,
+	latitude float64,
+	longitude float64,
+	verificationID string,
+) error {
+	ws.mu.RLock()
+	zones := ws.zones
+	ws.mu.RUnlock()
+
+	if zones == nil {
+		return fmt.Errorf("no zone registry configured")
+	}
+
+	zone, inZone := zones.ResolveZone(latitude, longitude)
+	if !inZone {
+		return nil
+	}
+
+	return ws.CheckAndAlert(ctx, did, latitude, longitude, zone.Name, zone, verificationID)
+}
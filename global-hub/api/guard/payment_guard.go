@@ -0,0 +1,160 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Payment Kill-Switch
+//
+// Central guard checked by every autonomous payment path before debiting.
+// During a security incident an operator halts the guard and all seamless
+// biometric payments, boarding proxy debits and settlements are refused
+// until an authority resumes execution. The guard also enforces a global
+// maximum fee ceiling as a last line of defence against runaway charges.
+
+package guard
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrPaymentsHalted is returned by payment paths while the kill-switch is active
+var ErrPaymentsHalted = errors.New("payments halted")
+
+// ErrFeeAboveCeiling is returned when a charge exceeds the configured maximum fee
+var ErrFeeAboveCeiling = errors.New("fee exceeds maximum ceiling")
+
+// GuardStatus represents the current state of the payment kill-switch
+type GuardStatus struct {
+	Halted       bool      `json:"halted"`
+	Reason       string    `json:"reason,omitempty"` // Why payments were halted
+	HaltedAt     time.Time `json:"halted_at,omitempty"`
+	ResumedBy    string    `json:"resumed_by,omitempty"` // Authority that last resumed payments
+	ResumedAt    time.Time `json:"resumed_at,omitempty"`
+	RefusedCount int64     `json:"refused_count"` // Payments refused during the current halt
+	MaxFeeUSOV   int64     `json:"max_fee_usov"`  // Fee ceiling (0 = no ceiling)
+}
+
+// PaymentGuard is a kill-switch for all autonomous payment execution
+type PaymentGuard struct {
+	status GuardStatus
+	mu     sync.RWMutex
+}
+
+// SetMaxFee sets the global fee ceiling in uSOV (0 disables the ceiling)
+// Independent of per-type pricing: any single charge above it is refused.
+func (pg *PaymentGuard) SetMaxFee(maxFeeUSOV int64) error {
+	if maxFeeUSOV < 0 {
+		return fmt.Errorf("max fee cannot be negative")
+	}
+
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+
+	pg.status.MaxFeeUSOV = maxFeeUSOV
+	return nil
+}
+
+// CheckFee returns ErrFeeAboveCeiling (wrapped with the amounts) if the fee exceeds the ceiling
+func (pg *PaymentGuard) CheckFee(feeUSOV int64) error {
+	pg.mu.RLock()
+	maxFee := pg.status.MaxFeeUSOV
+	pg.mu.RUnlock()
+
+	if maxFee > 0 && feeUSOV > maxFee {
+		return fmt.Errorf("%w: %d uSOV requested, ceiling is %d uSOV", ErrFeeAboveCeiling, feeUSOV, maxFee)
+	}
+
+	return nil
+}
+
+// Authorize runs the kill-switch check and the fee ceiling check for a single charge
+func (pg *PaymentGuard) Authorize(feeUSOV int64) error {
+	if err := pg.Check(); err != nil {
+		return err
+	}
+	return pg.CheckFee(feeUSOV)
+}
+
+// NewPaymentGuard creates a new payment guard (payments allowed)
+func NewPaymentGuard() *PaymentGuard {
+	return &PaymentGuard{}
+}
+
+// Halt stops all autonomous payment execution until Resume is called
+func (pg *PaymentGuard) Halt(reason string) error {
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+
+	if reason == "" {
+		return fmt.Errorf("halt reason required")
+	}
+
+	if pg.status.Halted {
+		return fmt.Errorf("payments already halted: %s", pg.status.Reason)
+	}
+
+	pg.status.Halted = true
+	pg.status.Reason = reason
+	pg.status.HaltedAt = time.Now()
+	pg.status.RefusedCount = 0
+
+	fmt.Printf("🛑 PAYMENTS HALTED: %s\n", reason)
+
+	return nil
+}
+
+// Resume re-enables autonomous payment execution
+func (pg *PaymentGuard) Resume(authority string) error {
+	pg.mu.Lock()
+	defer pg.mu.Unlock()
+
+	if authority == "" {
+		return fmt.Errorf("resuming authority required")
+	}
+
+	if !pg.status.Halted {
+		return fmt.Errorf("payments are not halted")
+	}
+
+	pg.status.Halted = false
+	pg.status.ResumedBy = authority
+	pg.status.ResumedAt = time.Now()
+
+	fmt.Printf("✅ Payments resumed by %s (%d refused during halt)\n", authority, pg.status.RefusedCount)
+
+	return nil
+}
+
+// Check returns ErrPaymentsHalted (wrapped with the halt reason) while halted
+// Payment paths must call Check before debiting any vault or wallet
+func (pg *PaymentGuard) Check() error {
+	pg.mu.RLock()
+	halted := pg.status.Halted
+	reason := pg.status.Reason
+	pg.mu.RUnlock()
+
+	if !halted {
+		return nil
+	}
+
+	pg.mu.Lock()
+	pg.status.RefusedCount++
+	pg.mu.Unlock()
+
+	return fmt.Errorf("%w: %s", ErrPaymentsHalted, reason)
+}
+
+// IsHalted returns true if payment execution is currently halted
+func (pg *PaymentGuard) IsHalted() bool {
+	pg.mu.RLock()
+	defer pg.mu.RUnlock()
+
+	return pg.status.Halted
+}
+
+// GetStatus returns a snapshot of the kill-switch state
+func (pg *PaymentGuard) GetStatus() GuardStatus {
+	pg.mu.RLock()
+	defer pg.mu.RUnlock()
+
+	return pg.status
+}
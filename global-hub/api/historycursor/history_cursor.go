@@ -0,0 +1,64 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Transaction History Cursors
+//
+// Shared by the wallet and billing transaction histories. History pages are
+// ordered most recent first. A cursor names the last transaction of a page
+// (timestamp and ID), so the next page resumes right after it even when
+// transactions share a timestamp.
+
+package historycursor
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cursor is the last transaction a history page returned
+type Cursor struct {
+	Timestamp     time.Time
+	TransactionID string
+}
+
+// Precedes reports whether the cursor sorts before a transaction in most-recent-first order
+func (c *Cursor) Precedes(timestamp time.Time, transactionID string) bool {
+	if timestamp.Equal(c.Timestamp) {
+		return transactionID < c.TransactionID
+	}
+	return timestamp.Before(c.Timestamp)
+}
+
+// Encode builds an opaque cursor from the last transaction of a page
+func Encode(timestamp time.Time, transactionID string) string {
+	raw := strconv.FormatInt(timestamp.UnixNano(), 10) + ":" + transactionID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decode parses a cursor from Encode (nil for the first page)
+func Decode(cursor string) (*Cursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid history cursor: %w", err)
+	}
+
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid history cursor")
+	}
+
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid history cursor: %w", err)
+	}
+
+	return &Cursor{
+		Timestamp:     time.Unix(0, nanos),
+		TransactionID: parts[1],
+	}, nil
+}
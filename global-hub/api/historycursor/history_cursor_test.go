@@ -0,0 +1,53 @@
+package historycursor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	timestamp := time.Date(2026, 3, 1, 9, 30, 0, 123, time.UTC)
+
+	cursor, err := Decode(Encode(timestamp, "tx:with:colons"))
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if !cursor.Timestamp.Equal(timestamp) || cursor.TransactionID != "tx:with:colons" {
+		t.Errorf("Decode = %v %q, want %v %q", cursor.Timestamp, cursor.TransactionID, timestamp, "tx:with:colons")
+	}
+
+	if cursor, err := Decode(""); cursor != nil || err != nil {
+		t.Errorf("Decode(\"\") = %v, %v; want nil, nil", cursor, err)
+	}
+
+	for _, invalid := range []string{"not base64!", Encode(timestamp, "")[:4], "MTIz"} {
+		if _, err := Decode(invalid); err == nil {
+			t.Errorf("Decode(%q) succeeded", invalid)
+		}
+	}
+}
+
+func TestCursorPrecedes(t *testing.T) {
+	at := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	cursor := &Cursor{Timestamp: at, TransactionID: "tx-m"}
+
+	tests := []struct {
+		name          string
+		timestamp     time.Time
+		transactionID string
+		want          bool
+	}{
+		{"older transaction", at.Add(-time.Second), "tx-z", true},
+		{"newer transaction", at.Add(time.Second), "tx-a", false},
+		{"same timestamp, lower ID", at, "tx-a", true},
+		{"same timestamp, higher ID", at, "tx-z", false},
+		{"cursor transaction itself", at, "tx-m", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cursor.Precedes(tt.timestamp, tt.transactionID); got != tt.want {
+				t.Errorf("Precedes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
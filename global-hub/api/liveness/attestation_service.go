@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -12,11 +13,21 @@ import (
 type AttestationService struct {
 	// In production, this would connect to Cosmos SDK blockchain
 	blockchainClient interface{}
+
+	// Anchored attestations by hash
+	attestations map[string]*LivenessAttestation
+
+	// Optional signing keys (nil = attestations are anchored unsigned)
+	keyRing *AttestationKeyRing
+
+	mu sync.RWMutex
 }
 
 // NewAttestationService creates a new attestation service
 func NewAttestationService() *AttestationService {
-	return &AttestationService{}
+	return &AttestationService{
+		attestations: make(map[string]*LivenessAttestation),
+	}
 }
 
 // LivenessAttestation represents a stored attestation
@@ -33,6 +44,11 @@ type LivenessAttestation struct {
 	TransactionHash   string
 	BlockHeight       int64
 	AnchoredAt        int64
+
+	// Hub signature (current signing key, or counter-signature after re-anchoring)
+	SigningKeyID string
+	Signature    []byte
+	Reanchors    []AttestationReanchor // Key rotation continuity, oldest first
 }
 
 // AnchorAttestation anchors a liveness attestation to the blockchain
@@ -66,7 +82,15 @@ func (s *AttestationService) AnchorAttestation(
 	attestation.TransactionHash = txHash
 	attestation.BlockHeight = blockHeight
 
-	// 3. Store in database
+	// 3. Sign with the hub's current key
+	s.mu.RLock()
+	keyRing := s.keyRing
+	s.mu.RUnlock()
+	if keyRing != nil {
+		attestation.SigningKeyID, attestation.Signature = keyRing.sign(attestationMessage(attestation))
+	}
+
+	// 4. Store in database
 	if err := s.storeAttestation(ctx, attestation); err != nil {
 		return nil, fmt.Errorf("failed to store attestation: %w", err)
 	}
@@ -99,8 +123,22 @@ func (s *AttestationService) VerifyAttestation(
 		return nil, fmt.Errorf("failed to verify blockchain anchor: %w", err)
 	}
 
+	// 3. Verify the hub signature under the current key ring
+	signatureError := ""
+	s.mu.RLock()
+	if s.keyRing != nil {
+		if err := s.verifySignature(attestation); err != nil {
+			verified = false
+			signatureError = err.Error()
+		}
+	}
+	s.mu.RUnlock()
+
 	return map[string]interface{}{
 		"verified":           verified,
+		"signing_key_id":     attestation.SigningKeyID,
+		"signature_error":    signatureError,
+		"reanchored":         len(attestation.Reanchors) > 0,
 		"attestation_hash":   attestation.AttestationHash,
 		"liveness_confirmed": attestation.LivenessConfirmed,
 		"overall_confidence": attestation.OverallConfidence,
@@ -163,8 +201,12 @@ func (s *AttestationService) storeAttestation(
 	attestation *LivenessAttestation,
 ) error {
 
-	// MOCK: In production, insert into PostgreSQL
-	// INSERT INTO liveness_attestations (...)
+	// In-memory for development
+	// In production, insert into PostgreSQL: INSERT INTO liveness_attestations (...)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attestations[attestation.AttestationHash] = attestation
 
 	return nil
 }
@@ -175,19 +217,17 @@ func (s *AttestationService) queryAttestation(
 	attestationHash string,
 ) (*LivenessAttestation, error) {
 
-	// MOCK: In production, query from PostgreSQL
-	// SELECT * FROM liveness_attestations WHERE attestation_hash = ?
+	// In-memory for development
+	// In production, query from PostgreSQL: SELECT * FROM liveness_attestations WHERE attestation_hash = ?
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-	return &LivenessAttestation{
-		AttestationHash:   attestationHash,
-		LivenessConfirmed: true,
-		OverallConfidence: 0.98,
-		TransactionHash:   "mock_tx_hash",
-		BlockHeight:       1000000,
-		AnchoredAt:        time.Now().UnixMilli(),
-		DeviceID:          "hashed_device_id",
-		NPUModel:          "Apple A17 Pro Neural Engine",
-	}, nil
+	attestation, exists := s.attestations[attestationHash]
+	if !exists {
+		return nil, fmt.Errorf("no attestation with hash %s", attestationHash)
+	}
+
+	return attestation, nil
 }
 
 // verifyBlockchainAnchor verifies transaction exists on blockchain
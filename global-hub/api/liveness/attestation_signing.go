@@ -0,0 +1,228 @@
+package liveness
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// AttestationKeyRing holds the hub's attestation signing keys
+// Only the current key is trusted when verifying attestations. Keys retired by
+// Rotate are kept so ReanchorAttestation can re-verify what they signed.
+type AttestationKeyRing struct {
+	currentKeyID string
+	currentKey   ed25519.PrivateKey
+	publicKeys   map[string]ed25519.PublicKey // Key ID -> public key (current and retired)
+	mu           sync.RWMutex
+}
+
+// AttestationReanchor links an attestation's previous signature to its counter-signature
+type AttestationReanchor struct {
+	PreviousKeyID     string
+	PreviousSignature []byte
+	PreviousTxHash    string
+	KeyID             string
+	CounterSignature  []byte
+	TransactionHash   string
+	BlockHeight       int64
+	ReanchoredAt      int64
+}
+
+// NewAttestationKeyRing creates a key ring with a freshly generated current key
+func NewAttestationKeyRing() (*AttestationKeyRing, error) {
+	ring := &AttestationKeyRing{
+		publicKeys: make(map[string]ed25519.PublicKey),
+	}
+
+	if _, err := ring.Rotate(); err != nil {
+		return nil, err
+	}
+
+	return ring, nil
+}
+
+// Rotate generates a new current signing key and retires the previous one
+// Returns the new key ID.
+func (kr *AttestationKeyRing) Rotate() (string, error) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate attestation signing key: %w", err)
+	}
+
+	fingerprint := sha256.Sum256(publicKey)
+	keyID := hex.EncodeToString(fingerprint[:8])
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	kr.publicKeys[keyID] = publicKey
+	kr.currentKeyID = keyID
+	kr.currentKey = privateKey
+
+	return keyID, nil
+}
+
+// CurrentKeyID returns the ID of the key new signatures are made with
+func (kr *AttestationKeyRing) CurrentKeyID() string {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	return kr.currentKeyID
+}
+
+// sign signs message with the current key
+func (kr *AttestationKeyRing) sign(message []byte) (string, []byte) {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	return kr.currentKeyID, ed25519.Sign(kr.currentKey, message)
+}
+
+// verifyCurrent checks a signature made with the current key
+func (kr *AttestationKeyRing) verifyCurrent(keyID string, message []byte, sig []byte) error {
+	kr.mu.RLock()
+	current := kr.currentKeyID
+	kr.mu.RUnlock()
+
+	if keyID != current {
+		return fmt.Errorf("signed under retired key %s (current key %s): re-anchor required", keyID, current)
+	}
+
+	return kr.verifyAny(keyID, message, sig)
+}
+
+// verifyAny checks a signature made with the current or a retired key
+func (kr *AttestationKeyRing) verifyAny(keyID string, message []byte, sig []byte) error {
+	kr.mu.RLock()
+	publicKey, exists := kr.publicKeys[keyID]
+	kr.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("unknown attestation signing key: %s", keyID)
+	}
+
+	if !ed25519.Verify(publicKey, message, sig) {
+		return fmt.Errorf("invalid attestation signature under key %s", keyID)
+	}
+
+	return nil
+}
+
+// attestationMessage returns the bytes the hub signs when anchoring an attestation
+func attestationMessage(attestation *LivenessAttestation) []byte {
+	return []byte(fmt.Sprintf("%s:%t:%.4f:%s:%s:%s:%s:%d:%d",
+		attestation.AttestationHash,
+		attestation.LivenessConfirmed,
+		attestation.OverallConfidence,
+		attestation.TextureHash,
+		attestation.PulseHash,
+		attestation.DeviceID,
+		attestation.NPUModel,
+		attestation.CaptureTimestamp,
+		attestation.AnalysisTimestamp,
+	))
+}
+
+// signedMessage returns the bytes an attestation's current signature covers
+// A re-anchored attestation's counter-signature also covers the signature it replaced.
+func signedMessage(attestation *LivenessAttestation) []byte {
+	message := attestationMessage(attestation)
+	if len(attestation.Reanchors) == 0 {
+		return message
+	}
+
+	last := attestation.Reanchors[len(attestation.Reanchors)-1]
+	return reanchorMessage(message, last.PreviousKeyID, last.PreviousSignature)
+}
+
+// reanchorMessage binds an attestation to the signature being counter-signed
+func reanchorMessage(message []byte, previousKeyID string, previousSignature []byte) []byte {
+	return []byte(fmt.Sprintf("%s|reanchor:%s:%s", message, previousKeyID, hex.EncodeToString(previousSignature)))
+}
+
+// SetKeyRing enables attestation signing (attestations anchored without a key ring are unsigned)
+func (s *AttestationService) SetKeyRing(keyRing *AttestationKeyRing) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keyRing = keyRing
+}
+
+// verifySignature checks an attestation's signature against the current key
+func (s *AttestationService) verifySignature(attestation *LivenessAttestation) error {
+	if len(attestation.Signature) == 0 {
+		return fmt.Errorf("attestation is unsigned")
+	}
+
+	return s.keyRing.verifyCurrent(attestation.SigningKeyID, signedMessage(attestation), attestation.Signature)
+}
+
+// ReanchorAttestation counter-signs an attestation signed under a retired key
+//
+// RE-ANCHOR LOGIC:
+// 1. Attestation must be signed, and not already under the current key
+// 2. Its existing signature is re-verified under the retired key
+// 3. The current key counter-signs the attestation together with that signature
+// 4. The counter-signature is anchored and the linkage recorded on the attestation
+// Afterwards the attestation verifies under the current key ring.
+func (s *AttestationService) ReanchorAttestation(ctx context.Context, attestationHash string) (*AttestationReanchor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.keyRing == nil {
+		return nil, fmt.Errorf("attestation key ring not configured")
+	}
+
+	attestation, exists := s.attestations[attestationHash]
+	if !exists {
+		return nil, fmt.Errorf("attestation not found: %s", attestationHash)
+	}
+
+	// 1. Only signed attestations under a retired key need re-anchoring
+	if len(attestation.Signature) == 0 {
+		return nil, fmt.Errorf("attestation %s is unsigned: nothing to re-anchor", attestationHash)
+	}
+	if attestation.SigningKeyID == s.keyRing.CurrentKeyID() {
+		return nil, fmt.Errorf("attestation %s already signed under current key %s", attestationHash, attestation.SigningKeyID)
+	}
+
+	// 2. Re-verify the original under its own key
+	if err := s.keyRing.verifyAny(attestation.SigningKeyID, signedMessage(attestation), attestation.Signature); err != nil {
+		return nil, fmt.Errorf("original attestation failed verification: %w", err)
+	}
+
+	// 3. Counter-sign with the current key
+	keyID, counterSignature := s.keyRing.sign(reanchorMessage(attestationMessage(attestation), attestation.SigningKeyID, attestation.Signature))
+
+	// 4. Anchor and record the linkage
+	txHash, blockHeight, err := s.anchorToBlockchain(ctx, attestation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to anchor re-signed attestation: %w", err)
+	}
+
+	reanchor := AttestationReanchor{
+		PreviousKeyID:     attestation.SigningKeyID,
+		PreviousSignature: attestation.Signature,
+		PreviousTxHash:    attestation.TransactionHash,
+		KeyID:             keyID,
+		CounterSignature:  counterSignature,
+		TransactionHash:   txHash,
+		BlockHeight:       blockHeight,
+		ReanchoredAt:      time.Now().UnixMilli(),
+	}
+
+	attestation.Reanchors = append(attestation.Reanchors, reanchor)
+	attestation.SigningKeyID = keyID
+	attestation.Signature = counterSignature
+	attestation.TransactionHash = txHash
+	attestation.BlockHeight = blockHeight
+
+	fmt.Printf("✅ Attestation %s re-anchored: key %s -> %s (tx %s)\n", attestationHash, reanchor.PreviousKeyID, keyID, txHash)
+
+	return &reanchor, nil
+}
@@ -1,7 +1,6 @@
 package liveness
 
 import (
-	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -27,6 +26,7 @@ func (h *LivenessHandlers) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/v1/liveness/attest", h.HandleAttestation)
 	mux.HandleFunc("/v1/liveness/verify", h.HandleVerifyAttestation)
 	mux.HandleFunc("/v1/liveness/query", h.HandleQueryAttestation)
+	mux.HandleFunc("/v1/liveness/reanchor", h.HandleReanchorAttestation)
 }
 
 // AttestationRequest represents a liveness attestation request
@@ -71,7 +71,7 @@ func (h *LivenessHandlers) HandleAttestation(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 
 	// Anchor attestation to blockchain
 	result, err := h.attestationService.AnchorAttestation(ctx, &req)
@@ -101,7 +101,7 @@ func (h *LivenessHandlers) HandleVerifyAttestation(w http.ResponseWriter, r *htt
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 
 	// Verify attestation
 	result, err := h.attestationService.VerifyAttestation(ctx, req.AttestationHash)
@@ -128,7 +128,7 @@ func (h *LivenessHandlers) HandleQueryAttestation(w http.ResponseWriter, r *http
 		return
 	}
 
-	ctx := context.Background()
+	ctx := r.Context()
 
 	// Query attestations
 	attestations, err := h.attestationService.QueryAttestations(ctx, deviceID)
@@ -145,6 +145,43 @@ func (h *LivenessHandlers) HandleQueryAttestation(w http.ResponseWriter, r *http
 	})
 }
 
+// HandleReanchorAttestation handles POST /v1/liveness/reanchor
+// Operator tool: counter-signs an attestation signed under a retired key
+func (h *LivenessHandlers) HandleReanchorAttestation(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		AttestationHash string `json:"attestation_hash"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+
+	// Re-anchor attestation under the current key
+	reanchor, err := h.attestationService.ReanchorAttestation(ctx, req.AttestationHash)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Failed to re-anchor attestation: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"attestation_hash": req.AttestationHash,
+		"previous_key_id":  reanchor.PreviousKeyID,
+		"key_id":           reanchor.KeyID,
+		"transaction_hash": reanchor.TransactionHash,
+		"block_height":     reanchor.BlockHeight,
+		"reanchored_at":    reanchor.ReanchoredAt,
+	})
+}
+
 // validateAttestation validates the attestation request
 func (h *LivenessHandlers) validateAttestation(req *AttestationRequest) error {
 	// Validate attestation hash format
@@ -35,6 +35,10 @@ CREATE TABLE liveness_attestations (
   block_height BIGINT NOT NULL,
   blockchain_verified BOOLEAN DEFAULT false,
   
+  -- Hub signature (current key, or counter-signature after re-anchoring)
+  signing_key_id TEXT,
+  signature BYTEA,
+  
   -- Metadata
   created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
   
@@ -47,6 +51,27 @@ CREATE TABLE liveness_attestations (
   INDEX idx_liveness_confirmed (liveness_confirmed)
 );
 
+-- Attestation Re-anchors (key rotation continuity)
+CREATE TABLE attestation_reanchors (
+  reanchor_id SERIAL PRIMARY KEY,
+  attestation_hash TEXT NOT NULL REFERENCES liveness_attestations(attestation_hash),
+  
+  -- Signature being replaced (verified under the retired key)
+  previous_key_id TEXT NOT NULL,
+  previous_signature BYTEA NOT NULL,
+  previous_transaction_hash TEXT NOT NULL,
+  
+  -- Counter-signature by the current key over the attestation and previous signature
+  key_id TEXT NOT NULL,
+  counter_signature BYTEA NOT NULL,
+  transaction_hash TEXT NOT NULL,
+  block_height BIGINT NOT NULL,
+  reanchored_at BIGINT NOT NULL,
+  
+  -- Indexes
+  INDEX idx_reanchor_attestation (attestation_hash, reanchored_at)
+);
+
 -- Device Attestation History
 CREATE TABLE device_attestation_history (
   history_id SERIAL PRIMARY KEY,
@@ -0,0 +1,344 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Metrics Registry
+//
+// Consolidated metrics surface for all hub services. Services publish
+// counters, gauges and histograms to a shared registry, which is exposed
+// at /metrics in the Prometheus text exposition format.
+
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MetricType represents the Prometheus type of a metric family
+type MetricType string
+
+const (
+	MetricTypeCounter   MetricType = "counter"
+	MetricTypeGauge     MetricType = "gauge"
+	MetricTypeHistogram MetricType = "histogram"
+)
+
+// DefaultLatencyBuckets are histogram buckets (seconds) suited to verification latency
+var DefaultLatencyBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+
+// Counter is a monotonically increasing value
+type Counter struct {
+	value float64
+	mu    sync.Mutex
+}
+
+// Inc increments the counter by 1
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Add increments the counter by delta (negative deltas are ignored)
+func (c *Counter) Add(delta float64) {
+	if delta < 0 {
+		return
+	}
+	c.mu.Lock()
+	c.value += delta
+	c.mu.Unlock()
+}
+
+// Value returns the current counter value
+func (c *Counter) Value() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.value
+}
+
+// Gauge is a value that can go up and down
+type Gauge struct {
+	value float64
+	fn    func() float64 // If set, the value is read from fn at scrape time
+	mu    sync.Mutex
+}
+
+// Set sets the gauge value
+func (g *Gauge) Set(value float64) {
+	g.mu.Lock()
+	g.value = value
+	g.mu.Unlock()
+}
+
+// Add adds delta to the gauge value
+func (g *Gauge) Add(delta float64) {
+	g.mu.Lock()
+	g.value += delta
+	g.mu.Unlock()
+}
+
+// Value returns the current gauge value
+func (g *Gauge) Value() float64 {
+	g.mu.Lock()
+	fn := g.fn
+	value := g.value
+	g.mu.Unlock()
+
+	if fn != nil {
+		return fn()
+	}
+	return value
+}
+
+// Histogram samples observations into cumulative buckets
+type Histogram struct {
+	buckets []float64 // Upper bounds, sorted ascending
+	counts  []uint64  // Observations per bucket (non-cumulative)
+	sum     float64
+	count   uint64
+	mu      sync.Mutex
+}
+
+// Observe records a single observation
+func (h *Histogram) Observe(value float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	idx := sort.SearchFloat64s(h.buckets, value)
+	if idx < len(h.counts) {
+		h.counts[idx]++
+	}
+	h.sum += value
+	h.count++
+}
+
+// Count returns the number of observations
+func (h *Histogram) Count() uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.count
+}
+
+// family groups all series sharing a metric name
+type family struct {
+	name   string
+	help   string
+	kind   MetricType
+	series map[string]interface{} // key: rendered label set
+}
+
+// Registry holds every metric family published by the hub services
+type Registry struct {
+	families map[string]*family
+	mu       sync.RWMutex
+}
+
+// NewRegistry creates a new, empty metrics registry
+func NewRegistry() *Registry {
+	return &Registry{
+		families: make(map[string]*family),
+	}
+}
+
+// Counter returns the counter for name and label pairs, creating it if needed
+// Labels are given as alternating key/value strings (e.g., "outcome", "settled")
+func (r *Registry) Counter(name, help string, labels ...string) *Counter {
+	return r.getOrCreate(name, help, MetricTypeCounter, labels, func() interface{} {
+		return &Counter{}
+	}).(*Counter)
+}
+
+// Gauge returns the gauge for name and label pairs, creating it if needed
+func (r *Registry) Gauge(name, help string, labels ...string) *Gauge {
+	return r.getOrCreate(name, help, MetricTypeGauge, labels, func() interface{} {
+		return &Gauge{}
+	}).(*Gauge)
+}
+
+// GaugeFunc registers a gauge whose value is computed by fn at scrape time
+// Use for figures owned by another service that are safe to read at any time
+// (cache hit ratio). Chain state must not be read this way: an sdk.Context
+// captured in fn goes stale, so publish it with Gauge from EndBlocker instead.
+func (r *Registry) GaugeFunc(name, help string, fn func() float64, labels ...string) {
+	gauge := r.Gauge(name, help, labels...)
+	gauge.mu.Lock()
+	gauge.fn = fn
+	gauge.mu.Unlock()
+}
+
+// Histogram returns the histogram for name and label pairs, creating it if needed
+// Buckets are only applied when the series is first created
+func (r *Registry) Histogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	return r.getOrCreate(name, help, MetricTypeHistogram, labels, func() interface{} {
+		bounds := make([]float64, len(buckets))
+		copy(bounds, buckets)
+		sort.Float64s(bounds)
+		return &Histogram{
+			buckets: bounds,
+			counts:  make([]uint64, len(bounds)),
+		}
+	}).(*Histogram)
+}
+
+// getOrCreate returns an existing series or registers a new one
+// Panics if name is already registered with a different type (programming error)
+func (r *Registry) getOrCreate(name, help string, kind MetricType, labels []string, create func() interface{}) interface{} {
+	key := renderLabels(labels)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fam, exists := r.families[name]
+	if !exists {
+		fam = &family{
+			name:   name,
+			help:   help,
+			kind:   kind,
+			series: make(map[string]interface{}),
+		}
+		r.families[name] = fam
+	}
+
+	if fam.kind != kind {
+		panic(fmt.Sprintf("metric %s already registered as %s, not %s", name, fam.kind, kind))
+	}
+
+	metric, exists := fam.series[key]
+	if !exists {
+		metric = create()
+		fam.series[key] = metric
+	}
+
+	return metric
+}
+
+// WritePrometheus writes all metrics in the Prometheus text exposition format
+func (r *Registry) WritePrometheus(w io.Writer) error {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.families))
+	for name := range r.families {
+		names = append(names, name)
+	}
+	r.mu.RUnlock()
+
+	sort.Strings(names)
+
+	var b strings.Builder
+	for _, name := range names {
+		r.mu.RLock()
+		fam := r.families[name]
+		keys := make([]string, 0, len(fam.series))
+		for key := range fam.series {
+			keys = append(keys, key)
+		}
+		r.mu.RUnlock()
+
+		sort.Strings(keys)
+
+		fmt.Fprintf(&b, "# HELP %s %s\n", fam.name, escapeHelp(fam.help))
+		fmt.Fprintf(&b, "# TYPE %s %s\n", fam.name, fam.kind)
+
+		for _, key := range keys {
+			r.mu.RLock()
+			metric := fam.series[key]
+			r.mu.RUnlock()
+
+			switch m := metric.(type) {
+			case *Counter:
+				fmt.Fprintf(&b, "%s%s %s\n", fam.name, wrapLabels(key), formatValue(m.Value()))
+			case *Gauge:
+				fmt.Fprintf(&b, "%s%s %s\n", fam.name, wrapLabels(key), formatValue(m.Value()))
+			case *Histogram:
+				writeHistogram(&b, fam.name, key, m)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// HandleMetrics handles GET /metrics
+func (r *Registry) HandleMetrics(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := r.WritePrometheus(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// RegisterRoutes registers the metrics endpoint
+func (r *Registry) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/metrics", r.HandleMetrics)
+}
+
+// writeHistogram renders cumulative buckets, sum and count for one series
+func writeHistogram(b *strings.Builder, name, key string, h *Histogram) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	cumulative := uint64(0)
+	for i, bound := range h.buckets {
+		cumulative += h.counts[i]
+		fmt.Fprintf(b, "%s_bucket%s %d\n", name, wrapLabels(joinLabels(key, `le="`+formatValue(bound)+`"`)), cumulative)
+	}
+	fmt.Fprintf(b, "%s_bucket%s %d\n", name, wrapLabels(joinLabels(key, `le="+Inf"`)), h.count)
+	fmt.Fprintf(b, "%s_sum%s %s\n", name, wrapLabels(key), formatValue(h.sum))
+	fmt.Fprintf(b, "%s_count%s %d\n", name, wrapLabels(key), h.count)
+}
+
+// renderLabels renders key/value pairs as a sorted Prometheus label list (without braces)
+func renderLabels(labels []string) string {
+	if len(labels)%2 != 0 {
+		labels = append(labels, "")
+	}
+
+	pairs := make([]string, 0, len(labels)/2)
+	for i := 0; i < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", labels[i], labels[i+1]))
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+// joinLabels appends an extra label to a rendered label list
+func joinLabels(key, extra string) string {
+	if key == "" {
+		return extra
+	}
+	return key + "," + extra
+}
+
+// wrapLabels wraps a rendered label list in braces (empty for no labels)
+func wrapLabels(key string) string {
+	if key == "" {
+		return ""
+	}
+	return "{" + key + "}"
+}
+
+// formatValue formats a sample value as Prometheus expects
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// escapeHelp escapes backslashes and newlines in HELP text
+func escapeHelp(help string) string {
+	help = strings.ReplaceAll(help, `\`, `\\`)
+	return strings.ReplaceAll(help, "\n", `\n`)
+}
@@ -0,0 +1,168 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Unified Pricing Service
+//
+// Single source of truth for every fee the hub charges. Verification,
+// boarding, checkpoint and consultation fees used to be defined separately in
+// each module; they now resolve through a PricingService so total pricing can
+// be read and changed in one place. Modules share one instance via their
+// SetPricingService setters, or fall back to the default schedule.
+
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// FeeCategory groups related fees
+type FeeCategory string
+
+const (
+	CategoryVerification FeeCategory = "verification" // PFF biometric payments (seamless debit)
+	CategoryBoarding     FeeCategory = "boarding"     // Boarding gate scans charged to the Vitalian or airline vault
+	CategoryCheckpoint   FeeCategory = "checkpoint"   // Multi-party settlement event base amounts
+	CategoryConsultation FeeCategory = "consultation" // Professional consultation escrow
+)
+
+// Fee keys within each category
+const (
+	VerificationFastTrack = "fast_track"
+	VerificationStandard  = "standard"
+
+	BoardingStandard = "standard"
+
+	CheckpointAirport      = "AIRPORT_CHECKPOINT"
+	CheckpointBoardingGate = "BOARDING_GATE"
+	CheckpointDualPurpose  = "DUAL_PURPOSE"
+
+	ConsultationDefault = "default" // Fallback for professionals without a matching tier
+)
+
+// Default fee amounts (uSOV)
+const (
+	DefaultFastTrackFee            = 1_000_000  // 1 SOV
+	DefaultStandardVerificationFee = 10_000_000 // 10 SOV
+	DefaultBoardingFee             = 10_000_000 // 10 SOV
+	DefaultAirportCheckpointFee    = 1_000_000  // 1 SOV
+	DefaultBoardingGateFee         = 10_000_000 // 10 SOV
+	DefaultDualPurposeFee          = 11_000_000 // 11 SOV (checkpoint + boarding gate)
+	DefaultConsultationFee         = 50_000_000 // 50 SOV
+)
+
+// ErrUnknownFee is returned when a category/key pair has no fee
+var ErrUnknownFee = errors.New("unknown fee")
+
+// FeeKey identifies one fee in the schedule
+type FeeKey struct {
+	Category FeeCategory `json:"category"`
+	Key      string      `json:"key"`
+}
+
+// Fee is one entry of the fee schedule
+type Fee struct {
+	Category   FeeCategory `json:"category"`
+	Key        string      `json:"key"`
+	AmountUSOV int64       `json:"amount_usov"`
+}
+
+// DefaultFeeSchedule returns the launch fee schedule
+func DefaultFeeSchedule() map[FeeKey]int64 {
+	return map[FeeKey]int64{
+		{CategoryVerification, VerificationFastTrack}: DefaultFastTrackFee,
+		{CategoryVerification, VerificationStandard}:  DefaultStandardVerificationFee,
+		{CategoryBoarding, BoardingStandard}:          DefaultBoardingFee,
+		{CategoryCheckpoint, CheckpointAirport}:       DefaultAirportCheckpointFee,
+		{CategoryCheckpoint, CheckpointBoardingGate}:  DefaultBoardingGateFee,
+		{CategoryCheckpoint, CheckpointDualPurpose}:   DefaultDualPurposeFee,
+		{CategoryConsultation, ConsultationDefault}:   DefaultConsultationFee,
+	}
+}
+
+// PricingService owns the fee schedule
+type PricingService struct {
+	fees map[FeeKey]int64
+	mu   sync.RWMutex
+}
+
+// NewPricingService creates a pricing service loaded with the default schedule
+func NewPricingService() *PricingService {
+	return &PricingService{
+		fees: DefaultFeeSchedule(),
+	}
+}
+
+// Fee returns the amount (uSOV) for a category and key, or ErrUnknownFee
+func (ps *PricingService) Fee(category FeeCategory, key string) (int64, error) {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	amount, exists := ps.fees[FeeKey{category, key}]
+	if !exists {
+		return 0, fmt.Errorf("%w: %s/%s", ErrUnknownFee, category, key)
+	}
+
+	return amount, nil
+}
+
+// SetFee adds or changes a fee (amounts must not be negative)
+func (ps *PricingService) SetFee(category FeeCategory, key string, amountUSOV int64) error {
+	switch category {
+	case CategoryVerification, CategoryBoarding, CategoryCheckpoint, CategoryConsultation:
+	default:
+		return fmt.Errorf("invalid fee category: %s", category)
+	}
+	if key == "" {
+		return fmt.Errorf("fee key required")
+	}
+	if amountUSOV < 0 {
+		return fmt.Errorf("fee %s/%s cannot be negative, got %d", category, key, amountUSOV)
+	}
+
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+
+	ps.fees[FeeKey{category, key}] = amountUSOV
+	return nil
+}
+
+// VerificationFee returns the PFF verification fee for a transaction type (e.g. "fast_track")
+func (ps *PricingService) VerificationFee(transactionType string) (int64, error) {
+	return ps.Fee(CategoryVerification, transactionType)
+}
+
+// BoardingFee returns the boarding gate fee for a fare class (e.g. "standard")
+func (ps *PricingService) BoardingFee(fareClass string) (int64, error) {
+	return ps.Fee(CategoryBoarding, fareClass)
+}
+
+// CheckpointFee returns the settlement base amount for an event type (e.g. "AIRPORT_CHECKPOINT")
+func (ps *PricingService) CheckpointFee(eventType string) (int64, error) {
+	return ps.Fee(CategoryCheckpoint, eventType)
+}
+
+// ConsultationFee returns a consultation fee (e.g. "default")
+func (ps *PricingService) ConsultationFee(key string) (int64, error) {
+	return ps.Fee(CategoryConsultation, key)
+}
+
+// GetSchedule returns every fee, ordered by category then key
+func (ps *PricingService) GetSchedule() []Fee {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+
+	schedule := make([]Fee, 0, len(ps.fees))
+	for key, amount := range ps.fees {
+		schedule = append(schedule, Fee{Category: key.Category, Key: key.Key, AmountUSOV: amount})
+	}
+
+	sort.Slice(schedule, func(i, j int) bool {
+		if schedule[i].Category != schedule[j].Category {
+			return schedule[i].Category < schedule[j].Category
+		}
+		return schedule[i].Key < schedule[j].Key
+	})
+
+	return schedule
+}
@@ -0,0 +1,329 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Chain Balance Reconciliation
+//
+// Keeps API-layer vault and wallet balances consistent with the on-chain bank
+// module. A DID's on-chain account backs both its SovereignVaultManager vault
+// and its WalletManager wallet (wallets are keyed by DID), so the chain balance
+// is compared with their sum. The chain is the source of truth: divergent
+// accounts are either flagged for an operator or corrected in place, depending
+// on the configured policy. Runs as a full sweep or per-DID when the chain
+// emits a balance change event.
+
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/billing"
+	"github.com/sovrn-protocol/sovrn/hub/api/wallet"
+)
+
+// ChainReconciliationPurpose is the vault transaction purpose for balance corrections
+const ChainReconciliationPurpose = "chain_reconciliation"
+
+// BalancePolicy controls what happens when a divergence is found
+type BalancePolicy string
+
+const (
+	BalancePolicyReport  BalancePolicy = "report"  // Flag divergence only (default)
+	BalancePolicyCorrect BalancePolicy = "correct" // Adjust the API vault to match the chain
+)
+
+// BalanceDivergence represents a DID whose API balance differs from its on-chain account
+type BalanceDivergence struct {
+	DID            string    `json:"did"`
+	UserID         string    `json:"user_id"`
+	VaultBalance   int64     `json:"vault_balance"`  // uSOV (0 without a vault)
+	WalletBalance  int64     `json:"wallet_balance"` // uSOV, regular + escrow (0 without a wallet)
+	APIBalance     int64     `json:"api_balance"`    // uSOV, vault + wallet
+	ChainBalance   int64     `json:"chain_balance"`  // uSOV
+	Difference     int64     `json:"difference"`     // chain - api
+	Corrected      bool      `json:"corrected"`
+	CorrectionTxID string    `json:"correction_tx_id,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	DetectedAt     time.Time `json:"detected_at"`
+}
+
+// BalanceReport summarizes a balance reconciliation run
+type BalanceReport struct {
+	Policy         BalancePolicy        `json:"policy"`
+	Divergences    []*BalanceDivergence `json:"divergences"`
+	VaultsScanned  int                  `json:"vaults_scanned"`
+	WalletsScanned int                  `json:"wallets_scanned"`
+	DIDsMatched    int                  `json:"dids_matched"`
+	NoChainAccount int                  `json:"no_chain_account"` // DIDs with no on-chain account (skipped)
+	GeneratedAt    time.Time            `json:"generated_at"`
+}
+
+// BalanceChangeEvent is emitted by the chain when an account balance changes
+type BalanceChangeEvent struct {
+	DID         string `json:"did"`
+	Balance     int64  `json:"balance"` // uSOV after the change
+	BlockHeight int64  `json:"block_height"`
+}
+
+// ChainBalanceSource provides on-chain balances by DID
+type ChainBalanceSource interface {
+	// GetChainBalance returns the uSOV balance and whether an on-chain account exists
+	GetChainBalance(ctx context.Context, did string) (int64, bool, error)
+}
+
+// VaultLedger provides API vault balances and applies corrections
+// Balances are read through copies taken under the ledger's lock, never through
+// shared vault pointers that payments mutate concurrently.
+type VaultLedger interface {
+	SnapshotVaults(ctx context.Context) ([]wallet.SovereignVault, error)
+	SnapshotVaultByDID(ctx context.Context, did string) (wallet.SovereignVault, bool, error)
+	CreditVault(ctx context.Context, userID string, amount int64, purpose string) (string, error)
+	DebitVault(ctx context.Context, userID string, amount int64, purpose string, pffHash string) (string, error)
+}
+
+// WalletLedger provides API wallet balances (keyed by DID) and applies corrections
+type WalletLedger interface {
+	SnapshotWallets(ctx context.Context) ([]billing.SovereignWallet, error)
+	SnapshotWallet(ctx context.Context, userID string) (billing.SovereignWallet, bool, error)
+	CreditRegular(ctx context.Context, userID string, amount int64, purpose string, reference string) (string, error)
+	DebitRegular(ctx context.Context, userID string, amount int64, purpose string, reference string) (string, error)
+}
+
+// apiAccount is everything the API layer holds for one DID
+type apiAccount struct {
+	did    string
+	vault  *wallet.SovereignVault   // nil without a vault
+	wallet *billing.SovereignWallet // nil without a wallet
+}
+
+// balance returns the vault and wallet balances held for the DID
+func (a *apiAccount) balance() (vaultBalance int64, walletBalance int64) {
+	if a.vault != nil {
+		vaultBalance = a.vault.Balance
+	}
+	if a.wallet != nil {
+		walletBalance = a.wallet.RegularBalance + a.wallet.EscrowBalance
+	}
+	return vaultBalance, walletBalance
+}
+
+// BalanceReconciler compares API vault and wallet balances against on-chain balances
+type BalanceReconciler struct {
+	vaults  VaultLedger
+	wallets WalletLedger // nil = vaults only
+	chain   ChainBalanceSource
+	policy  BalancePolicy
+
+	// Most recent full report
+	lastReport *BalanceReport
+
+	mu sync.Mutex
+}
+
+// NewBalanceReconciler creates a new balance reconciler (report-only by default)
+func NewBalanceReconciler(vaults VaultLedger, chain ChainBalanceSource) *BalanceReconciler {
+	return &BalanceReconciler{
+		vaults: vaults,
+		chain:  chain,
+		policy: BalancePolicyReport,
+	}
+}
+
+// SetWalletLedger includes WalletManager wallets in reconciliation
+func (br *BalanceReconciler) SetWalletLedger(wallets WalletLedger) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	br.wallets = wallets
+}
+
+// SetPolicy sets whether divergences are only reported or also corrected
+func (br *BalanceReconciler) SetPolicy(policy BalancePolicy) error {
+	if policy != BalancePolicyReport && policy != BalancePolicyCorrect {
+		return fmt.Errorf("invalid balance policy: %s", policy)
+	}
+
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	br.policy = policy
+	return nil
+}
+
+// Reconcile compares every DID held by the API layer with its on-chain account
+//
+// RECONCILIATION LOGIC:
+// 1. Group vaults and wallets by DID
+// 2. Skip DIDs with no on-chain account (nothing to compare against)
+// 3. Vault + wallet balance equal to the chain balance -> matched
+// 4. Divergent balances -> flagged, and under the correct policy adjusted to match the chain
+func (br *BalanceReconciler) Reconcile(ctx context.Context) (*BalanceReport, error) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	report := &BalanceReport{
+		Policy:      br.policy,
+		Divergences: make([]*BalanceDivergence, 0),
+		GeneratedAt: time.Now(),
+	}
+
+	// 1. Group by DID (vaults first, then wallet-only DIDs)
+	vaults, err := br.vaults.SnapshotVaults(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load vaults: %w", err)
+	}
+
+	accounts := make([]*apiAccount, 0, len(vaults))
+	byDID := make(map[string]*apiAccount, len(vaults))
+	for i := range vaults {
+		report.VaultsScanned++
+		account := &apiAccount{did: vaults[i].DID, vault: &vaults[i]}
+		accounts = append(accounts, account)
+		byDID[account.did] = account
+	}
+
+	if br.wallets != nil {
+		wallets, err := br.wallets.SnapshotWallets(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load wallets: %w", err)
+		}
+
+		for i := range wallets {
+			report.WalletsScanned++
+			account, exists := byDID[wallets[i].UserID]
+			if !exists {
+				account = &apiAccount{did: wallets[i].UserID}
+				accounts = append(accounts, account)
+				byDID[account.did] = account
+			}
+			account.wallet = &wallets[i]
+		}
+	}
+
+	for _, account := range accounts {
+		// 2. Chain account
+		chainBalance, found, err := br.chain.GetChainBalance(ctx, account.did)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load chain balance for %s: %w", account.did, err)
+		}
+
+		if !found {
+			report.NoChainAccount++
+			continue
+		}
+
+		// 3-4. Compare
+		divergence := br.compareLocked(ctx, account, chainBalance)
+		if divergence == nil {
+			report.DIDsMatched++
+			continue
+		}
+
+		report.Divergences = append(report.Divergences, divergence)
+	}
+
+	br.lastReport = report
+
+	return report, nil
+}
+
+// HandleBalanceChangeEvent reconciles a single DID when the chain reports a balance change
+// Returns nil if the DID matches the chain or the API layer holds nothing for it
+func (br *BalanceReconciler) HandleBalanceChangeEvent(ctx context.Context, event BalanceChangeEvent) (*BalanceDivergence, error) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	account := &apiAccount{did: event.DID}
+
+	vault, found, err := br.vaults.SnapshotVaultByDID(ctx, event.DID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load vault for %s: %w", event.DID, err)
+	}
+	if found {
+		account.vault = &vault
+	}
+
+	if br.wallets != nil {
+		sovereignWallet, found, err := br.wallets.SnapshotWallet(ctx, event.DID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load wallet for %s: %w", event.DID, err)
+		}
+		if found {
+			account.wallet = &sovereignWallet
+		}
+	}
+
+	if account.vault == nil && account.wallet == nil {
+		return nil, nil // No API vault or wallet for this account
+	}
+
+	return br.compareLocked(ctx, account, event.Balance), nil
+}
+
+// GetLastReport returns the most recent full reconciliation report
+func (br *BalanceReconciler) GetLastReport() (*BalanceReport, error) {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	if br.lastReport == nil {
+		return nil, fmt.Errorf("no reconciliation has been run")
+	}
+
+	return br.lastReport, nil
+}
+
+// compareLocked checks one DID against its chain balance and applies the policy (caller holds br.mu)
+// Corrections go to the vault when the DID has one, otherwise to the wallet's regular balance.
+func (br *BalanceReconciler) compareLocked(ctx context.Context, account *apiAccount, chainBalance int64) *BalanceDivergence {
+	vaultBalance, walletBalance := account.balance()
+	apiBalance := vaultBalance + walletBalance
+	if apiBalance == chainBalance {
+		return nil
+	}
+
+	divergence := &BalanceDivergence{
+		DID:           account.did,
+		UserID:        account.did,
+		VaultBalance:  vaultBalance,
+		WalletBalance: walletBalance,
+		APIBalance:    apiBalance,
+		ChainBalance:  chainBalance,
+		Difference:    chainBalance - apiBalance,
+		DetectedAt:    time.Now(),
+	}
+	if account.vault != nil {
+		divergence.UserID = account.vault.UserID
+	}
+
+	if br.policy != BalancePolicyCorrect {
+		return divergence
+	}
+
+	// Chain is source of truth: move the API balance to the chain balance
+	var txID string
+	var err error
+	switch {
+	case account.vault != nil && divergence.Difference > 0:
+		txID, err = br.vaults.CreditVault(ctx, account.vault.UserID, divergence.Difference, ChainReconciliationPurpose)
+	case account.vault != nil:
+		txID, err = br.vaults.DebitVault(ctx, account.vault.UserID, -divergence.Difference, ChainReconciliationPurpose, "")
+	case divergence.Difference > 0:
+		txID, err = br.wallets.CreditRegular(ctx, account.did, divergence.Difference, ChainReconciliationPurpose, "")
+	default:
+		txID, err = br.wallets.DebitRegular(ctx, account.did, -divergence.Difference, ChainReconciliationPurpose, "")
+	}
+
+	if err != nil {
+		divergence.Error = err.Error()
+		return divergence
+	}
+
+	divergence.Corrected = true
+	divergence.CorrectionTxID = txID
+
+	fmt.Printf("✅ API balance reconciled to chain\n")
+	fmt.Printf("   DID: %s\n", account.did)
+	fmt.Printf("   API Balance: %d uSOV -> %d uSOV\n", divergence.APIBalance, chainBalance)
+
+	return divergence
+}
@@ -0,0 +1,259 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Orphaned Transaction Reprocessing
+//
+// Cross-references vault transactions, Vitalian records and billing events to
+// find half-completed operations left behind by a crash mid-flow, and suggests
+// how each one should be resolved.
+
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sovrn-protocol/sovrn/chain/economics"
+	"github.com/sovrn-protocol/sovrn/hub/api/billing"
+	"github.com/sovrn-protocol/sovrn/hub/api/wallet"
+)
+
+// OrphanType represents the kind of mismatch detected
+type OrphanType string
+
+const (
+	OrphanDebitWithoutRecord  OrphanType = "debit_without_record"  // Proxy debit with no Vitalian record
+	OrphanRecordWithoutDebit  OrphanType = "record_without_debit"  // Vitalian record with no successful debit
+	OrphanBillingWithoutDebit OrphanType = "billing_without_debit" // Charged billing event with no carrier debit
+)
+
+// ResolutionAction represents the suggested fix for an orphan
+type ResolutionAction string
+
+const (
+	ResolveCreateRecord      ResolutionAction = "create_vitalian_record" // Replay RecordVitalianPassage for the debit
+	ResolveRefundDebit       ResolutionAction = "refund_debit"           // Credit the debited amount back
+	ResolveRevokeRecord      ResolutionAction = "revoke_record"          // Invalidate the unpaid Vitalian record
+	ResolveRetryDebit        ResolutionAction = "retry_debit"            // Re-attempt the carrier debit
+	ResolveMarkBillingFailed ResolutionAction = "mark_billing_failed"    // Flag the billing event as failed
+)
+
+// Orphan represents a single half-completed operation
+type Orphan struct {
+	Type             OrphanType         `json:"type"`
+	ReferenceID      string             `json:"reference_id"` // Transaction, record or billing event ID
+	UserID           string             `json:"user_id"`
+	AmountUSOV       int64              `json:"amount_usov"`
+	PFFHash          string             `json:"pff_hash,omitempty"`
+	DetectedAt       time.Time          `json:"detected_at"`
+	OccurredAt       time.Time          `json:"occurred_at"`
+	Details          string             `json:"details"`
+	SuggestedActions []ResolutionAction `json:"suggested_actions"` // In order of preference
+	RequiresOperator bool               `json:"requires_operator"` // True if funds must move to resolve
+}
+
+// OrphanReport summarizes a reconciliation run
+type OrphanReport struct {
+	Orphans              []*Orphan `json:"orphans"`
+	DebitsScanned        int       `json:"debits_scanned"`
+	RecordsScanned       int       `json:"records_scanned"`
+	BillingEventsScanned int       `json:"billing_events_scanned"`
+	GeneratedAt          time.Time `json:"generated_at"`
+}
+
+// VaultTransactionSource provides vault transactions for reconciliation
+type VaultTransactionSource interface {
+	GetAllTransactions(ctx context.Context) ([]*wallet.VaultTransaction, error)
+}
+
+// VitalianRecordSource provides Vitalian records for reconciliation
+type VitalianRecordSource interface {
+	GetAllVitalianRecords() []*economics.VitalianRecord
+}
+
+// BillingEventSource provides billing events for reconciliation
+type BillingEventSource interface {
+	GetAllBillingEvents(ctx context.Context) ([]*billing.BillingEvent, error)
+}
+
+// WalletTransactionSource looks up billing wallet transactions by originating reference
+type WalletTransactionSource interface {
+	GetTransactionsByReference(ctx context.Context, reference string) ([]*billing.WalletTransaction, error)
+}
+
+// OrphanFinder cross-references ledgers to detect orphaned operations
+type OrphanFinder struct {
+	vaultTxs        VaultTransactionSource
+	vitalianRecords VitalianRecordSource
+	billingEvents   BillingEventSource
+	walletTxs       WalletTransactionSource
+
+	// gracePeriod skips operations younger than this (they may still be in flight)
+	gracePeriod time.Duration
+}
+
+// NewOrphanFinder creates a new orphan finder
+func NewOrphanFinder(
+	vaultTxs VaultTransactionSource,
+	vitalianRecords VitalianRecordSource,
+	billingEvents BillingEventSource,
+	walletTxs WalletTransactionSource,
+) *OrphanFinder {
+	return &OrphanFinder{
+		vaultTxs:        vaultTxs,
+		vitalianRecords: vitalianRecords,
+		billingEvents:   billingEvents,
+		walletTxs:       walletTxs,
+		gracePeriod:     5 * time.Minute, // Matches PFF proof freshness window
+	}
+}
+
+// SetGracePeriod sets how old an operation must be before it can be reported
+func (of *OrphanFinder) SetGracePeriod(gracePeriod time.Duration) {
+	of.gracePeriod = gracePeriod
+}
+
+// FindOrphans cross-references all ledgers and reports mismatches
+//
+// RECONCILIATION LOGIC:
+// 1. Index successful vault debits by transaction ID
+// 2. Proxy debit without a Vitalian record -> debit_without_record
+// 3. Vitalian record whose transaction has no successful debit -> record_without_debit
+// 4. Charged billing event with no successful wallet debit referencing its verification -> billing_without_debit
+func (of *OrphanFinder) FindOrphans(ctx context.Context) (*OrphanReport, error) {
+	now := time.Now()
+	cutoff := now.Add(-of.gracePeriod)
+
+	txs, err := of.vaultTxs.GetAllTransactions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load vault transactions: %w", err)
+	}
+
+	records := of.vitalianRecords.GetAllVitalianRecords()
+
+	events, err := of.billingEvents.GetAllBillingEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load billing events: %w", err)
+	}
+
+	report := &OrphanReport{
+		Orphans:              make([]*Orphan, 0),
+		RecordsScanned:       len(records),
+		BillingEventsScanned: len(events),
+		GeneratedAt:          now,
+	}
+
+	// 1. Index successful debits
+	debitsByTxID := make(map[string]*wallet.VaultTransaction)
+	for _, tx := range txs {
+		if tx.Type != "debit" || tx.Status != "success" {
+			continue
+		}
+		report.DebitsScanned++
+
+		debitsByTxID[tx.TransactionID] = tx
+	}
+
+	recordsByTxID := make(map[string]*economics.VitalianRecord)
+	for _, record := range records {
+		recordsByTxID[record.TransactionID] = record
+	}
+
+	// 2. Proxy debits without a Vitalian record
+	for _, tx := range debitsByTxID {
+		if !strings.HasPrefix(tx.Purpose, economics.PROXY_PAYMENT_PURPOSE_PREFIX) || tx.Timestamp.After(cutoff) {
+			continue
+		}
+
+		if _, exists := recordsByTxID[tx.TransactionID]; !exists {
+			report.Orphans = append(report.Orphans, &Orphan{
+				Type:             OrphanDebitWithoutRecord,
+				ReferenceID:      tx.TransactionID,
+				UserID:           tx.UserID,
+				AmountUSOV:       tx.Amount,
+				PFFHash:          tx.PFFHash,
+				DetectedAt:       now,
+				OccurredAt:       tx.Timestamp,
+				Details:          fmt.Sprintf("proxy debit for %s has no Vitalian passage record", strings.TrimPrefix(tx.Purpose, economics.PROXY_PAYMENT_PURPOSE_PREFIX)),
+				SuggestedActions: []ResolutionAction{ResolveCreateRecord, ResolveRefundDebit},
+				RequiresOperator: false, // Replaying the record moves no funds
+			})
+		}
+	}
+
+	// 3. Vitalian records without a successful debit
+	for _, record := range records {
+		if record.Timestamp.After(cutoff) {
+			continue
+		}
+
+		if _, exists := debitsByTxID[record.TransactionID]; !exists {
+			report.Orphans = append(report.Orphans, &Orphan{
+				Type:             OrphanRecordWithoutDebit,
+				ReferenceID:      record.RecordID,
+				UserID:           record.TravelerDID,
+				PFFHash:          record.PFFHash,
+				DetectedAt:       now,
+				OccurredAt:       record.Timestamp,
+				Details:          fmt.Sprintf("Vitalian record references missing debit %s (payer %s)", record.TransactionID, record.ProxyDID),
+				SuggestedActions: []ResolutionAction{ResolveRevokeRecord},
+				RequiresOperator: true,
+			})
+		}
+	}
+
+	// 4. Charged billing events without a wallet debit (fees are debited with the verification ID as reference)
+	for _, event := range events {
+		if event.Status != "charged" || event.Timestamp.After(cutoff) {
+			continue
+		}
+
+		debited, err := of.hasReferencedDebit(ctx, event.VerificationID)
+		if err != nil {
+			return nil, err
+		}
+		if !debited {
+			report.Orphans = append(report.Orphans, &Orphan{
+				Type:             OrphanBillingWithoutDebit,
+				ReferenceID:      event.EventID,
+				UserID:           event.CarrierID,
+				AmountUSOV:       event.AmountUSOV,
+				DetectedAt:       now,
+				OccurredAt:       event.Timestamp,
+				Details:          fmt.Sprintf("billing event for verification %s marked charged but no wallet debit references it", event.VerificationID),
+				SuggestedActions: []ResolutionAction{ResolveRetryDebit, ResolveMarkBillingFailed},
+				RequiresOperator: true,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// hasReferencedDebit reports whether a successful wallet debit carries the reference
+func (of *OrphanFinder) hasReferencedDebit(ctx context.Context, reference string) (bool, error) {
+	if reference == "" {
+		return false, nil
+	}
+
+	txs, err := of.walletTxs.GetTransactionsByReference(ctx, reference)
+	if err != nil {
+		return false, fmt.Errorf("failed to load wallet transactions for %s: %w", reference, err)
+	}
+
+	for _, tx := range txs {
+		if tx.Type == "debit" && tx.Status == "success" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// CountByType returns the number of orphans of each type in the report
+func (r *OrphanReport) CountByType() map[OrphanType]int {
+	counts := make(map[OrphanType]int)
+	for _, orphan := range r.Orphans {
+		counts[orphan.Type]++
+	}
+	return counts
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrNoSpokeRoute is returned when no route maps a carrier to a National Spoke
+var ErrNoSpokeRoute = errors.New("no spoke route")
+
+// SpokeRouter maps carrier IDs ("airline:BA", "airport:LOS") to National Spokes
+//
+// ROUTING ORDER:
+// 1. Exact carrier ID (RegisterCarrierSpoke)
+// 2. IATA code after the "airline:"/"airport:" type prefix (RegisterIATASpoke)
+// 3. Longest registered carrier ID prefix (RegisterPrefixSpoke)
+// Carriers matching none of these get ErrNoSpokeRoute rather than a default spoke.
+type SpokeRouter struct {
+	carrierSpokes map[string]string // Carrier ID -> spoke
+	iataSpokes    map[string]string // Upper-case IATA airline/airport code -> spoke
+	prefixSpokes  map[string]string // Carrier ID prefix -> spoke
+	mu            sync.RWMutex
+}
+
+// NewSpokeRouter creates an empty spoke router
+func NewSpokeRouter() *SpokeRouter {
+	return &SpokeRouter{
+		carrierSpokes: make(map[string]string),
+		iataSpokes:    make(map[string]string),
+		prefixSpokes:  make(map[string]string),
+	}
+}
+
+// DefaultSpokeRouter returns a router preloaded with the launch carriers and airports
+func DefaultSpokeRouter() *SpokeRouter {
+	router := NewSpokeRouter()
+
+	for code, spokeID := range map[string]string{
+		"AA":  "usa",     // American Airlines
+		"BA":  "uk",      // British Airways
+		"KQ":  "kenya",   // Kenya Airways
+		"LOS": "nigeria", // Lagos
+		"ABV": "nigeria", // Abuja
+		"NBO": "kenya",   // Nairobi
+		"LHR": "uk",      // London Heathrow
+		"JFK": "usa",     // New York JFK
+	} {
+		router.iataSpokes[code] = spokeID
+	}
+
+	return router
+}
+
+// RegisterCarrierSpoke routes an exact carrier ID (e.g. "airline:BA") to a spoke
+func (sr *SpokeRouter) RegisterCarrierSpoke(carrierID string, spokeID string) error {
+	if carrierID == "" || spokeID == "" {
+		return fmt.Errorf("carrier ID and spoke ID required")
+	}
+
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	sr.carrierSpokes[carrierID] = spokeID
+	return nil
+}
+
+// RegisterIATASpoke routes an IATA airline designator or airport code (case-insensitive) to a spoke
+func (sr *SpokeRouter) RegisterIATASpoke(code string, spokeID string) error {
+	if code == "" || spokeID == "" {
+		return fmt.Errorf("IATA code and spoke ID required")
+	}
+
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	sr.iataSpokes[strings.ToUpper(code)] = spokeID
+	return nil
+}
+
+// RegisterPrefixSpoke routes every carrier ID starting with prefix (e.g. "airport:NG-") to a spoke
+func (sr *SpokeRouter) RegisterPrefixSpoke(prefix string, spokeID string) error {
+	if prefix == "" || spokeID == "" {
+		return fmt.Errorf("prefix and spoke ID required")
+	}
+
+	sr.mu.Lock()
+	defer sr.mu.Unlock()
+
+	sr.prefixSpokes[prefix] = spokeID
+	return nil
+}
+
+// Route returns the spoke for a carrier ID
+func (sr *SpokeRouter) Route(carrierID string) (string, error) {
+	sr.mu.RLock()
+	defer sr.mu.RUnlock()
+
+	// 1. Exact carrier ID
+	if spokeID, exists := sr.carrierSpokes[carrierID]; exists {
+		return spokeID, nil
+	}
+
+	// 2. IATA code ("airline:BA" -> "BA", "airport:LOS" -> "LOS")
+	if idx := strings.Index(carrierID, ":"); idx >= 0 {
+		if spokeID, exists := sr.iataSpokes[strings.ToUpper(carrierID[idx+1:])]; exists {
+			return spokeID, nil
+		}
+	}
+
+	// 3. Longest matching prefix
+	prefixes := make([]string, 0, len(sr.prefixSpokes))
+	for prefix := range sr.prefixSpokes {
+		if strings.HasPrefix(carrierID, prefix) {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if len(prefixes) > 0 {
+		sort.Slice(prefixes, func(i, j int) bool {
+			return len(prefixes[i]) > len(prefixes[j])
+		})
+		return sr.prefixSpokes[prefixes[0]], nil
+	}
+
+	return "", fmt.Errorf("%w for carrier %s", ErrNoSpokeRoute, carrierID)
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"strings"
+)
+
+// Spoke routing sources, in precedence order
+const (
+	SpokeRoutingDID             = "did"              // Traveler's DID names their home spoke
+	SpokeRoutingNationalityHint = "nationality_hint" // Claimed nationality from the scan context
+	SpokeRoutingCarrierDefault  = "carrier_default"  // Carrier/airport default spoke
+)
+
+// DefaultNationalitySpokes maps nationality codes to National Spoke IDs
+// Spoke IDs themselves (e.g., "nigeria") are accepted as hints without an entry.
+func DefaultNationalitySpokes() map[string]string {
+	return map[string]string{
+		"ng": "nigeria",
+		"ke": "kenya",
+		"gb": "uk",
+		"uk": "uk",
+		"us": "usa",
+	}
+}
+
+// SetNationalitySpoke routes a nationality code (case-insensitive) to a spoke
+func (fts *FastTrackService) SetNationalitySpoke(nationality string, spokeID string) {
+	fts.routingMu.Lock()
+	defer fts.routingMu.Unlock()
+
+	fts.nationalitySpokes[strings.ToLower(nationality)] = spokeID
+}
+
+// SetSpokeRouter replaces the carrier routing table used when no DID or nationality hint is given
+func (fts *FastTrackService) SetSpokeRouter(router *SpokeRouter) {
+	fts.routingMu.Lock()
+	defer fts.routingMu.Unlock()
+
+	fts.spokeRouter = router
+}
+
+// resolveSpoke picks the spoke for a verification
+//
+// PRECEDENCE:
+// 1. TravelerDID (did:sovra:{country}:{id}) -> the DID's country spoke
+// 2. NationalityHint (walk-up travelers without a DID)
+// 3. Carrier route (determineSpokeID); fails with ErrNoSpokeRoute for unknown carriers
+func (fts *FastTrackService) resolveSpoke(req *VerifyTravelerRequest) (string, string, error) {
+	if country := didCountry(req.TravelerDID); country != "" {
+		return fts.nationalitySpoke(country), SpokeRoutingDID, nil
+	}
+
+	if hint := strings.TrimSpace(req.NationalityHint); hint != "" {
+		return fts.nationalitySpoke(hint), SpokeRoutingNationalityHint, nil
+	}
+
+	spokeID, err := fts.determineSpokeID(req.CarrierID)
+	return spokeID, SpokeRoutingCarrierDefault, err
+}
+
+// nationalitySpoke maps a nationality code to its spoke (unmapped values are used as spoke IDs)
+func (fts *FastTrackService) nationalitySpoke(nationality string) string {
+	nationality = strings.ToLower(nationality)
+
+	fts.routingMu.RLock()
+	defer fts.routingMu.RUnlock()
+
+	if spokeID, exists := fts.nationalitySpokes[nationality]; exists {
+		return spokeID
+	}
+	return nationality
+}
+
+// didCountry extracts the country segment of a did:sovra:{country}:{id} DID ("" if malformed)
+func didCountry(did string) string {
+	parts := strings.Split(did, ":")
+	if len(parts) < 4 || parts[0] != "did" || parts[2] == "" {
+		return ""
+	}
+	return parts[2]
+}
@@ -10,16 +10,29 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/sovrn-protocol/sovrn/x/mint/types"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/metrics"
 )
 
 // SupplyExplorerService provides real-time supply tracking
 type SupplyExplorerService struct {
 	mintKeeper MintKeeper
 	bankKeeper BankKeeper
+	metrics    *supplyMetrics // Set by RegisterMetrics, refreshed by UpdateMetrics
+}
+
+// supplyMetrics are the supply gauges published to the metrics registry
+type supplyMetrics struct {
+	circulatingSupply *metrics.Gauge
+	remainingMintable *metrics.Gauge
+	blackHoleBalance  *metrics.Gauge
+	burnRate          *metrics.Gauge
+	totalMinted       *metrics.Gauge
 }
 
 // NewSupplyExplorerService creates a new supply explorer service
@@ -99,6 +112,34 @@ func (ses *SupplyExplorerService) GetSupplyStatus(ctx sdk.Context) SupplyExplore
 	}
 }
 
+// RegisterMetrics publishes supply figures as gauges
+// The gauges hold the values from the last UpdateMetrics call; call it from
+// EndBlocker so scrapes always report committed state, never a stale context.
+func (ses *SupplyExplorerService) RegisterMetrics(registry *metrics.Registry) {
+	ses.metrics = &supplyMetrics{
+		circulatingSupply: registry.Gauge("sovrn_circulating_supply_usov", "Circulating SOV supply in uSOV"),
+		remainingMintable: registry.Gauge("sovrn_remaining_mintable_usov", "uSOV that can still be minted before the max supply"),
+		blackHoleBalance:  registry.Gauge("sovrn_black_hole_balance_usov", "Total uSOV burned to the black hole address"),
+		burnRate:          registry.Gauge("sovrn_burn_rate", "Current burn rate applied to transactions"),
+		totalMinted:       registry.Gauge("sovrn_minted_usov", "Total uSOV minted on verifications"),
+	}
+}
+
+// UpdateMetrics refreshes the supply gauges from the block's state (call from EndBlocker)
+// Does nothing until RegisterMetrics has been called.
+func (ses *SupplyExplorerService) UpdateMetrics(ctx sdk.Context) {
+	if ses.metrics == nil {
+		return
+	}
+
+	status := ses.mintKeeper.GetSupplyStatus(ctx)
+	ses.metrics.circulatingSupply.Set(parseMetricValue(status.CirculatingSupply.String()))
+	ses.metrics.remainingMintable.Set(parseMetricValue(status.RemainingMintable.String()))
+	ses.metrics.blackHoleBalance.Set(parseMetricValue(ses.mintKeeper.GetBlackHoleBalance(ctx).String()))
+	ses.metrics.burnRate.Set(parseMetricValue(ses.mintKeeper.GetCurrentBurnRate(ctx).String()))
+	ses.metrics.totalMinted.Set(parseMetricValue(ses.mintKeeper.GetTotalMinted(ctx).String()))
+}
+
 // HTTP Handlers
 
 // HandleGetSupplyStatus handles GET /v1/supply/status
@@ -141,6 +182,56 @@ func (ses *SupplyExplorerService) HandleGetBlackHoleBalance(ctx sdk.Context) htt
 	}
 }
 
+// BurnRateTransition is one point on the burn rate history chart
+type BurnRateTransition struct {
+	BlockHeight         int64     `json:"block_height"`
+	Timestamp           time.Time `json:"timestamp"`
+	PreviousRate        string    `json:"previous_rate"`
+	NewRate             string    `json:"new_rate"`
+	NewRatePercent      string    `json:"new_rate_percent"`
+	TriggeringSupply    string    `json:"triggering_supply"`
+	TriggeringSupplySOV string    `json:"triggering_supply_sov"`
+}
+
+// GetBurnRateHistory returns burn rate transitions in chronological order
+func (ses *SupplyExplorerService) GetBurnRateHistory(ctx sdk.Context) []BurnRateTransition {
+	history := ses.mintKeeper.GetBurnRateHistory(ctx)
+	
+	transitions := make([]BurnRateTransition, 0, len(history))
+	for _, change := range history {
+		transitions = append(transitions, BurnRateTransition{
+			BlockHeight:         change.BlockHeight,
+			Timestamp:           change.Timestamp,
+			PreviousRate:        change.PreviousRate.String(),
+			NewRate:             change.NewRate.String(),
+			NewRatePercent:      change.NewRate.MulInt64(100).String() + "%",
+			TriggeringSupply:    change.TriggeringSupply.String(),
+			TriggeringSupplySOV: convertToSOV(change.TriggeringSupply),
+		})
+	}
+	
+	return transitions
+}
+
+// HandleGetBurnRateHistory handles GET /v1/supply/burn-rate-history
+func (ses *SupplyExplorerService) HandleGetBurnRateHistory(ctx sdk.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		
+		response := map[string]interface{}{
+			"transitions":       ses.GetBurnRateHistory(ctx),
+			"current_burn_rate": ses.mintKeeper.GetCurrentBurnRate(ctx).String(),
+			"timestamp":         time.Now().UTC(),
+		}
+		
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}
+}
+
 // Helper Functions
 
 // convertToSOV converts uSOV to SOV (1 SOV = 1,000,000 uSOV)
@@ -155,12 +246,23 @@ func convertToSOV(uSOV sdk.Int) string {
 	return fmt.Sprintf("%s.%06d SOV", sov.String(), remainder.Int64())
 }
 
+// parseMetricValue converts an sdk.Int/sdk.Dec string to a float for metrics (0 on error)
+func parseMetricValue(value string) float64 {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
 // Expected Keepers
 
 type MintKeeper interface {
 	GetSupplyStatus(ctx sdk.Context) minttypes.SupplyStatus
 	GetBlackHoleBalance(ctx sdk.Context) sdk.Int
 	GetCurrentBurnRate(ctx sdk.Context) sdk.Dec
+	GetBurnRateHistory(ctx sdk.Context) []minttypes.BurnRateChange
+	GetTotalMinted(ctx sdk.Context) sdk.Int
 }
 
 type BankKeeper interface {
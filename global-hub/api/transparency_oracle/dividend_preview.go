@@ -0,0 +1,63 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Dividend Distribution Preview
+//
+// Lets citizens see, before the monthly run, roughly what their Integrity
+// Dividend will be given the current spoke pools and eligible DID count.
+
+package transparency_oracle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/wallet"
+)
+
+// DividendEstimator projects a DID's dividend (implemented by wallet.DividendDistributor)
+type DividendEstimator interface {
+	EstimateDividend(ctx context.Context, did string) (*wallet.DividendEstimate, error)
+}
+
+// SetDividendEstimator enables dividend previews, using the distributor's own math
+func (tos *TransparencyOracleService) SetDividendEstimator(estimator DividendEstimator) {
+	tos.mu.Lock()
+	defer tos.mu.Unlock()
+
+	tos.estimator = estimator
+}
+
+// EstimateDividend returns the projected dividend for a DID from the current pool state
+func (tos *TransparencyOracleService) EstimateDividend(ctx context.Context, did string) (*wallet.DividendEstimate, error) {
+	tos.mu.RLock()
+	estimator := tos.estimator
+	tos.mu.RUnlock()
+
+	if estimator == nil {
+		return nil, fmt.Errorf("dividend preview not enabled")
+	}
+
+	if did == "" {
+		return nil, fmt.Errorf("did required")
+	}
+
+	return estimator.EstimateDividend(ctx, did)
+}
+
+// HandleEstimateDividend handles GET /v1/transparency/dividend-estimate?did={did}
+func (tos *TransparencyOracleService) HandleEstimateDividend(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	estimate, err := tos.EstimateDividend(r.Context(), r.URL.Query().Get("did"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(estimate)
+}
@@ -101,5 +101,48 @@ func RegisterTransparencyRoutes(mux *http.ServeMux, tos *TransparencyOracleServi
 	mux.HandleFunc("/v1/transparency/project-rnd", tos.HandleGetProjectRnD(ctx))
 	mux.HandleFunc("/v1/transparency/infrastructure", tos.HandleGetInfrastructure(ctx))
 	mux.HandleFunc("/v1/transparency/deflation", tos.HandleGetDeflation(ctx))
+
+	// Signed transparency reports
+	mux.HandleFunc("/v1/transparency/report", tos.HandleTransparencyReport(ctx))
+
+	// Dividend preview
+	mux.HandleFunc("/v1/transparency/dividend-estimate", tos.HandleEstimateDividend)
+}
+
+// HandleTransparencyReport returns HTTP handler for /v1/transparency/report
+// POST generates a new signed report; GET ?report_id= retrieves a prior one (omit to list IDs)
+func (tos *TransparencyOracleService) HandleTransparencyReport(ctx sdk.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method {
+		case http.MethodPost:
+			report, err := tos.GenerateTransparencyReport(ctx)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			json.NewEncoder(w).Encode(report)
+
+		case http.MethodGet:
+			reportID := r.URL.Query().Get("report_id")
+			if reportID == "" {
+				json.NewEncoder(w).Encode(map[string]interface{}{
+					"report_ids": tos.ListTransparencyReports(),
+				})
+				return
+			}
+
+			report, err := tos.GetTransparencyReport(reportID)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(report)
+
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+	}
 }
 
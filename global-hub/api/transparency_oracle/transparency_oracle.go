@@ -7,9 +7,11 @@
 package transparency_oracle
 
 import (
+	"crypto/ed25519"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,12 +22,23 @@ import (
 // TransparencyOracleService provides real-time balance tracking for Four Pillars
 type TransparencyOracleService struct {
 	bankKeeper BankKeeper
+
+	// Signed transparency reports (see transparency_report.go)
+	mintKeeper  MintKeeper
+	dividends   DividendSummarySource
+	reserves    ReserveSource
+	estimator   DividendEstimator
+	signingKey  ed25519.PrivateKey
+	reports     map[string]*TransparencyReport
+	reportOrder []string
+	mu          sync.RWMutex
 }
 
 // NewTransparencyOracleService creates a new transparency oracle service
 func NewTransparencyOracleService(bk BankKeeper) *TransparencyOracleService {
 	return &TransparencyOracleService{
 		bankKeeper: bk,
+		reports:    make(map[string]*TransparencyReport),
 	}
 }
 
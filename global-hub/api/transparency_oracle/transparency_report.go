@@ -0,0 +1,310 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Verifiable Transparency Report
+//
+// Bundles every public metric (Four Pillars balances, supply status, burn
+// totals, dividend distribution summary, proof-of-reserves) into a single
+// snapshot at a block height, signed with the hub's key so journalists and
+// watchdogs can verify it and compare it against earlier reports.
+
+package transparency_oracle
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/google/uuid"
+	"github.com/sovrn-protocol/sovrn/chain/economics"
+	minttypes "github.com/sovrn-protocol/sovrn/x/mint/types"
+)
+
+// TransparencyReport is a signed snapshot of all public metrics at a block height
+type TransparencyReport struct {
+	ReportID         string               `json:"report_id"`
+	PreviousReportID string               `json:"previous_report_id,omitempty"` // For historical comparison
+	BlockHeight      int64                `json:"block_height"`
+	ChainID          string               `json:"chain_id"`
+	GeneratedAt      time.Time            `json:"generated_at"`
+	Pillars          FourPillarsResponse  `json:"pillars"`
+	Supply           SupplySnapshot       `json:"supply"`
+	Dividends        *DividendSummary     `json:"dividends,omitempty"`
+	Reserves         *ProofOfReserves     `json:"reserves,omitempty"`
+	Reconciliation   ReportReconciliation `json:"reconciliation"`
+
+	// Ed25519 signature over the report with Signature cleared
+	Signature       string `json:"signature"`
+	SignerPublicKey string `json:"signer_public_key"`
+}
+
+// SupplySnapshot captures supply status and burn totals
+type SupplySnapshot struct {
+	CirculatingSupplyUSOV string `json:"circulating_supply_usov"`
+	MaxTotalSupplyUSOV    string `json:"max_total_supply_usov"`
+	TotalBurnedUSOV       string `json:"total_burned_usov"`
+	CurrentBurnRate       string `json:"current_burn_rate"`
+	PercentOfMax          int64  `json:"percent_of_max"`
+	IsAboveThreshold      bool   `json:"is_above_threshold"`
+}
+
+// DividendSummary summarizes citizen dividend distributions
+type DividendSummary struct {
+	TotalDistributedUSOV int64     `json:"total_distributed_usov"`
+	DistributionCount    int       `json:"distribution_count"`
+	LastRecipientCount   int       `json:"last_recipient_count"`
+	LastDistributedAt    time.Time `json:"last_distributed_at"`
+}
+
+// ProofOfReserves compares custodied reserves against off-chain liabilities
+type ProofOfReserves struct {
+	ReservesUSOV    int64  `json:"reserves_usov"`
+	LiabilitiesUSOV int64  `json:"liabilities_usov"`
+	Covered         bool   `json:"covered"`
+	Attestation     string `json:"attestation,omitempty"` // Source-specific attestation reference
+}
+
+// ReportReconciliation records whether the report's figures tie out internally
+type ReportReconciliation struct {
+	PillarHoldingsUSOV string   `json:"pillar_holdings_usov"` // Citizen + R&D + Infrastructure
+	BurnedUSOV         string   `json:"burned_usov"`
+	CirculatingUSOV    string   `json:"circulating_usov"`
+	Reconciles         bool     `json:"reconciles"`
+	Discrepancies      []string `json:"discrepancies,omitempty"`
+}
+
+// MintKeeper defines the expected mint keeper interface for supply figures
+type MintKeeper interface {
+	GetSupplyStatus(ctx sdk.Context) minttypes.SupplyStatus
+	GetBlackHoleBalance(ctx sdk.Context) sdk.Int
+}
+
+// DividendSummarySource provides the dividend distribution summary
+type DividendSummarySource interface {
+	GetDividendSummary(ctx context.Context) (*DividendSummary, error)
+}
+
+// ReserveSource provides proof-of-reserves figures
+type ReserveSource interface {
+	GetProofOfReserves(ctx context.Context) (*ProofOfReserves, error)
+}
+
+// SetMintKeeper enables supply and burn figures in transparency reports
+func (tos *TransparencyOracleService) SetMintKeeper(mk MintKeeper) {
+	tos.mu.Lock()
+	defer tos.mu.Unlock()
+
+	tos.mintKeeper = mk
+}
+
+// SetDividendSource enables the dividend summary in transparency reports
+func (tos *TransparencyOracleService) SetDividendSource(source DividendSummarySource) {
+	tos.mu.Lock()
+	defer tos.mu.Unlock()
+
+	tos.dividends = source
+}
+
+// SetReserveSource enables proof-of-reserves in transparency reports
+func (tos *TransparencyOracleService) SetReserveSource(source ReserveSource) {
+	tos.mu.Lock()
+	defer tos.mu.Unlock()
+
+	tos.reserves = source
+}
+
+// SetReportSigningKey sets the hub key used to sign transparency reports
+func (tos *TransparencyOracleService) SetReportSigningKey(key ed25519.PrivateKey) error {
+	if len(key) != ed25519.PrivateKeySize {
+		return fmt.Errorf("signing key must be %d bytes, got %d", ed25519.PrivateKeySize, len(key))
+	}
+
+	tos.mu.Lock()
+	defer tos.mu.Unlock()
+
+	tos.signingKey = key
+	return nil
+}
+
+// GenerateTransparencyReport assembles, reconciles, signs and stores a report at the current block
+//
+// REPORT LOGIC:
+// 1. Snapshot Four Pillars balances and supply status at the block height
+// 2. Attach dividend summary and proof-of-reserves (if sources are configured)
+// 3. Reconcile: burn pillar matches mint burn total, pillar holdings within circulating supply
+// 4. Sign with the hub key and store, linked to the previous report
+func (tos *TransparencyOracleService) GenerateTransparencyReport(ctx sdk.Context) (*TransparencyReport, error) {
+	tos.mu.Lock()
+	defer tos.mu.Unlock()
+
+	if tos.signingKey == nil {
+		return nil, fmt.Errorf("report signing key not configured")
+	}
+
+	if tos.mintKeeper == nil {
+		return nil, fmt.Errorf("mint keeper not configured")
+	}
+
+	goCtx := ctx.Context()
+
+	// 1. Balances and supply
+	report := &TransparencyReport{
+		ReportID:    uuid.New().String(),
+		BlockHeight: ctx.BlockHeight(),
+		ChainID:     ctx.ChainID(),
+		GeneratedAt: time.Now().UTC(),
+		Pillars:     tos.GetFourPillarsBalances(ctx),
+	}
+
+	if len(tos.reportOrder) > 0 {
+		report.PreviousReportID = tos.reportOrder[len(tos.reportOrder)-1]
+	}
+
+	status := tos.mintKeeper.GetSupplyStatus(ctx)
+	burned := tos.mintKeeper.GetBlackHoleBalance(ctx)
+
+	report.Supply = SupplySnapshot{
+		CirculatingSupplyUSOV: status.CirculatingSupply.String(),
+		MaxTotalSupplyUSOV:    status.MaxTotalSupply.String(),
+		TotalBurnedUSOV:       burned.String(),
+		CurrentBurnRate:       status.CurrentBurnRate.String(),
+		PercentOfMax:          status.PercentOfMax,
+		IsAboveThreshold:      status.IsAboveThreshold,
+	}
+
+	// 2. Optional sources
+	if tos.dividends != nil {
+		summary, err := tos.dividends.GetDividendSummary(goCtx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load dividend summary: %w", err)
+		}
+		report.Dividends = summary
+	}
+
+	if tos.reserves != nil {
+		reserves, err := tos.reserves.GetProofOfReserves(goCtx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load proof of reserves: %w", err)
+		}
+		reserves.Covered = reserves.ReservesUSOV >= reserves.LiabilitiesUSOV
+		report.Reserves = reserves
+	}
+
+	// 3. Reconcile
+	report.Reconciliation = tos.reconcileReport(ctx, status.CirculatingSupply, burned)
+
+	// 4. Sign and store
+	if err := signReport(report, tos.signingKey); err != nil {
+		return nil, err
+	}
+
+	tos.reports[report.ReportID] = report
+	tos.reportOrder = append(tos.reportOrder, report.ReportID)
+
+	return report, nil
+}
+
+// GetTransparencyReport retrieves a previously generated report
+func (tos *TransparencyOracleService) GetTransparencyReport(reportID string) (*TransparencyReport, error) {
+	tos.mu.RLock()
+	defer tos.mu.RUnlock()
+
+	report, exists := tos.reports[reportID]
+	if !exists {
+		return nil, fmt.Errorf("transparency report not found: %s", reportID)
+	}
+
+	return report, nil
+}
+
+// ListTransparencyReports returns all report IDs, oldest first
+func (tos *TransparencyOracleService) ListTransparencyReports() []string {
+	tos.mu.RLock()
+	defer tos.mu.RUnlock()
+
+	ids := make([]string, len(tos.reportOrder))
+	copy(ids, tos.reportOrder)
+	return ids
+}
+
+// reconcileReport checks that the report's figures tie out (caller holds tos.mu)
+func (tos *TransparencyOracleService) reconcileReport(ctx sdk.Context, circulating sdk.Int, burned sdk.Int) ReportReconciliation {
+	citizen := tos.bankKeeper.GetBalance(ctx, tos.bankKeeper.GetModuleAddress(economics.CitizenDividendPool), "usov").Amount
+	rnd := tos.bankKeeper.GetBalance(ctx, tos.bankKeeper.GetModuleAddress(economics.ProjectRnDVault), "usov").Amount
+	infra := tos.bankKeeper.GetBalance(ctx, tos.bankKeeper.GetModuleAddress(economics.NationInfrastructurePool), "usov").Amount
+
+	blackHoleAddr, _ := sdk.AccAddressFromBech32(minttypes.BLACK_HOLE_ADDRESS)
+	burnPillar := tos.bankKeeper.GetBalance(ctx, blackHoleAddr, "usov").Amount
+
+	holdings := citizen.Add(rnd).Add(infra)
+
+	reconciliation := ReportReconciliation{
+		PillarHoldingsUSOV: holdings.String(),
+		BurnedUSOV:         burned.String(),
+		CirculatingUSOV:    circulating.String(),
+		Discrepancies:      make([]string, 0),
+	}
+
+	// Burn pillar balance must match the mint module's burn total
+	if !burnPillar.Sub(burned).IsZero() {
+		reconciliation.Discrepancies = append(reconciliation.Discrepancies,
+			fmt.Sprintf("burn pillar balance %s does not match burned total %s", burnPillar.String(), burned.String()))
+	}
+
+	// Pillar holdings are part of circulating supply, so cannot exceed it
+	if holdings.GT(circulating) {
+		reconciliation.Discrepancies = append(reconciliation.Discrepancies,
+			fmt.Sprintf("pillar holdings %s exceed circulating supply %s", holdings.String(), circulating.String()))
+	}
+
+	reconciliation.Reconciles = len(reconciliation.Discrepancies) == 0
+
+	return reconciliation
+}
+
+// VerifyTransparencyReport verifies a report's signature against the hub's public key
+func VerifyTransparencyReport(report *TransparencyReport, publicKey ed25519.PublicKey) error {
+	signature, err := base64.StdEncoding.DecodeString(report.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+
+	payload, err := reportSigningPayload(report)
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(publicKey, payload, signature) {
+		return fmt.Errorf("transparency report signature invalid: %s", report.ReportID)
+	}
+
+	return nil
+}
+
+// signReport signs the report with the hub key
+func signReport(report *TransparencyReport, key ed25519.PrivateKey) error {
+	report.SignerPublicKey = base64.StdEncoding.EncodeToString(key.Public().(ed25519.PublicKey))
+
+	payload, err := reportSigningPayload(report)
+	if err != nil {
+		return err
+	}
+
+	report.Signature = base64.StdEncoding.EncodeToString(ed25519.Sign(key, payload))
+	return nil
+}
+
+// reportSigningPayload returns the canonical bytes that are signed (report with Signature cleared)
+func reportSigningPayload(report *TransparencyReport) ([]byte, error) {
+	unsigned := *report
+	unsigned.Signature = ""
+
+	payload, err := json.Marshal(&unsigned)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode report: %w", err)
+	}
+
+	return payload, nil
+}
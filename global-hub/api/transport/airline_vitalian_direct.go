@@ -13,6 +13,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/audit"
+	"github.com/sovrn-protocol/sovrn/hub/api/guard"
+	"github.com/sovrn-protocol/sovrn/hub/api/pricing"
+	"github.com/sovrn-protocol/sovrn/hub/api/waiver"
 )
 
 // Certified_Airline_Carrier represents a certified airline entity
@@ -31,18 +36,33 @@ type CertifiedAirlineCarrier struct {
 }
 
 // TicketPFFLink represents the link between an airline ticket and a Vitalian DID
+// FlightNumber, Origin and BoardingTime describe the first leg and Destination
+// the final one; Legs holds the full itinerary (a single leg for direct flights).
 type TicketPFFLink struct {
-	LinkID       string    // Unique link ID
-	TicketID     string    // Airline ticket ID (PNR or booking reference)
-	VitalianDID  string    // Vitalian DID (e.g., "did:sovra:ng:12345")
-	CarrierID    string    // Airline carrier ID
+	LinkID       string      // Unique link ID
+	TicketID     string      // Airline ticket ID (PNR or booking reference)
+	VitalianDID  string      // Vitalian DID (e.g., "did:sovra:ng:12345")
+	CarrierID    string      // Airline carrier ID
+	FlightNumber string      // Flight number (e.g., "AA123")
+	Origin       string      // Origin airport IATA code
+	Destination  string      // Destination airport IATA code
+	BoardingTime time.Time   // Scheduled boarding time
+	Legs         []FlightLeg // Itinerary legs in travel order
+	LegsBoarded  int         // Legs boarded so far (index of the next leg to scan)
+	Status       string      // Status: "linked", "boarded", "cancelled", "no_show"
+	CloseReason  string      // Why the link was cancelled or marked no-show
+	CreatedAt    time.Time   // Link creation timestamp
+	UpdatedAt    time.Time   // Last update timestamp
+}
+
+// FlightLeg is one flight of a ticket's itinerary
+type FlightLeg struct {
 	FlightNumber string    // Flight number (e.g., "AA123")
 	Origin       string    // Origin airport IATA code
 	Destination  string    // Destination airport IATA code
 	BoardingTime time.Time // Scheduled boarding time
-	Status       string    // Status: "linked", "boarded", "cancelled"
-	CreatedAt    time.Time // Link creation timestamp
-	UpdatedAt    time.Time // Last update timestamp
+	Boarded      bool      // True once the leg's gate scan has been processed
+	BoardedAt    time.Time // Boarding scan time (zero until boarded)
 }
 
 // BoardingEvent represents a PFF scan at boarding gate
@@ -52,13 +72,16 @@ type BoardingEvent struct {
 	VitalianDID      string    // Vitalian DID
 	CarrierID        string    // Airline carrier ID
 	FlightNumber     string    // Flight number
+	LegIndex         int       // Itinerary leg boarded (0 for direct flights)
 	PFFHash          string    // Hash of the PFF verification
-	WalletCheckResult string   // "vitalian_funded" or "vitalian_empty"
-	PaymentMethod    string    // "vitalian_wallet" or "airline_vault"
-	FeeAmount        int64     // Fee amount in uSOV
+	WalletCheckResult string   // "vitalian_funded", "vitalian_empty" or "fee_waived"
+	PaymentMethod    string    // "vitalian_wallet", "airline_vault" or "fee_waiver"
+	FeeAmount        int64     // Fee amount in uSOV (0 when waived)
+	WaiverReason     string    // Fee waiver applied (empty if charged)
 	TransactionID    string    // Payment transaction ID
 	IntegrityScore   int       // Updated integrity score
 	Timestamp        time.Time // Boarding timestamp
+	Simulated        bool      // True if produced by SimulateBoardingScan (no debit occurred)
 }
 
 // BoardingReceipt represents the confirmation sent to the Vitalian
@@ -78,6 +101,7 @@ type BoardingReceipt struct {
 type VaultManager interface {
 	GetVault(ctx context.Context, userID string) (*SovereignVault, error)
 	DebitVault(ctx context.Context, userID string, amount int64, purpose string, pffHash string) (string, error)
+	RecordExemptTransaction(ctx context.Context, userID string, purpose string, pffHash string, waiverReason string) (string, error)
 }
 
 // SovereignVault represents a user's wallet
@@ -108,6 +132,20 @@ type AirlineVitalianDirect struct {
 	carriers            map[string]*CertifiedAirlineCarrier // In-memory storage (use DB in production)
 	ticketLinks         map[string]*TicketPFFLink           // In-memory storage (use DB in production)
 	boardingEvents      map[string]*BoardingEvent           // In-memory storage (use DB in production)
+	auditLog            *audit.EscrowAuditLog               // Optional hash-chained escrow audit log
+	paymentGuard        *guard.PaymentGuard                 // Optional kill-switch checked before boarding debits
+	integrityDecay      *IntegrityDecayPolicy               // Optional score decay over inactivity
+	lastActivity        map[string]time.Time                // vitalianDID -> last verification
+	flightTickets       map[string][]string                 // Flight index: carrierID|flightNumber -> ticket IDs
+	flightEvents        map[string][]string                 // Flight index: carrierID|flightNumber -> boarding event IDs
+	flightBudgets       map[string]*FlightBudget            // Reservations: carrierID|flightNumber -> budget
+	integrityStore      IntegrityScoreStore                 // Persisted time-weighted integrity scores
+	integrityHalfLife   time.Duration                       // Time for a boarding's score contribution to halve
+	eventPublisher      EventPublisher                      // Downstream BoardingProcessed subscribers
+	linkGracePeriod     time.Duration                       // Time after boarding before an unscanned link is swept
+	feeWaivers          *waiver.FeeWaiverRegistry           // Optional DID/program fee exemptions
+	pricing             *pricing.PricingService             // Boarding fee schedule
+	proxyDebits         map[string]*ProxyDebit              // Carrier debits by ticket|leg (retries reuse them)
 }
 
 // NewAirlineVitalianDirect creates a new airline boarding handshake instance
@@ -123,14 +161,57 @@ func NewAirlineVitalianDirect(
 		carriers:            make(map[string]*CertifiedAirlineCarrier),
 		ticketLinks:         make(map[string]*TicketPFFLink),
 		boardingEvents:      make(map[string]*BoardingEvent),
+		lastActivity:        make(map[string]time.Time),
+		flightTickets:       make(map[string][]string),
+		flightEvents:        make(map[string][]string),
+		flightBudgets:       make(map[string]*FlightBudget),
+		integrityStore:      NewMemoryIntegrityScoreStore(),
+		integrityHalfLife:   DefaultIntegrityHalfLife,
+		eventPublisher:      NewChannelEventPublisher(DefaultEventBufferSize),
+		linkGracePeriod:     DefaultTicketLinkGracePeriod,
+		pricing:             pricing.NewPricingService(),
+		proxyDebits:         make(map[string]*ProxyDebit),
 	}
 }
 
+// SetAuditLog attaches a hash-chained audit log that records airline vault proxy debits
+func (avd *AirlineVitalianDirect) SetAuditLog(auditLog *audit.EscrowAuditLog) {
+	avd.auditLog = auditLog
+}
+
+// SetPaymentGuard attaches the kill-switch that can halt boarding proxy debits
+func (avd *AirlineVitalianDirect) SetPaymentGuard(paymentGuard *guard.PaymentGuard) {
+	avd.paymentGuard = paymentGuard
+}
+
+// SetFeeWaivers sets the registry of DIDs and programs exempt from boarding fees
+func (avd *AirlineVitalianDirect) SetFeeWaivers(registry *waiver.FeeWaiverRegistry) {
+	avd.feeWaivers = registry
+}
+
+// SetPricingService shares the hub-wide fee schedule boarding fees are resolved from
+func (avd *AirlineVitalianDirect) SetPricingService(pricingService *pricing.PricingService) {
+	avd.pricing = pricingService
+}
+
+// boardingFee resolves the boarding fee from the pricing service
+func (avd *AirlineVitalianDirect) boardingFee() (int64, error) {
+	feeAmount, err := avd.pricing.BoardingFee(pricing.BoardingStandard)
+	if err != nil {
+		return 0, fmt.Errorf("failed to resolve boarding fee: %w", err)
+	}
+	return feeAmount, nil
+}
+
 // RegisterCertifiedAirlineCarrier registers a new certified airline carrier
 func (avd *AirlineVitalianDirect) RegisterCertifiedAirlineCarrier(
 	ctx context.Context,
 	carrier *CertifiedAirlineCarrier,
 ) error {
+	if err := validateCarrier(carrier); err != nil {
+		return err
+	}
+
 	if carrier.CarrierID == "" {
 		carrier.CarrierID = fmt.Sprintf("airline:%s", carrier.IATA)
 	}
@@ -157,6 +238,72 @@ func (avd *AirlineVitalianDirect) RegisterCertifiedAirlineCarrier(
 	return nil
 }
 
+// CarrierRegistrationResult represents the outcome of registering one carrier in a batch
+type CarrierRegistrationResult struct {
+	Index     int    // Position in the submitted batch
+	CarrierID string // Assigned carrier ID (empty if validation failed early)
+	IATA      string // IATA code as submitted
+	VaultID   string // Carrier's Sovereign Vault ID
+	Success   bool   // True if the carrier was registered
+	Error     string // Failure reason
+}
+
+// RegisterCarriers registers a batch of certified airline carriers (e.g., parent + subsidiaries)
+// Each carrier is validated and registered independently - one bad entry does not fail the batch
+func (avd *AirlineVitalianDirect) RegisterCarriers(
+	ctx context.Context,
+	carriers []*CertifiedAirlineCarrier,
+) []*CarrierRegistrationResult {
+	results := make([]*CarrierRegistrationResult, 0, len(carriers))
+	seen := make(map[string]bool)
+
+	for i, carrier := range carriers {
+		result := &CarrierRegistrationResult{Index: i}
+		results = append(results, result)
+
+		if carrier == nil {
+			result.Error = "carrier is nil"
+			continue
+		}
+		result.IATA = carrier.IATA
+
+		// Reject duplicates within the same batch
+		carrierID := carrier.CarrierID
+		if carrierID == "" && carrier.IATA != "" {
+			carrierID = fmt.Sprintf("airline:%s", carrier.IATA)
+		}
+		if carrierID != "" && seen[carrierID] {
+			result.CarrierID = carrierID
+			result.Error = fmt.Sprintf("duplicate carrier %s in batch", carrierID)
+			continue
+		}
+
+		// Register carrier (validates and creates vault)
+		if err := avd.RegisterCertifiedAirlineCarrier(ctx, carrier); err != nil {
+			result.CarrierID = carrierID
+			result.Error = err.Error()
+			continue
+		}
+
+		seen[carrier.CarrierID] = true
+		result.CarrierID = carrier.CarrierID
+		result.VaultID = carrier.VaultID
+		result.Success = true
+	}
+
+	return results
+}
+
+// validateCarrier checks the required fields of a carrier registration
+// CarrierName is optional; receipts show an empty name when it is not set.
+func validateCarrier(carrier *CertifiedAirlineCarrier) error {
+	if carrier.IATA == "" {
+		return fmt.Errorf("IATA code is required")
+	}
+
+	return nil
+}
+
 // LinkTicketToPFF links an airline ticket to a Vitalian DID
 // This is called during check-in or booking to establish the ticket-DID relationship
 func (avd *AirlineVitalianDirect) LinkTicketToPFF(
@@ -168,6 +315,24 @@ func (avd *AirlineVitalianDirect) LinkTicketToPFF(
 	origin string,
 	destination string,
 	boardingTime time.Time,
+) (*TicketPFFLink, error) {
+	return avd.LinkItineraryToPFF(ctx, ticketID, vitalianDID, carrierID, []FlightLeg{{
+		FlightNumber: flightNumber,
+		Origin:       origin,
+		Destination:  destination,
+		BoardingTime: boardingTime,
+	}})
+}
+
+// LinkItineraryToPFF links a multi-leg airline ticket to a Vitalian DID
+// Each leg is boarded with its own gate scan, in order; the ticket is only
+// marked boarded once the final leg has been scanned.
+func (avd *AirlineVitalianDirect) LinkItineraryToPFF(
+	ctx context.Context,
+	ticketID string,
+	vitalianDID string,
+	carrierID string,
+	legs []FlightLeg,
 ) (*TicketPFFLink, error) {
 	// Validate carrier exists
 	carrier, exists := avd.carriers[carrierID]
@@ -179,16 +344,39 @@ func (avd *AirlineVitalianDirect) LinkTicketToPFF(
 		return nil, fmt.Errorf("carrier %s is not active", carrierID)
 	}
 
+	if len(legs) == 0 {
+		return nil, fmt.Errorf("itinerary for ticket %s has no legs", ticketID)
+	}
+
+	itinerary := make([]FlightLeg, len(legs))
+	for i, leg := range legs {
+		if leg.FlightNumber == "" {
+			return nil, fmt.Errorf("leg %d of ticket %s has no flight number", i, ticketID)
+		}
+		if i > 0 && leg.Origin != legs[i-1].Destination {
+			return nil, fmt.Errorf("leg %d of ticket %s departs %s but leg %d arrives at %s", i, ticketID, leg.Origin, i-1, legs[i-1].Destination)
+		}
+		itinerary[i] = FlightLeg{
+			FlightNumber: leg.FlightNumber,
+			Origin:       leg.Origin,
+			Destination:  leg.Destination,
+			BoardingTime: leg.BoardingTime,
+		}
+	}
+	first := itinerary[0]
+	last := itinerary[len(itinerary)-1]
+
 	// Create ticket link
 	link := &TicketPFFLink{
 		LinkID:       uuid.New().String(),
 		TicketID:     ticketID,
 		VitalianDID:  vitalianDID,
 		CarrierID:    carrierID,
-		FlightNumber: flightNumber,
-		Origin:       origin,
-		Destination:  destination,
-		BoardingTime: boardingTime,
+		FlightNumber: first.FlightNumber,
+		Origin:       first.Origin,
+		Destination:  last.Destination,
+		BoardingTime: first.BoardingTime,
+		Legs:         itinerary,
 		Status:       "linked",
 		CreatedAt:    time.Now(),
 		UpdatedAt:    time.Now(),
@@ -196,65 +384,121 @@ func (avd *AirlineVitalianDirect) LinkTicketToPFF(
 
 	// Store link
 	avd.ticketLinks[ticketID] = link
+	avd.indexTicket(link)
 
 	return link, nil
 }
 
 // ProcessBoardingScan handles PFF scan at boarding gate with conditional wallet logic
-// This is the "Boarding_Trigger" that checks Vitalian wallet and conditionally debits
+// This is the "Boarding_Trigger" that checks Vitalian wallet and conditionally debits.
+// legIndex selects the itinerary leg being boarded (0 for direct flights) and
+// must be the next unboarded leg.
 func (avd *AirlineVitalianDirect) ProcessBoardingScan(
 	ctx sdk.Context,
 	ticketID string,
+	legIndex int,
 	pffHash string,
-	feeAmount int64,
 ) (*BoardingEvent, error) {
-	// 1. Get ticket link
-	link, exists := avd.ticketLinks[ticketID]
-	if !exists {
-		return nil, fmt.Errorf("ticket %s not linked to any Vitalian DID", ticketID)
+	// Vault, store and receipt calls run under the caller's deadline and cancellation
+	goCtx := ctx.Context()
+
+	feeAmount, err := avd.boardingFee()
+	if err != nil {
+		return nil, err
 	}
 
-	if link.Status != "linked" {
-		return nil, fmt.Errorf("ticket %s status is %s, expected 'linked'", ticketID, link.Status)
+	// 1-3. Resolve ticket link, leg, carrier and Vitalian vault
+	link, leg, carrier, vitalianVault, err := avd.resolveBoardingScan(goCtx, ticketID, legIndex)
+	if err != nil {
+		return nil, err
 	}
 
-	// 2. Get carrier
-	carrier, exists := avd.carriers[link.CarrierID]
-	if !exists {
-		return nil, fmt.Errorf("carrier %s not found", link.CarrierID)
+	// Fee resolution: waived Vitalians board without a charge
+	var feeWaiver *waiver.FeeWaiver
+	if avd.feeWaivers != nil {
+		feeWaiver, _ = avd.feeWaivers.Lookup(link.VitalianDID)
 	}
 
-	// 3. Check Vitalian wallet balance
-	vitalianVault, err := avd.vaultMgr.GetVault(context.Background(), link.VitalianDID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get Vitalian vault: %w", err)
+	// Refuse to debit either vault while the payment kill-switch is active or above the fee ceiling
+	if avd.paymentGuard != nil && feeWaiver == nil {
+		if err := avd.paymentGuard.Authorize(feeAmount); err != nil {
+			return nil, err
+		}
 	}
 
 	var walletCheckResult string
 	var paymentMethod string
+	var waiverReason string
 	var txID string
 
-	// 4. Conditional wallet logic: If Empty -> Airline pays, If Funded -> Vitalian pays
-	if vitalianVault.Balance < feeAmount {
+	// 4. Conditional wallet logic: If Waived -> Exempt, If Empty -> Airline pays, If Funded -> Vitalian pays
+	if feeWaiver != nil {
+		// FEE WAIVED -> RECORD EXEMPT TRANSACTION (no debit, no split)
+		walletCheckResult = "fee_waived"
+		paymentMethod = "fee_waiver"
+		waiverReason = feeWaiver.Reason
+		feeAmount = 0
+
+		txID, err = avd.vaultMgr.RecordExemptTransaction(
+			goCtx,
+			link.VitalianDID,
+			fmt.Sprintf("Boarding fee waived - Flight %s", leg.FlightNumber),
+			pffHash,
+			waiverReason,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record exempt boarding: %w", err)
+		}
+	} else if priorDebit, debited := avd.GetProxyDebit(ticketID, legIndex); debited {
+		// RETRY AFTER A SUCCESSFUL AIRLINE_VAULT_DEBIT -> REUSE IT (the carrier is charged once per ticket leg)
+		walletCheckResult = "vitalian_empty"
+		paymentMethod = "airline_vault"
+		feeAmount = priorDebit.Amount
+		txID = priorDebit.TransactionID
+	} else if vitalianVault.Balance < feeAmount {
 		// VITALIAN WALLET IS EMPTY -> TRIGGER AIRLINE_VAULT_DEBIT
 		walletCheckResult = "vitalian_empty"
 		paymentMethod = "airline_vault"
 
+		// Fail before debiting if the flight's reservation cannot cover the fee
+		if err := avd.checkFlightBudget(goCtx, carrier, leg.FlightNumber, feeAmount); err != nil {
+			return nil, err
+		}
+
 		// Debit airline vault
 		txID, err = avd.vaultMgr.DebitVault(
-			context.Background(),
+			goCtx,
 			carrier.VaultID,
 			feeAmount,
-			fmt.Sprintf("Proxy payment for boarding - Flight %s, Ticket %s", link.FlightNumber, ticketID),
+			fmt.Sprintf("Proxy payment for boarding - Flight %s, Ticket %s", leg.FlightNumber, ticketID),
 			pffHash,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to debit airline vault: %w", err)
 		}
 
-		// Update carrier balance
+		// Remember the debit before anything else can fail, so a retried scan does not debit again
+		avd.recordProxyDebit(&ProxyDebit{
+			TicketID:      ticketID,
+			LegIndex:      legIndex,
+			CarrierID:     carrier.CarrierID,
+			VaultID:       carrier.VaultID,
+			Amount:        feeAmount,
+			TransactionID: txID,
+			DebitedAt:     time.Now(),
+		})
+
+		// Update carrier balance and draw down the flight reservation
 		carrier.VaultBalance -= feeAmount
 		carrier.UpdatedAt = time.Now()
+		avd.consumeFlightBudget(carrier.CarrierID, leg.FlightNumber, feeAmount)
+
+		// Audit release of pre-funded airline vault
+		if avd.auditLog != nil {
+			if _, err := avd.auditLog.Record(audit.SourceAirlineProxy, audit.EscrowActionRelease, ticketID, carrier.VaultID, feeAmount, txID); err != nil {
+				fmt.Printf("Warning: failed to record escrow audit event: %v\n", err)
+			}
+		}
 	} else {
 		// VITALIAN WALLET IS FUNDED -> TRIGGER VITALIAN_WALLET_DEBIT
 		walletCheckResult = "vitalian_funded"
@@ -262,10 +506,10 @@ func (avd *AirlineVitalianDirect) ProcessBoardingScan(
 
 		// Debit Vitalian wallet
 		txID, err = avd.vaultMgr.DebitVault(
-			context.Background(),
+			goCtx,
 			link.VitalianDID,
 			feeAmount,
-			fmt.Sprintf("Boarding fee - Flight %s", link.FlightNumber),
+			fmt.Sprintf("Boarding fee - Flight %s", leg.FlightNumber),
 			pffHash,
 		)
 		if err != nil {
@@ -273,14 +517,22 @@ func (avd *AirlineVitalianDirect) ProcessBoardingScan(
 		}
 	}
 
-	// 5. Trigger ExecuteFourWaySplit (25/25/25/25 distribution)
-	feeCoins := sdk.NewCoins(sdk.NewInt64Coin("usov", feeAmount))
-	err = avd.economicsKernel.ExecuteFourWaySplit(ctx, feeCoins, "fee_collector")
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute four-way split: %w", err)
+	// 5. Trigger ExecuteFourWaySplit (25/25/25/25 distribution) - nothing to split for waived fees
+	if feeAmount > 0 {
+		feeCoins := sdk.NewCoins(sdk.NewInt64Coin("usov", feeAmount))
+		err = avd.economicsKernel.ExecuteFourWaySplit(ctx, feeCoins, "fee_collector")
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute four-way split: %w", err)
+		}
 	}
 
-	// 6. Calculate integrity score (simple implementation - can be enhanced)
+	// 6. Update the stored integrity score (this scan counts as activity for decay)
+	boardedAt := time.Now()
+	avd.RecordActivity(link.VitalianDID, boardedAt)
+	if err := avd.recordBoardingScore(goCtx, link.VitalianDID, boardedAt); err != nil {
+		// Payment already settled; log rather than fail the boarding
+		fmt.Printf("Warning: %v\n", err)
+	}
 	integrityScore := avd.calculateIntegrityScore(link.VitalianDID)
 
 	// 7. Create boarding event
@@ -289,29 +541,37 @@ func (avd *AirlineVitalianDirect) ProcessBoardingScan(
 		TicketID:          ticketID,
 		VitalianDID:       link.VitalianDID,
 		CarrierID:         link.CarrierID,
-		FlightNumber:      link.FlightNumber,
+		FlightNumber:      leg.FlightNumber,
+		LegIndex:          legIndex,
 		PFFHash:           pffHash,
 		WalletCheckResult: walletCheckResult,
 		PaymentMethod:     paymentMethod,
 		FeeAmount:         feeAmount,
+		WaiverReason:      waiverReason,
 		TransactionID:     txID,
 		IntegrityScore:    integrityScore,
-		Timestamp:         time.Now(),
+		Timestamp:         boardedAt,
 	}
 
 	// Store boarding event
 	avd.boardingEvents[event.EventID] = event
-
-	// Update ticket link status
-	link.Status = "boarded"
+	avd.indexBoardingEvent(event)
+
+	// Advance the itinerary; the ticket is boarded once the final leg is scanned
+	leg.Boarded = true
+	leg.BoardedAt = boardedAt
+	link.LegsBoarded = legIndex + 1
+	if link.LegsBoarded == len(link.Legs) {
+		link.Status = "boarded"
+	}
 	link.UpdatedAt = time.Now()
 
 	// 8. Send receipt to Vitalian
 	err = avd.SendBoardingReceipt(
-		context.Background(),
+		goCtx,
 		link.VitalianDID,
 		carrier.CarrierName,
-		link.FlightNumber,
+		leg.FlightNumber,
 		paymentMethod,
 		feeAmount,
 		integrityScore,
@@ -321,9 +581,102 @@ func (avd *AirlineVitalianDirect) ProcessBoardingScan(
 		fmt.Printf("Warning: failed to send boarding receipt: %v\n", err)
 	}
 
+	// 9. Publish the boarding to downstream subscribers
+	avd.publishBoardingProcessed(goCtx, event)
+
 	return event, nil
 }
 
+// SimulateBoardingScan runs the boarding handshake without moving funds
+// Partners use it as a sandbox integration target: the wallet check and
+// payment method are resolved exactly as in ProcessBoardingScan, but no vault
+// is debited, no split is executed, no receipt is sent and the itinerary does not advance.
+func (avd *AirlineVitalianDirect) SimulateBoardingScan(
+	ctx sdk.Context,
+	ticketID string,
+	legIndex int,
+	pffHash string,
+) (*BoardingEvent, error) {
+	feeAmount, err := avd.boardingFee()
+	if err != nil {
+		return nil, err
+	}
+
+	link, leg, _, vitalianVault, err := avd.resolveBoardingScan(ctx.Context(), ticketID, legIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	walletCheckResult := "vitalian_funded"
+	paymentMethod := "vitalian_wallet"
+	waiverReason := ""
+	if avd.feeWaivers != nil {
+		if feeWaiver, waived := avd.feeWaivers.Lookup(link.VitalianDID); waived {
+			walletCheckResult = "fee_waived"
+			paymentMethod = "fee_waiver"
+			waiverReason = feeWaiver.Reason
+			feeAmount = 0
+		}
+	}
+	if waiverReason == "" && vitalianVault.Balance < feeAmount {
+		walletCheckResult = "vitalian_empty"
+		paymentMethod = "airline_vault"
+	}
+
+	return &BoardingEvent{
+		EventID:           uuid.New().String(),
+		TicketID:          ticketID,
+		VitalianDID:       link.VitalianDID,
+		CarrierID:         link.CarrierID,
+		FlightNumber:      leg.FlightNumber,
+		LegIndex:          legIndex,
+		PFFHash:           pffHash,
+		WalletCheckResult: walletCheckResult,
+		PaymentMethod:     paymentMethod,
+		FeeAmount:         feeAmount, // Would-be charge
+		WaiverReason:      waiverReason,
+		IntegrityScore:    avd.calculateIntegrityScore(link.VitalianDID),
+		Timestamp:         time.Now(),
+		Simulated:         true,
+	}, nil
+}
+
+// resolveBoardingScan loads the ticket link, leg, carrier and Vitalian vault for a boarding scan
+func (avd *AirlineVitalianDirect) resolveBoardingScan(ctx context.Context, ticketID string, legIndex int) (*TicketPFFLink, *FlightLeg, *CertifiedAirlineCarrier, *SovereignVault, error) {
+	// 1. Get ticket link
+	link, exists := avd.ticketLinks[ticketID]
+	if !exists {
+		return nil, nil, nil, nil, fmt.Errorf("ticket %s not linked to any Vitalian DID", ticketID)
+	}
+
+	if link.Status != "linked" {
+		return nil, nil, nil, nil, fmt.Errorf("ticket %s status is %s, expected 'linked'", ticketID, link.Status)
+	}
+
+	// Legs are boarded in order, one scan each
+	if legIndex < 0 || legIndex >= len(link.Legs) {
+		return nil, nil, nil, nil, fmt.Errorf("ticket %s has no leg %d (itinerary has %d legs)", ticketID, legIndex, len(link.Legs))
+	}
+	if legIndex != link.LegsBoarded {
+		return nil, nil, nil, nil, fmt.Errorf("ticket %s leg %d scanned out of order, expected leg %d", ticketID, legIndex, link.LegsBoarded)
+	}
+	leg := &link.Legs[legIndex]
+
+	// 2. Get carrier
+	carrier, exists := avd.carriers[link.CarrierID]
+	if !exists {
+		return nil, nil, nil, nil, fmt.Errorf("carrier %s not found", link.CarrierID)
+	}
+
+	// 3. Check Vitalian wallet balance
+	vitalianVault, err := avd.vaultMgr.GetVault(ctx, link.VitalianDID)
+	if err != nil {
+		return nil, nil, nil, nil, fmt.Errorf("failed to get Vitalian vault: %w", err)
+	}
+
+	return link, leg, carrier, vitalianVault, nil
+}
+
 // SendBoardingReceipt sends confirmation receipt to Vitalian
 func (avd *AirlineVitalianDirect) SendBoardingReceipt(
 	ctx context.Context,
@@ -350,24 +703,9 @@ func (avd *AirlineVitalianDirect) SendBoardingReceipt(
 }
 
 // calculateIntegrityScore calculates the Vitalian's integrity score
-// This is a simple implementation - can be enhanced with more sophisticated logic
+// Base 100 plus 5 per recency-weighted boarding (capped at 1000), decayed if configured
 func (avd *AirlineVitalianDirect) calculateIntegrityScore(vitalianDID string) int {
-	// Count successful boarding events for this Vitalian
-	successfulBoardings := 0
-	for _, event := range avd.boardingEvents {
-		if event.VitalianDID == vitalianDID {
-			successfulBoardings++
-		}
-	}
-
-	// Base score: 100
-	// Add 5 points per successful boarding (capped at 1000)
-	score := 100 + (successfulBoardings * 5)
-	if score > 1000 {
-		score = 1000
-	}
-
-	return score
+	return avd.GetIntegrityScore(vitalianDID)
 }
 
 // GetCarrier retrieves a certified airline carrier by ID
@@ -0,0 +1,124 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Boarding Event Stream
+//
+// Every successful boarding scan is published as a typed BoardingProcessed
+// event so loyalty, analytics and other downstream services can react in real
+// time instead of polling the boarding event store.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultEventBufferSize is the buffer of the default channel-backed publisher
+const DefaultEventBufferSize = 100
+
+// BoardingProcessed is published after each successful ProcessBoardingScan
+type BoardingProcessed struct {
+	EventID           string    // Boarding event ID
+	TicketID          string    // Airline ticket ID
+	VitalianDID       string    // Vitalian DID
+	CarrierID         string    // Airline carrier ID
+	FlightNumber      string    // Flight number of the boarded leg
+	LegIndex          int       // Itinerary leg boarded
+	WalletCheckResult string    // Wallet decision: "vitalian_funded", "vitalian_empty" or "fee_waived"
+	PaymentMethod     string    // "vitalian_wallet", "airline_vault" or "fee_waiver"
+	FeeAmount         int64     // Fee amount in uSOV (0 when waived)
+	WaiverReason      string    // Fee waiver applied (empty if charged)
+	TransactionID     string    // Payment transaction ID
+	IntegrityScore    int       // Updated integrity score
+	Timestamp         time.Time // Boarding timestamp
+}
+
+// EventPublisher delivers boarding events to downstream subscribers
+type EventPublisher interface {
+	PublishBoardingProcessed(ctx context.Context, event BoardingProcessed) error
+}
+
+// ChannelEventPublisher publishes boarding events to a buffered channel
+// Publishing never blocks: if the buffer is full the event is dropped and an
+// error returned.
+type ChannelEventPublisher struct {
+	events chan BoardingProcessed
+}
+
+// NewChannelEventPublisher creates a channel-backed publisher (bufferSize <= 0 uses DefaultEventBufferSize)
+func NewChannelEventPublisher(bufferSize int) *ChannelEventPublisher {
+	if bufferSize <= 0 {
+		bufferSize = DefaultEventBufferSize
+	}
+
+	return &ChannelEventPublisher{
+		events: make(chan BoardingProcessed, bufferSize),
+	}
+}
+
+// PublishBoardingProcessed queues the event for subscribers
+func (p *ChannelEventPublisher) PublishBoardingProcessed(ctx context.Context, event BoardingProcessed) error {
+	select {
+	case p.events <- event:
+		return nil
+	default:
+		return fmt.Errorf("boarding event channel full - event %s dropped", event.EventID)
+	}
+}
+
+// Events returns the channel subscribers read boarding events from
+func (p *ChannelEventPublisher) Events() <-chan BoardingProcessed {
+	return p.events
+}
+
+// NoopEventPublisher discards all events (for tests and deployments without subscribers)
+type NoopEventPublisher struct{}
+
+// PublishBoardingProcessed discards the event
+func (NoopEventPublisher) PublishBoardingProcessed(ctx context.Context, event BoardingProcessed) error {
+	return nil
+}
+
+// SetEventPublisher replaces the publisher boarding events are sent to
+func (avd *AirlineVitalianDirect) SetEventPublisher(publisher EventPublisher) {
+	if publisher == nil {
+		publisher = NoopEventPublisher{}
+	}
+	avd.eventPublisher = publisher
+}
+
+// GetBoardingEventChannel returns the default publisher's event channel
+// Returns nil if a custom publisher has been set with SetEventPublisher.
+func (avd *AirlineVitalianDirect) GetBoardingEventChannel() <-chan BoardingProcessed {
+	if publisher, ok := avd.eventPublisher.(*ChannelEventPublisher); ok {
+		return publisher.Events()
+	}
+	return nil
+}
+
+// publishBoardingProcessed sends a processed boarding to the event publisher
+// The boarding has already settled, so publish failures are logged, not returned.
+func (avd *AirlineVitalianDirect) publishBoardingProcessed(ctx context.Context, event *BoardingEvent) {
+	if avd.eventPublisher == nil {
+		return
+	}
+
+	err := avd.eventPublisher.PublishBoardingProcessed(ctx, BoardingProcessed{
+		EventID:           event.EventID,
+		TicketID:          event.TicketID,
+		VitalianDID:       event.VitalianDID,
+		CarrierID:         event.CarrierID,
+		FlightNumber:      event.FlightNumber,
+		LegIndex:          event.LegIndex,
+		WalletCheckResult: event.WalletCheckResult,
+		PaymentMethod:     event.PaymentMethod,
+		FeeAmount:         event.FeeAmount,
+		WaiverReason:      event.WaiverReason,
+		TransactionID:     event.TransactionID,
+		IntegrityScore:    event.IntegrityScore,
+		Timestamp:         event.Timestamp,
+	})
+	if err != nil {
+		fmt.Printf("Warning: failed to publish boarding event: %v\n", err)
+	}
+}
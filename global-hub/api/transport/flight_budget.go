@@ -0,0 +1,181 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Flight Budget Reservations
+//
+// Large carriers reserve funds for a whole flight manifest up front. The
+// reservation is held against the carrier vault (other flights cannot claim
+// the same funds) and each proxy-paid boarding scan draws it down. A scan that
+// would overrun the reservation, or an unreserved flight's scan that would dip
+// into other flights' reservations, fails before any vault is debited.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// FlightBudget is a carrier's reservation for a flight's proxy-paid boardings
+type FlightBudget struct {
+	CarrierID          string    // Airline carrier ID
+	FlightNumber       string    // Flight number
+	ExpectedPassengers int       // Passengers the reservation was sized for
+	FeePerPassenger    int64     // Fee per passenger in uSOV
+	Reserved           int64     // Total reserved in uSOV (ExpectedPassengers * FeePerPassenger)
+	Consumed           int64     // Drawn down by proxy-paid boarding scans
+	Released           int64     // Returned to the vault by cancelled and no-show tickets
+	ScansCovered       int       // Boarding scans paid from the reservation
+	CreatedAt          time.Time // Reservation timestamp
+	UpdatedAt          time.Time // Last draw-down timestamp
+}
+
+// Remaining returns the unconsumed part of the reservation
+func (fb *FlightBudget) Remaining() int64 {
+	return fb.Reserved - fb.Consumed - fb.Released
+}
+
+// ReserveFlightBudget holds funds in the carrier vault for a flight manifest
+//
+// RESERVATION LOGIC:
+// 1. Carrier must exist and be active; one reservation per carrier flight
+// 2. Reserved amount = expectedPassengers * feePerPassenger
+// 3. The vault balance, less what other flights still hold, must cover it
+// 4. Proxy-paid scans for the flight draw the reservation down (see ProcessBoardingScan)
+func (avd *AirlineVitalianDirect) ReserveFlightBudget(
+	ctx context.Context,
+	carrierID string,
+	flightNumber string,
+	expectedPassengers int,
+	feePerPassenger int64,
+) (*FlightBudget, error) {
+	if expectedPassengers <= 0 {
+		return nil, fmt.Errorf("expected passengers must be positive")
+	}
+	if feePerPassenger <= 0 {
+		return nil, fmt.Errorf("fee per passenger must be positive")
+	}
+
+	// 1. Validate carrier
+	carrier, exists := avd.carriers[carrierID]
+	if !exists {
+		return nil, fmt.Errorf("carrier %s not found", carrierID)
+	}
+	if !carrier.IsActive {
+		return nil, fmt.Errorf("carrier %s is not active", carrierID)
+	}
+
+	key := flightKey(carrierID, flightNumber)
+	if _, exists := avd.flightBudgets[key]; exists {
+		return nil, fmt.Errorf("flight %s already has a budget reserved by %s", flightNumber, carrierID)
+	}
+
+	// 2. Size the reservation
+	reserved := int64(expectedPassengers) * feePerPassenger
+
+	// 3. Check the vault covers it alongside existing reservations
+	vault, err := avd.vaultMgr.GetVault(ctx, carrier.VaultID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get airline vault: %w", err)
+	}
+
+	available := vault.Balance - avd.outstandingReservations(carrierID)
+	if available < reserved {
+		return nil, fmt.Errorf("insufficient airline vault funds for flight %s: need %d uSOV, %d available after existing reservations", flightNumber, reserved, available)
+	}
+
+	now := time.Now()
+	budget := &FlightBudget{
+		CarrierID:          carrierID,
+		FlightNumber:       flightNumber,
+		ExpectedPassengers: expectedPassengers,
+		FeePerPassenger:    feePerPassenger,
+		Reserved:           reserved,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	avd.flightBudgets[key] = budget
+
+	fmt.Printf("✅ Flight budget reserved: %s %s - %d passengers, %.6f SOV\n", carrierID, flightNumber, expectedPassengers, float64(reserved)/1_000_000)
+
+	return budget, nil
+}
+
+// GetFlightBudget returns the reservation for a carrier's flight
+func (avd *AirlineVitalianDirect) GetFlightBudget(carrierID string, flightNumber string) (*FlightBudget, error) {
+	budget, exists := avd.flightBudgets[flightKey(carrierID, flightNumber)]
+	if !exists {
+		return nil, fmt.Errorf("no budget reserved for flight %s by %s", flightNumber, carrierID)
+	}
+	return budget, nil
+}
+
+// checkFlightBudget fails if the carrier cannot cover a proxy-paid boarding fee
+// Reserved flights draw on their own reservation; unreserved flights may only use
+// vault funds not already held for other flights' reservations.
+func (avd *AirlineVitalianDirect) checkFlightBudget(ctx context.Context, carrier *CertifiedAirlineCarrier, flightNumber string, feeAmount int64) error {
+	budget, exists := avd.flightBudgets[flightKey(carrier.CarrierID, flightNumber)]
+	if exists {
+		if budget.Remaining() < feeAmount {
+			return fmt.Errorf("flight budget exhausted for %s %s: %d uSOV remaining, fee is %d uSOV", carrier.CarrierID, flightNumber, budget.Remaining(), feeAmount)
+		}
+		return nil
+	}
+
+	vault, err := avd.vaultMgr.GetVault(ctx, carrier.VaultID)
+	if err != nil {
+		return fmt.Errorf("failed to get airline vault: %w", err)
+	}
+
+	available := vault.Balance - avd.outstandingReservations(carrier.CarrierID)
+	if available < feeAmount {
+		return fmt.Errorf("insufficient unreserved airline vault funds for flight %s: fee is %d uSOV, %d available after reservations", flightNumber, feeAmount, available)
+	}
+
+	return nil
+}
+
+// consumeFlightBudget draws a proxy-paid boarding fee from the flight's reservation, if any
+func (avd *AirlineVitalianDirect) consumeFlightBudget(carrierID string, flightNumber string, feeAmount int64) {
+	budget, exists := avd.flightBudgets[flightKey(carrierID, flightNumber)]
+	if !exists {
+		return
+	}
+
+	budget.Consumed += feeAmount
+	budget.ScansCovered++
+	budget.UpdatedAt = time.Now()
+}
+
+// releaseFlightBudget returns one passenger's share of the flight's reservation, if any
+// Used when a ticket will never board the flight; never releases more than remains.
+func (avd *AirlineVitalianDirect) releaseFlightBudget(carrierID string, flightNumber string) int64 {
+	budget, exists := avd.flightBudgets[flightKey(carrierID, flightNumber)]
+	if !exists {
+		return 0
+	}
+
+	released := budget.FeePerPassenger
+	if remaining := budget.Remaining(); remaining < released {
+		released = remaining
+	}
+	if released <= 0 {
+		return 0
+	}
+
+	budget.Released += released
+	budget.UpdatedAt = time.Now()
+
+	return released
+}
+
+// outstandingReservations sums the unconsumed reservations held against a carrier's vault
+func (avd *AirlineVitalianDirect) outstandingReservations(carrierID string) int64 {
+	total := int64(0)
+	for _, budget := range avd.flightBudgets {
+		if budget.CarrierID == carrierID {
+			total += budget.Remaining()
+		}
+	}
+	return total
+}
@@ -0,0 +1,121 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Carrier Flight Usage Report
+//
+// Per-flight cost breakdown for carriers: how many passengers were linked and
+// boarded, how many boardings were proxy-paid from the airline vault versus
+// self-paid from the Vitalian wallet, and the total cost to the carrier.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// FlightReport is the usage and cost breakdown for one flight
+type FlightReport struct {
+	CarrierID         string    // Airline carrier ID
+	FlightNumber      string    // Flight number
+	LinkedTickets     int       // Tickets linked to a Vitalian DID
+	PassengersBoarded int       // Boarding scans processed
+	NotBoarded        int       // Linked tickets without a boarding scan
+	ProxyPaidCount    int       // Boardings paid from the airline vault
+	SelfPaidCount     int       // Boardings paid from the Vitalian wallet
+	WaivedCount       int       // Boardings exempt under a fee waiver
+	CarrierCostUSOV   int64     // Total debited from the airline vault
+	SelfPaidUSOV      int64     // Total paid by Vitalians
+	TotalFeesUSOV     int64     // All boarding fees for the flight
+	FirstBoardingAt   time.Time // Earliest boarding scan (zero if none)
+	LastBoardingAt    time.Time // Latest boarding scan (zero if none)
+	GeneratedAt       time.Time // Report generation time
+}
+
+// flightKey returns the flight index key for a carrier's flight
+func flightKey(carrierID string, flightNumber string) string {
+	return carrierID + "|" + flightNumber
+}
+
+// indexTicket adds a ticket to the index of each flight in its itinerary (no-op if already indexed)
+func (avd *AirlineVitalianDirect) indexTicket(link *TicketPFFLink) {
+	for _, leg := range link.Legs {
+		avd.indexTicketForFlight(flightKey(link.CarrierID, leg.FlightNumber), link.TicketID)
+	}
+}
+
+// indexTicketForFlight adds a ticket ID to one flight's index (no-op if already indexed)
+func (avd *AirlineVitalianDirect) indexTicketForFlight(key string, ticketID string) {
+	for _, indexed := range avd.flightTickets[key] {
+		if indexed == ticketID {
+			return
+		}
+	}
+	avd.flightTickets[key] = append(avd.flightTickets[key], ticketID)
+}
+
+// indexBoardingEvent adds a boarding event to its flight's index
+func (avd *AirlineVitalianDirect) indexBoardingEvent(event *BoardingEvent) {
+	key := flightKey(event.CarrierID, event.FlightNumber)
+	avd.flightEvents[key] = append(avd.flightEvents[key], event.EventID)
+}
+
+// GetFlightReport aggregates a flight's tickets and boarding events into a cost breakdown
+// Uses the flight index, so only tickets and boardings for that flight are read.
+func (avd *AirlineVitalianDirect) GetFlightReport(ctx context.Context, carrierID string, flightNumber string) (*FlightReport, error) {
+	if _, exists := avd.carriers[carrierID]; !exists {
+		return nil, fmt.Errorf("carrier %s not found", carrierID)
+	}
+
+	key := flightKey(carrierID, flightNumber)
+	ticketIDs := avd.flightTickets[key]
+	eventIDs := avd.flightEvents[key]
+
+	if len(ticketIDs) == 0 && len(eventIDs) == 0 {
+		return nil, fmt.Errorf("no tickets found for flight %s (carrier %s)", flightNumber, carrierID)
+	}
+
+	report := &FlightReport{
+		CarrierID:     carrierID,
+		FlightNumber:  flightNumber,
+		LinkedTickets: len(ticketIDs),
+		GeneratedAt:   time.Now(),
+	}
+
+	boardedTickets := make(map[string]bool)
+	for _, eventID := range eventIDs {
+		event, exists := avd.boardingEvents[eventID]
+		if !exists {
+			continue
+		}
+
+		report.PassengersBoarded++
+		report.TotalFeesUSOV += event.FeeAmount
+		boardedTickets[event.TicketID] = true
+
+		switch event.PaymentMethod {
+		case "airline_vault":
+			report.ProxyPaidCount++
+			report.CarrierCostUSOV += event.FeeAmount
+		case "vitalian_wallet":
+			report.SelfPaidCount++
+			report.SelfPaidUSOV += event.FeeAmount
+		case "fee_waiver":
+			report.WaivedCount++
+		}
+
+		if report.FirstBoardingAt.IsZero() || event.Timestamp.Before(report.FirstBoardingAt) {
+			report.FirstBoardingAt = event.Timestamp
+		}
+		if event.Timestamp.After(report.LastBoardingAt) {
+			report.LastBoardingAt = event.Timestamp
+		}
+	}
+
+	for _, ticketID := range ticketIDs {
+		if !boardedTickets[ticketID] {
+			report.NotBoarded++
+		}
+	}
+
+	return report, nil
+}
@@ -0,0 +1,103 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Integrity Score Decay
+//
+// Optional time-based decay so a dormant identity does not keep a high
+// integrity score indefinitely. Once a Vitalian has been inactive longer than
+// the configured period, the score drifts back toward the base score.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Integrity score bounds
+const (
+	BaseIntegrityScore      = 100
+	MaxIntegrityScore       = 1000
+	IntegrityPointsPerBoard = 5
+)
+
+// IntegrityDecayPolicy configures how scores decay over inactivity
+type IntegrityDecayPolicy struct {
+	InactivityPeriod  time.Duration // Grace period after the last activity before decay starts
+	DecayInterval     time.Duration // Length of each decay step once decay has started
+	PointsPerInterval int           // Points lost per elapsed decay interval
+}
+
+// SetIntegrityDecay enables integrity score decay (nil disables it)
+func (avd *AirlineVitalianDirect) SetIntegrityDecay(policy *IntegrityDecayPolicy) error {
+	if policy != nil {
+		if policy.InactivityPeriod < 0 {
+			return fmt.Errorf("inactivity period cannot be negative")
+		}
+		if policy.DecayInterval <= 0 {
+			return fmt.Errorf("decay interval must be positive")
+		}
+		if policy.PointsPerInterval <= 0 {
+			return fmt.Errorf("points per interval must be positive")
+		}
+	}
+
+	avd.integrityDecay = policy
+	return nil
+}
+
+// RecordActivity marks a verification for the Vitalian, resetting the inactivity clock
+func (avd *AirlineVitalianDirect) RecordActivity(vitalianDID string, at time.Time) {
+	if last, exists := avd.lastActivity[vitalianDID]; exists && last.After(at) {
+		return
+	}
+	avd.lastActivity[vitalianDID] = at
+}
+
+// GetIntegrityScore returns the Vitalian's current integrity score
+func (avd *AirlineVitalianDirect) GetIntegrityScore(vitalianDID string) int {
+	return avd.GetIntegrityScoreAt(vitalianDID, time.Now())
+}
+
+// GetIntegrityScoreAt returns the Vitalian's integrity score as of the given time
+//
+// SCORE LOGIC:
+// 1. Base score plus points per stored boarding, each weighted by recency (see SetIntegrityHalfLife)
+// 2. Capped at the maximum
+// 3. Decay anchor is the latest of the last boarding and the last recorded verification
+// 4. Past the inactivity period, lose points per elapsed interval, never below the base
+func (avd *AirlineVitalianDirect) GetIntegrityScoreAt(vitalianDID string, at time.Time) int {
+	stored, err := avd.integrityStore.Load(context.Background(), vitalianDID)
+	if err != nil {
+		fmt.Printf("Warning: failed to load integrity score for %s: %v\n", vitalianDID, err)
+	}
+
+	lastActive := avd.lastActivity[vitalianDID]
+	if stored != nil && stored.UpdatedAt.After(lastActive) {
+		lastActive = stored.UpdatedAt
+	}
+
+	weight := DecayedBoardingWeight(stored, avd.integrityHalfLife, at)
+	score := BaseIntegrityScore + int(math.Round(weight*IntegrityPointsPerBoard))
+	if score > MaxIntegrityScore {
+		score = MaxIntegrityScore
+	}
+
+	policy := avd.integrityDecay
+	if policy == nil || lastActive.IsZero() {
+		return score
+	}
+
+	idle := at.Sub(lastActive) - policy.InactivityPeriod
+	if idle <= 0 {
+		return score
+	}
+
+	intervals := int(idle / policy.DecayInterval)
+	score -= intervals * policy.PointsPerInterval
+	if score < BaseIntegrityScore {
+		score = BaseIntegrityScore
+	}
+
+	return score
+}
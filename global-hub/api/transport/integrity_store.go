@@ -0,0 +1,217 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Persistent Integrity Scores
+//
+// Each Vitalian's integrity score is stored rather than recomputed from the
+// in-memory boarding history, so it survives restarts. Boardings are weighted
+// by recency: a boarding's contribution halves every IntegrityHalfLife, and
+// the stored weight is decayed forward before each new boarding is added.
+
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultIntegrityHalfLife is how long it takes a boarding's contribution to halve
+const DefaultIntegrityHalfLife = 180 * 24 * time.Hour
+
+// StoredIntegrityScore is the persisted, time-weighted boarding record of a Vitalian
+type StoredIntegrityScore struct {
+	VitalianDID    string    `json:"vitalian_did"`
+	BoardingWeight float64   `json:"boarding_weight"` // Decayed boarding count as of UpdatedAt
+	Boardings      int       `json:"boardings"`       // Undecayed lifetime boarding count
+	UpdatedAt      time.Time `json:"updated_at"`      // Time of the last boarding
+}
+
+// IntegrityScoreStore persists integrity scores per Vitalian DID
+type IntegrityScoreStore interface {
+	Load(ctx context.Context, vitalianDID string) (*StoredIntegrityScore, error) // nil if none stored
+	Save(ctx context.Context, score *StoredIntegrityScore) error
+}
+
+// SetIntegrityScoreStore replaces the integrity score store (default: in-memory)
+func (avd *AirlineVitalianDirect) SetIntegrityScoreStore(store IntegrityScoreStore) {
+	avd.integrityStore = store
+}
+
+// SetIntegrityHalfLife sets how long it takes a boarding's contribution to halve (0 disables recency weighting)
+func (avd *AirlineVitalianDirect) SetIntegrityHalfLife(halfLife time.Duration) error {
+	if halfLife < 0 {
+		return fmt.Errorf("integrity half-life cannot be negative")
+	}
+	avd.integrityHalfLife = halfLife
+	return nil
+}
+
+// DecayedBoardingWeight returns the stored weight decayed forward to at
+// weight(at) = weight * 0.5^((at - UpdatedAt) / halfLife)
+func DecayedBoardingWeight(score *StoredIntegrityScore, halfLife time.Duration, at time.Time) float64 {
+	if score == nil {
+		return 0
+	}
+
+	elapsed := at.Sub(score.UpdatedAt)
+	if halfLife <= 0 || elapsed <= 0 {
+		return score.BoardingWeight
+	}
+
+	return score.BoardingWeight * math.Pow(0.5, float64(elapsed)/float64(halfLife))
+}
+
+// recordBoardingScore decays the stored weight to now and adds one boarding
+func (avd *AirlineVitalianDirect) recordBoardingScore(ctx context.Context, vitalianDID string, at time.Time) error {
+	stored, err := avd.integrityStore.Load(ctx, vitalianDID)
+	if err != nil {
+		return fmt.Errorf("failed to load integrity score: %w", err)
+	}
+
+	updated := &StoredIntegrityScore{
+		VitalianDID:    vitalianDID,
+		BoardingWeight: DecayedBoardingWeight(stored, avd.integrityHalfLife, at) + 1,
+		Boardings:      1,
+		UpdatedAt:      at,
+	}
+	if stored != nil {
+		updated.Boardings = stored.Boardings + 1
+	}
+
+	if err := avd.integrityStore.Save(ctx, updated); err != nil {
+		return fmt.Errorf("failed to save integrity score: %w", err)
+	}
+
+	return nil
+}
+
+// MemoryIntegrityScoreStore keeps integrity scores in memory (lost on restart)
+type MemoryIntegrityScoreStore struct {
+	scores map[string]StoredIntegrityScore // vitalianDID -> score
+	mu     sync.RWMutex
+}
+
+// NewMemoryIntegrityScoreStore creates an in-memory integrity score store
+func NewMemoryIntegrityScoreStore() *MemoryIntegrityScoreStore {
+	return &MemoryIntegrityScoreStore{
+		scores: make(map[string]StoredIntegrityScore),
+	}
+}
+
+// Load returns the stored score for a Vitalian, or nil if none
+func (ms *MemoryIntegrityScoreStore) Load(ctx context.Context, vitalianDID string) (*StoredIntegrityScore, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	score, exists := ms.scores[vitalianDID]
+	if !exists {
+		return nil, nil
+	}
+	return &score, nil
+}
+
+// Save stores a Vitalian's score
+func (ms *MemoryIntegrityScoreStore) Save(ctx context.Context, score *StoredIntegrityScore) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.scores[score.VitalianDID] = *score
+	return nil
+}
+
+// FileIntegrityScoreStore persists integrity scores to a JSON file so they survive restarts
+// Suitable for a single hub process; the file is rewritten atomically on every change.
+type FileIntegrityScoreStore struct {
+	path   string
+	scores map[string]StoredIntegrityScore // vitalianDID -> score
+	mu     sync.RWMutex
+}
+
+// NewFileIntegrityScoreStore opens (or creates) a file-backed integrity score store
+func NewFileIntegrityScoreStore(path string) (*FileIntegrityScoreStore, error) {
+	fs := &FileIntegrityScoreStore{
+		path:   path,
+		scores: make(map[string]StoredIntegrityScore),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read integrity score store: %w", err)
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &fs.scores); err != nil {
+			return nil, fmt.Errorf("failed to decode integrity score store: %w", err)
+		}
+	}
+
+	return fs, nil
+}
+
+// Load returns the stored score for a Vitalian, or nil if none
+func (fs *FileIntegrityScoreStore) Load(ctx context.Context, vitalianDID string) (*StoredIntegrityScore, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+
+	score, exists := fs.scores[vitalianDID]
+	if !exists {
+		return nil, nil
+	}
+	return &score, nil
+}
+
+// Save stores a Vitalian's score and rewrites the file
+func (fs *FileIntegrityScoreStore) Save(ctx context.Context, score *StoredIntegrityScore) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	previous, existed := fs.scores[score.VitalianDID]
+	fs.scores[score.VitalianDID] = *score
+
+	if err := fs.persistLocked(); err != nil {
+		// Roll back so memory and disk agree
+		if existed {
+			fs.scores[score.VitalianDID] = previous
+		} else {
+			delete(fs.scores, score.VitalianDID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// persistLocked writes all scores via a temp file and rename (caller holds fs.mu)
+func (fs *FileIntegrityScoreStore) persistLocked() error {
+	data, err := json.Marshal(fs.scores)
+	if err != nil {
+		return fmt.Errorf("failed to encode integrity score store: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fs.path), filepath.Base(fs.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write integrity score store: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write integrity score store: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write integrity score store: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), fs.path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to replace integrity score store: %w", err)
+	}
+
+	return nil
+}
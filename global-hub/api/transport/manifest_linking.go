@@ -0,0 +1,68 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Flight Manifest Linking
+//
+// Carriers link a whole flight manifest at check-in close rather than one
+// ticket at a time. Entries are linked in manifest order, each succeeding or
+// failing on its own.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+)
+
+// ManifestEntry is one passenger on a flight manifest
+type ManifestEntry struct {
+	TicketID    string      // Airline ticket ID
+	VitalianDID string      // Passenger DID
+	Legs        []FlightLeg // Itinerary (a single leg for direct flights)
+}
+
+// ManifestLinkResult is the outcome of linking one manifest entry
+type ManifestLinkResult struct {
+	Index    int            // Position in the submitted manifest
+	TicketID string         // Ticket ID as submitted
+	Link     *TicketPFFLink // Created link (nil on failure)
+	Error    string         // Failure reason
+}
+
+// LinkManifest links every ticket on a flight manifest to its passenger's DID
+//
+// MANIFEST LOGIC:
+// 1. Load each passenger's vault (passengers without one are not linked)
+// 2. Link the ticket via LinkItineraryToPFF
+// Each entry succeeds or fails independently; results are returned in manifest order.
+func (avd *AirlineVitalianDirect) LinkManifest(
+	ctx context.Context,
+	carrierID string,
+	manifest []ManifestEntry,
+) []*ManifestLinkResult {
+	results := make([]*ManifestLinkResult, len(manifest))
+	for i, entry := range manifest {
+		results[i] = &ManifestLinkResult{Index: i, TicketID: entry.TicketID}
+	}
+
+	for i, entry := range manifest {
+		if entry.TicketID == "" || entry.VitalianDID == "" {
+			results[i].Error = "ticket ID and Vitalian DID are required"
+			continue
+		}
+
+		// 1. Load passenger vault
+		if _, err := avd.vaultMgr.GetVault(ctx, entry.VitalianDID); err != nil {
+			results[i].Error = fmt.Sprintf("failed to get Vitalian vault: %v", err)
+			continue
+		}
+
+		// 2. Link ticket
+		link, err := avd.LinkItineraryToPFF(ctx, entry.TicketID, entry.VitalianDID, carrierID, entry.Legs)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Link = link
+	}
+
+	return results
+}
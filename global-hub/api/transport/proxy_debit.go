@@ -0,0 +1,41 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Idempotent Carrier Proxy Debits
+//
+// A boarding scan can fail after the airline vault was debited (e.g. the
+// four-way split or a later write fails) and then be retried. Proxy debits are
+// recorded per ticket leg, so the retry reuses the original debit instead of
+// charging the carrier a second time.
+
+package transport
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProxyDebit is a carrier vault debit made on behalf of a passenger for one ticket leg
+type ProxyDebit struct {
+	TicketID      string    // Ticket the boarding fee was paid for
+	LegIndex      int       // Itinerary leg the fee covers
+	CarrierID     string    // Airline carrier ID
+	VaultID       string    // Carrier vault that was debited
+	Amount        int64     // Fee debited in uSOV
+	TransactionID string    // Vault debit transaction ID
+	DebitedAt     time.Time // Debit timestamp
+}
+
+// proxyDebitKey keys a proxy debit by ticket and leg
+func proxyDebitKey(ticketID string, legIndex int) string {
+	return fmt.Sprintf("%s|%d", ticketID, legIndex)
+}
+
+// GetProxyDebit returns the carrier debit already made for a ticket leg, if any
+func (avd *AirlineVitalianDirect) GetProxyDebit(ticketID string, legIndex int) (*ProxyDebit, bool) {
+	debit, exists := avd.proxyDebits[proxyDebitKey(ticketID, legIndex)]
+	return debit, exists
+}
+
+// recordProxyDebit remembers a carrier debit so retries of the same ticket leg reuse it
+func (avd *AirlineVitalianDirect) recordProxyDebit(debit *ProxyDebit) {
+	avd.proxyDebits[proxyDebitKey(debit.TicketID, debit.LegIndex)] = debit
+}
@@ -32,7 +32,9 @@ CREATE TABLE ticket_pff_links (
   origin TEXT NOT NULL,
   destination TEXT NOT NULL,
   boarding_time TIMESTAMP NOT NULL,
-  status TEXT NOT NULL CHECK (status IN ('linked', 'boarded', 'cancelled')),
+  legs_boarded INTEGER NOT NULL DEFAULT 0,
+  status TEXT NOT NULL CHECK (status IN ('linked', 'boarded', 'cancelled', 'no_show')),
+  close_reason TEXT,
   created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
   updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
@@ -44,6 +46,21 @@ CREATE INDEX idx_ticket_links_flight_number ON ticket_pff_links(flight_number);
 CREATE INDEX idx_ticket_links_status ON ticket_pff_links(status);
 CREATE INDEX idx_ticket_links_boarding_time ON ticket_pff_links(boarding_time);
 
+-- Ticket Flight Legs (itinerary of a linked ticket; one row for direct flights)
+CREATE TABLE ticket_flight_legs (
+  ticket_id TEXT NOT NULL REFERENCES ticket_pff_links(ticket_id),
+  leg_index INTEGER NOT NULL CHECK (leg_index >= 0),
+  flight_number TEXT NOT NULL,
+  origin TEXT NOT NULL,
+  destination TEXT NOT NULL,
+  boarding_time TIMESTAMP NOT NULL,
+  boarded BOOLEAN NOT NULL DEFAULT FALSE,
+  boarded_at TIMESTAMP,
+  PRIMARY KEY (ticket_id, leg_index)
+);
+
+CREATE INDEX idx_ticket_flight_legs_flight_number ON ticket_flight_legs(flight_number);
+
 -- Boarding Events
 CREATE TABLE boarding_events (
   event_id TEXT PRIMARY KEY,
@@ -51,10 +68,12 @@ CREATE TABLE boarding_events (
   vitalian_did TEXT NOT NULL,
   carrier_id TEXT NOT NULL REFERENCES certified_airline_carriers(carrier_id),
   flight_number TEXT NOT NULL,
+  leg_index INTEGER NOT NULL DEFAULT 0,
   pff_hash TEXT NOT NULL,
-  wallet_check_result TEXT NOT NULL CHECK (wallet_check_result IN ('vitalian_funded', 'vitalian_empty')),
-  payment_method TEXT NOT NULL CHECK (payment_method IN ('vitalian_wallet', 'airline_vault')),
+  wallet_check_result TEXT NOT NULL CHECK (wallet_check_result IN ('vitalian_funded', 'vitalian_empty', 'fee_waived')),
+  payment_method TEXT NOT NULL CHECK (payment_method IN ('vitalian_wallet', 'airline_vault', 'fee_waiver')),
   fee_amount BIGINT NOT NULL,
+  waiver_reason TEXT,
   transaction_id TEXT NOT NULL,
   integrity_score INTEGER NOT NULL,
   timestamp TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
@@ -73,7 +92,7 @@ CREATE TABLE boarding_receipts (
   vitalian_did TEXT NOT NULL,
   carrier_name TEXT NOT NULL,
   flight_number TEXT NOT NULL,
-  payment_method TEXT NOT NULL CHECK (payment_method IN ('vitalian_wallet', 'airline_vault')),
+  payment_method TEXT NOT NULL CHECK (payment_method IN ('vitalian_wallet', 'airline_vault', 'fee_waiver')),
   fee_amount BIGINT NOT NULL,
   integrity_score INTEGER NOT NULL,
   message TEXT NOT NULL,
@@ -83,6 +102,29 @@ CREATE TABLE boarding_receipts (
 CREATE INDEX idx_boarding_receipts_vitalian_did ON boarding_receipts(vitalian_did);
 CREATE INDEX idx_boarding_receipts_timestamp ON boarding_receipts(timestamp);
 
+-- Vitalian Integrity Scores (recency-weighted boarding record)
+CREATE TABLE vitalian_integrity_scores (
+  vitalian_did TEXT PRIMARY KEY,
+  boarding_weight DOUBLE PRECISION NOT NULL DEFAULT 0, -- Decayed boarding count as of updated_at
+  boardings INTEGER NOT NULL DEFAULT 0,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Flight Budget Reservations (pre-funded proxy boardings)
+CREATE TABLE flight_budgets (
+  carrier_id TEXT NOT NULL REFERENCES certified_airline_carriers(carrier_id),
+  flight_number TEXT NOT NULL,
+  expected_passengers INTEGER NOT NULL CHECK (expected_passengers > 0),
+  fee_per_passenger BIGINT NOT NULL CHECK (fee_per_passenger > 0),
+  reserved BIGINT NOT NULL,
+  consumed BIGINT NOT NULL DEFAULT 0 CHECK (consumed <= reserved),
+  released BIGINT NOT NULL DEFAULT 0 CHECK (consumed + released <= reserved),
+  scans_covered INTEGER NOT NULL DEFAULT 0,
+  created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (carrier_id, flight_number)
+);
+
 -- Views for Analytics
 
 -- Airline Proxy Payment Statistics
@@ -121,6 +163,7 @@ SELECT
   COUNT(*) AS total_passengers,
   COUNT(CASE WHEN be.payment_method = 'airline_vault' THEN 1 END) AS proxy_paid_passengers,
   COUNT(CASE WHEN be.payment_method = 'vitalian_wallet' THEN 1 END) AS self_paid_passengers,
+  COUNT(CASE WHEN be.payment_method = 'fee_waiver' THEN 1 END) AS waived_passengers,
   SUM(be.fee_amount) AS total_fees_collected_usov,
   MIN(be.timestamp) AS first_boarding,
   MAX(be.timestamp) AS last_boarding
@@ -0,0 +1,96 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Ticket Cancellation and No-Shows
+//
+// Linked tickets that will never board are closed out so stale links do not
+// accumulate. Closing a ticket returns its passenger share of any flight
+// reservation to the carrier vault for each leg it had not yet boarded.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DefaultTicketLinkGracePeriod is how long after boarding time an unscanned link is kept
+const DefaultTicketLinkGracePeriod = 24 * time.Hour
+
+// SetTicketLinkGracePeriod sets how long after boarding time SweepStaleTicketLinks waits
+func (avd *AirlineVitalianDirect) SetTicketLinkGracePeriod(grace time.Duration) error {
+	if grace < 0 {
+		return fmt.Errorf("grace period cannot be negative")
+	}
+
+	avd.linkGracePeriod = grace
+
+	return nil
+}
+
+// CancelTicketLink cancels a linked ticket (e.g. a refunded booking)
+// Boarded tickets cannot be cancelled; a partly flown itinerary cancels its remaining legs.
+func (avd *AirlineVitalianDirect) CancelTicketLink(ctx context.Context, ticketID string, reason string) (*TicketPFFLink, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("cancellation reason required")
+	}
+
+	return avd.closeTicketLink(ticketID, "cancelled", reason)
+}
+
+// MarkNoShow closes a linked ticket whose passenger never scanned at the gate
+func (avd *AirlineVitalianDirect) MarkNoShow(ticketID string) (*TicketPFFLink, error) {
+	return avd.closeTicketLink(ticketID, "no_show", "passenger did not board")
+}
+
+// SweepStaleTicketLinks cancels linked tickets whose next leg's boarding time passed by the grace period
+// Returns the IDs of the tickets that were cancelled.
+func (avd *AirlineVitalianDirect) SweepStaleTicketLinks(ctx context.Context) ([]string, error) {
+	now := time.Now()
+	cancelled := make([]string, 0)
+
+	for ticketID, link := range avd.ticketLinks {
+		if link.Status != "linked" || link.LegsBoarded >= len(link.Legs) {
+			continue
+		}
+
+		nextLeg := link.Legs[link.LegsBoarded]
+		if now.Before(nextLeg.BoardingTime.Add(avd.linkGracePeriod)) {
+			continue
+		}
+
+		reason := fmt.Sprintf("not boarded within %s of flight %s boarding time", avd.linkGracePeriod, nextLeg.FlightNumber)
+		if _, err := avd.closeTicketLink(ticketID, "cancelled", reason); err != nil {
+			return cancelled, fmt.Errorf("failed to cancel ticket %s: %w", ticketID, err)
+		}
+
+		cancelled = append(cancelled, ticketID)
+	}
+
+	return cancelled, nil
+}
+
+// closeTicketLink moves a linked ticket to a closed status and releases its flight reservations
+func (avd *AirlineVitalianDirect) closeTicketLink(ticketID string, status string, reason string) (*TicketPFFLink, error) {
+	link, exists := avd.ticketLinks[ticketID]
+	if !exists {
+		return nil, fmt.Errorf("ticket %s not linked to any Vitalian DID", ticketID)
+	}
+
+	if link.Status != "linked" {
+		return nil, fmt.Errorf("ticket %s status is %s, expected 'linked'", ticketID, link.Status)
+	}
+
+	// Return the passenger's share of each unflown leg's reservation
+	released := int64(0)
+	for _, leg := range link.Legs[link.LegsBoarded:] {
+		released += avd.releaseFlightBudget(link.CarrierID, leg.FlightNumber)
+	}
+
+	link.Status = status
+	link.CloseReason = reason
+	link.UpdatedAt = time.Now()
+
+	fmt.Printf("✅ Ticket %s closed (%s): %s - %.6f SOV reservation released\n", ticketID, status, reason, float64(released)/1_000_000)
+
+	return link, nil
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Webhook delivery defaults
+const (
+	DefaultWebhookMaxAttempts  = 3
+	DefaultWebhookRetryBackoff = 500 * time.Millisecond
+	DefaultWebhookTimeout      = 5 * time.Second
+
+	// WebhookSignatureHeader carries the hex HMAC-SHA256 of "<timestamp>.<body>"
+	WebhookSignatureHeader = "X-Sovrn-Signature"
+	// WebhookTimestampHeader carries the unix timestamp included in the signature
+	WebhookTimestampHeader = "X-Sovrn-Timestamp"
+)
+
+// WebhookRegistration is a carrier's callback endpoint for verification outcomes
+type WebhookRegistration struct {
+	CarrierID    string
+	URL          string
+	secret       []byte // HMAC key shared with the carrier (never serialized)
+	RegisteredAt time.Time
+}
+
+// VerificationOutcome is the payload POSTed to a carrier's webhook
+// It never carries the biometric hash.
+type VerificationOutcome struct {
+	VerificationID string    `json:"verification_id"`
+	RequestID      string    `json:"request_id,omitempty"`
+	CarrierID      string    `json:"carrier_id"`
+	CheckpointType string    `json:"checkpoint_type"`
+	FlightNumber   string    `json:"flight_number,omitempty"`
+	Success        bool      `json:"success"`
+	TrustScore     int32     `json:"trust_score"`
+	TrustLevel     string    `json:"trust_level"`
+	Cached         bool      `json:"cached"`
+	Message        string    `json:"message"`
+	CompletedAt    time.Time `json:"completed_at"`
+}
+
+// WebhookDispatcher delivers signed verification outcomes to registered carriers
+type WebhookDispatcher struct {
+	registrations map[string]*WebhookRegistration // carrierID -> registration
+	client        *http.Client
+	maxAttempts   int
+	retryBackoff  time.Duration
+	mu            sync.RWMutex
+}
+
+// NewWebhookDispatcher creates a webhook dispatcher with default retry settings
+func NewWebhookDispatcher() *WebhookDispatcher {
+	return &WebhookDispatcher{
+		registrations: make(map[string]*WebhookRegistration),
+		client:        &http.Client{Timeout: DefaultWebhookTimeout},
+		maxAttempts:   DefaultWebhookMaxAttempts,
+		retryBackoff:  DefaultWebhookRetryBackoff,
+	}
+}
+
+// SetHTTPClient overrides the HTTP client used for deliveries
+func (wd *WebhookDispatcher) SetHTTPClient(client *http.Client) {
+	wd.mu.Lock()
+	defer wd.mu.Unlock()
+
+	wd.client = client
+}
+
+// SetRetryPolicy sets the delivery attempts and base backoff (doubled after each failure)
+func (wd *WebhookDispatcher) SetRetryPolicy(maxAttempts int, backoff time.Duration) error {
+	if maxAttempts < 1 {
+		return fmt.Errorf("max attempts must be at least 1, got %d", maxAttempts)
+	}
+	if backoff < 0 {
+		return fmt.Errorf("backoff cannot be negative")
+	}
+
+	wd.mu.Lock()
+	defer wd.mu.Unlock()
+
+	wd.maxAttempts = maxAttempts
+	wd.retryBackoff = backoff
+
+	return nil
+}
+
+// RegisterWebhook registers (or replaces) a carrier's callback URL and signing secret
+func (wd *WebhookDispatcher) RegisterWebhook(carrierID string, url string, secret []byte) (*WebhookRegistration, error) {
+	if carrierID == "" {
+		return nil, fmt.Errorf("carrier ID required")
+	}
+	if url == "" {
+		return nil, fmt.Errorf("webhook URL required")
+	}
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("webhook signing secret required")
+	}
+
+	wd.mu.Lock()
+	defer wd.mu.Unlock()
+
+	registration := &WebhookRegistration{
+		CarrierID:    carrierID,
+		URL:          url,
+		secret:       append([]byte(nil), secret...),
+		RegisteredAt: time.Now(),
+	}
+	wd.registrations[carrierID] = registration
+
+	return registration, nil
+}
+
+// UnregisterWebhook removes a carrier's callback
+func (wd *WebhookDispatcher) UnregisterWebhook(carrierID string) {
+	wd.mu.Lock()
+	defer wd.mu.Unlock()
+
+	delete(wd.registrations, carrierID)
+}
+
+// GetWebhook returns a carrier's registration, if any
+func (wd *WebhookDispatcher) GetWebhook(carrierID string) (*WebhookRegistration, bool) {
+	wd.mu.RLock()
+	defer wd.mu.RUnlock()
+
+	registration, exists := wd.registrations[carrierID]
+	return registration, exists
+}
+
+// Dispatch delivers an outcome in the background (no-op if the carrier has no webhook)
+// Delivery is detached from the request context so it outlives the verification call.
+func (wd *WebhookDispatcher) Dispatch(outcome *VerificationOutcome) {
+	if _, exists := wd.GetWebhook(outcome.CarrierID); !exists {
+		return
+	}
+
+	go func() {
+		if err := wd.Deliver(context.Background(), outcome); err != nil {
+			fmt.Printf("⚠️  Webhook delivery to carrier %s failed: %v\n", outcome.CarrierID, err)
+		}
+	}()
+}
+
+// Deliver POSTs a signed outcome to the carrier's webhook, retrying on failure
+//
+// DELIVERY LOGIC:
+// 1. Serialize the outcome and sign "<timestamp>.<body>" with the carrier secret
+// 2. POST with signature and timestamp headers
+// 3. Any non-2xx response or transport error is retried with exponential backoff
+// 4. Give up after maxAttempts and return the last error
+func (wd *WebhookDispatcher) Deliver(ctx context.Context, outcome *VerificationOutcome) error {
+	wd.mu.RLock()
+	registration, exists := wd.registrations[outcome.CarrierID]
+	client := wd.client
+	maxAttempts := wd.maxAttempts
+	backoff := wd.retryBackoff
+	wd.mu.RUnlock()
+
+	if !exists {
+		return fmt.Errorf("no webhook registered for carrier: %s", outcome.CarrierID)
+	}
+
+	body, err := json.Marshal(outcome)
+	if err != nil {
+		return fmt.Errorf("failed to encode outcome: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		lastErr = wd.post(ctx, client, registration, body)
+		if lastErr == nil {
+			return nil
+		}
+
+		if attempt == maxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("webhook delivery cancelled: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	return fmt.Errorf("webhook delivery failed after %d attempts: %w", maxAttempts, lastErr)
+}
+
+// post performs a single signed delivery attempt
+func (wd *WebhookDispatcher) post(ctx context.Context, client *http.Client, registration *WebhookRegistration, body []byte) error {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, registration.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(registration.secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// SignWebhookPayload computes the hex HMAC-SHA256 signature of "<timestamp>.<body>"
+// Carriers recompute it with their secret to authenticate deliveries.
+func SignWebhookPayload(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature checks a delivery's signature in constant time
+func VerifyWebhookSignature(secret []byte, timestamp string, body []byte, signature string) bool {
+	expected := SignWebhookPayload(secret, timestamp, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
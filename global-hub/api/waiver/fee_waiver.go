@@ -0,0 +1,161 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Fee Waiver Registry
+//
+// Humanitarian, diplomatic and similar travelers may be exempt from PFF fees.
+// Payment paths consult the registry when resolving a fee: waived identities
+// are still verified and their passage recorded as a zero-amount exempt
+// transaction carrying the waiver reason, but no vault is debited.
+
+package waiver
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Waiver scopes
+const (
+	ScopeDID     = "did"     // Waiver granted to a single DID
+	ScopeProgram = "program" // Waiver granted to every DID enrolled in a program
+)
+
+// FeeWaiver is a single fee exemption
+type FeeWaiver struct {
+	Scope     string    `json:"scope"`      // "did" or "program"
+	Subject   string    `json:"subject"`    // Waived DID or program tag
+	Reason    string    `json:"reason"`     // Recorded on every exempt transaction
+	GrantedBy string    `json:"granted_by"` // Authority that granted the waiver
+	GrantedAt time.Time `json:"granted_at"`
+}
+
+// FeeWaiverRegistry holds DID and program fee waivers
+type FeeWaiverRegistry struct {
+	didWaivers     map[string]*FeeWaiver // DID -> waiver
+	programWaivers map[string]*FeeWaiver // Program tag -> waiver
+	enrollments    map[string][]string   // DID -> program tags
+	mu             sync.RWMutex
+}
+
+// NewFeeWaiverRegistry creates an empty fee waiver registry
+func NewFeeWaiverRegistry() *FeeWaiverRegistry {
+	return &FeeWaiverRegistry{
+		didWaivers:     make(map[string]*FeeWaiver),
+		programWaivers: make(map[string]*FeeWaiver),
+		enrollments:    make(map[string][]string),
+	}
+}
+
+// WaiveDID exempts a single DID from fees
+func (r *FeeWaiverRegistry) WaiveDID(did string, reason string, grantedBy string) (*FeeWaiver, error) {
+	if did == "" {
+		return nil, fmt.Errorf("DID required")
+	}
+
+	waiver, err := newFeeWaiver(ScopeDID, did, reason, grantedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.didWaivers[did] = waiver
+
+	fmt.Printf("✅ Fee waiver granted to %s by %s: %s\n", did, grantedBy, reason)
+
+	return waiver, nil
+}
+
+// WaiveProgram exempts every DID enrolled in a program (e.g. "humanitarian", "diplomatic")
+func (r *FeeWaiverRegistry) WaiveProgram(program string, reason string, grantedBy string) (*FeeWaiver, error) {
+	if program == "" {
+		return nil, fmt.Errorf("program tag required")
+	}
+
+	waiver, err := newFeeWaiver(ScopeProgram, program, reason, grantedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.programWaivers[program] = waiver
+
+	fmt.Printf("✅ Fee waiver granted to program %s by %s: %s\n", program, grantedBy, reason)
+
+	return waiver, nil
+}
+
+// EnrollDID adds a DID to a program (no-op if already enrolled)
+func (r *FeeWaiverRegistry) EnrollDID(did string, program string) error {
+	if did == "" || program == "" {
+		return fmt.Errorf("DID and program tag required")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, enrolled := range r.enrollments[did] {
+		if enrolled == program {
+			return nil
+		}
+	}
+	r.enrollments[did] = append(r.enrollments[did], program)
+
+	return nil
+}
+
+// RevokeDID removes a DID's own waiver (program waivers still apply)
+func (r *FeeWaiverRegistry) RevokeDID(did string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.didWaivers, did)
+}
+
+// RevokeProgram removes a program's waiver (enrollments are kept)
+func (r *FeeWaiverRegistry) RevokeProgram(program string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.programWaivers, program)
+}
+
+// Lookup returns the waiver that applies to a DID
+// A DID's own waiver takes precedence over its programs' waivers.
+func (r *FeeWaiverRegistry) Lookup(did string) (*FeeWaiver, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if waiver, exists := r.didWaivers[did]; exists {
+		return waiver, true
+	}
+
+	for _, program := range r.enrollments[did] {
+		if waiver, exists := r.programWaivers[program]; exists {
+			return waiver, true
+		}
+	}
+
+	return nil, false
+}
+
+// newFeeWaiver validates and builds a waiver
+func newFeeWaiver(scope string, subject string, reason string, grantedBy string) (*FeeWaiver, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("waiver reason required")
+	}
+	if grantedBy == "" {
+		return nil, fmt.Errorf("granting authority required")
+	}
+
+	return &FeeWaiver{
+		Scope:     scope,
+		Subject:   subject,
+		Reason:    reason,
+		GrantedBy: grantedBy,
+		GrantedAt: time.Now(),
+	}, nil
+}
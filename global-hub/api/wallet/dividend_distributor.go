@@ -8,10 +8,13 @@ package wallet
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/robfig/cron/v3"
+	"github.com/sovrn-protocol/sovrn/hub/api/workerpool"
 )
 
 // BlockchainAPI defines the interface for querying blockchain module accounts
@@ -19,8 +22,9 @@ type BlockchainAPI interface {
 	// GetSpokePoolBalance returns the balance of a National_Spoke_Pool
 	GetSpokePoolBalance(ctx context.Context, spokeID string) (int64, error)
 	
-	// ResetSpokePool resets a National_Spoke_Pool balance to 0 after distribution
-	ResetSpokePool(ctx context.Context, spokeID string) error
+	// DeductSpokePool removes the amount actually distributed from a National_Spoke_Pool
+	// Anything left (rounding remainder, failed credits) stays for the next distribution.
+	DeductSpokePool(ctx context.Context, spokeID string, amount int64) error
 	
 	// GetSpokeIDs returns all active spoke IDs
 	GetSpokeIDs(ctx context.Context) ([]string, error)
@@ -38,20 +42,83 @@ type DividendDistributor struct {
 	blockchainAPI BlockchainAPI
 	notifier      NotificationService
 	cronScheduler *cron.Cron
+	location      *time.Location // Timezone the schedule and distribution periods are evaluated in
+	schedule      string         // Standard 5-field cron spec, validated by SetupCronJob
+	pool          *workerpool.Pool // Bounds concurrent dividend notifications per spoke
+	batchSize     int              // Credits applied per BatchCredit call
+
+	// Small pool handling (pools that can't pay every DID the minimum)
+	smallPoolPolicy   SmallPoolPolicy
+	minDividendPerDID int64
+	rotationOffsets   map[string]int // spokeID -> next rotation start
+
+	// Reserve retained in each pool for clawbacks and corrections
+	reserveBasisPoints int64
+	reserves           map[string]int64 // spokeID -> reserve held in the pool
+
+	// ledger records distributed periods so no spoke is paid twice in a period
+	ledger DistributionLedger
+
+	// Optional weighting of each DID's share (nil = equal split)
+	weightFunc DividendWeightFunc
+
+	mu sync.Mutex
 }
 
+// DefaultDividendSchedule runs the distribution on the first day of every month at midnight
+const DefaultDividendSchedule = "0 0 1 * *"
+
+// DefaultDividendLocation is West Africa Time (UTC+1, no daylight saving)
+var DefaultDividendLocation = time.FixedZone("WAT", 60*60)
+
 // NewDividendDistributor creates a new dividend distributor
+// The cron schedule is evaluated in location regardless of the host timezone
+// (nil falls back to DefaultDividendLocation, an empty schedule to
+// DefaultDividendSchedule). The schedule is validated by SetupCronJob.
 func NewDividendDistributor(
 	vaultMgr *SovereignVaultManager,
 	blockchainAPI BlockchainAPI,
 	notifier NotificationService,
+	location *time.Location,
+	schedule string,
 ) *DividendDistributor {
+	if location == nil {
+		location = DefaultDividendLocation
+	}
+	if schedule == "" {
+		schedule = DefaultDividendSchedule
+	}
+
 	return &DividendDistributor{
-		vaultMgr:      vaultMgr,
-		blockchainAPI: blockchainAPI,
-		notifier:      notifier,
-		cronScheduler: cron.New(),
+		vaultMgr:          vaultMgr,
+		blockchainAPI:     blockchainAPI,
+		notifier:          notifier,
+		cronScheduler:     cron.New(cron.WithLocation(location)),
+		location:          location,
+		schedule:          schedule,
+		pool:              workerpool.New(workerpool.DefaultConcurrency),
+		batchSize:         DefaultCreditBatchSize,
+		smallPoolPolicy:   SmallPoolCarryForward,
+		minDividendPerDID: DefaultMinDividendPerDID,
+		rotationOffsets:   make(map[string]int),
+		reserves:          make(map[string]int64),
+		ledger:            NewMemoryDistributionLedger(),
+	}
+}
+
+// SetConcurrency sets how many dividend notifications are sent in parallel during distribution
+func (dd *DividendDistributor) SetConcurrency(concurrency int) {
+	dd.pool = workerpool.New(concurrency)
+}
+
+// SetCreditBatchSize sets how many DIDs are credited per batch (each batch is atomic)
+func (dd *DividendDistributor) SetCreditBatchSize(size int) error {
+	if size <= 0 {
+		return fmt.Errorf("credit batch size must be positive")
 	}
+
+	dd.batchSize = size
+	return nil
 }
 
 // DistributeMonthlyIntegrityFunds is the cron job function
@@ -59,14 +126,35 @@ func NewDividendDistributor(
 // AUTONOMOUS LOGIC:
 // 1. Query total balance in National_Spoke_Pool for each spoke
 // 2. Get all verified DIDs (Status: "verified")
-// 3. Calculate dividend per DID (total pool / number of verified DIDs)
+// 3. Calculate dividend per DID (total pool / number of verified DIDs, or by weight)
 // 4. Distribute to each verified DID
 // 5. Send notification: "You have received your SOVRA Integrity Dividend!"
-// 6. Reset National_Spoke_Pool balance to 0
+// 6. Deduct the amount actually credited from National_Spoke_Pool
+//
+// With a DividendWeightFunc (SetDividendWeightFunc), step 3 splits the pool in
+// proportion to weight instead (see weightedShares).
+//
+// A configured reserve ratio (SetReserveRatio) is kept back before step 3, so
+// the pool is left holding the reserve rather than drained.
+//
+// The integer-division remainder and the share of any DID whose credit failed
+// stay in the pool and are carried into the next distribution, so no uSOV vanish.
+// Spokes with no verified DIDs are skipped and keep their whole pool.
+//
+// Pools too small to pay every DID the minimum follow the SmallPoolPolicy
+// (carried forward by default, or paid to a rotating subset).
+//
+// Each spoke is distributed at most once per period (see DistributionLedger);
+// spokes already settled for the current month are skipped.
 //
 // EXECUTION: First day of every month at midnight (WAT)
 func (dd *DividendDistributor) DistributeMonthlyIntegrityFunds(ctx context.Context) error {
-	fmt.Println("🔄 Starting Monthly Integrity Dividend Distribution...")
+	return dd.distributePeriod(ctx, DistributionPeriod(time.Now().In(dd.location)), false)
+}
+
+// distributePeriod distributes every spoke pool for a period (force re-runs settled spokes)
+func (dd *DividendDistributor) distributePeriod(ctx context.Context, period string, force bool) error {
+	fmt.Printf("🔄 Starting Monthly Integrity Dividend Distribution (%s)...\n", period)
 	startTime := time.Now()
 
 	// Get all spoke IDs
@@ -80,9 +168,15 @@ func (dd *DividendDistributor) DistributeMonthlyIntegrityFunds(ctx context.Conte
 
 	// Process each spoke
 	for _, spokeID := range spokeIDs {
-		distributed, recipients, err := dd.distributeSpokePool(ctx, spokeID)
+		distributed, recipients, err := dd.distributeSpokePeriod(ctx, spokeID, period, force)
+		if errors.Is(err, ErrPeriodAlreadyDistributed) {
+			fmt.Printf("   %s: Already distributed for %s, skipping\n", spokeID, period)
+			continue
+		}
 		if err != nil {
 			fmt.Printf("⚠️  Failed to distribute %s pool: %v\n", spokeID, err)
+			totalDistributed += distributed
+			totalRecipients += recipients
 			continue
 		}
 
@@ -126,61 +220,193 @@ func (dd *DividendDistributor) distributeSpokePool(ctx context.Context, spokeID
 		return 0, 0, nil
 	}
 
-	// 3. Calculate dividend per DID
-	dividendPerDID := totalPool / int64(len(verifiedDIDs))
+	// 3. Retain the reserve, then calculate each DID's share (equal split or weighted)
+	distributable, reserve := dd.splitReserve(totalPool)
+	dd.setReserve(spokeID, reserve)
+
+	recipients, amounts, err := dd.planSpokeShares(ctx, spokeID, distributable, verifiedDIDs)
+	if err != nil {
+		return 0, 0, err
+	}
+	if recipients == nil {
+		fmt.Printf("   %s: Pool of %d uSOV (after %d uSOV reserve) below minimum or unweighted for %d verified DIDs, carrying forward\n",
+			spokeID, distributable, reserve, len(verifiedDIDs))
+		return 0, 0, nil
+	}
+
+	planned := int64(0)
+	for _, amount := range amounts {
+		planned += amount
+	}
 
-	fmt.Printf("   %s: Distributing %d uSOV to %d verified DIDs (%.6f SOV each)\n",
-		spokeID, totalPool, len(verifiedDIDs), float64(dividendPerDID)/1_000_000)
+	fmt.Printf("   %s: Distributing %d uSOV to %d verified DIDs\n", spokeID, planned, len(recipients))
 
-	// 4. Distribute to each verified DID
-	successCount := 0
-	for _, did := range verifiedDIDs {
-		// Get vault by DID
-		vault, err := dd.vaultMgr.GetVaultByDID(ctx, did)
-		if err != nil {
-			fmt.Printf("      ⚠️  Failed to get vault for %s: %v\n", did, err)
-			continue
+	// 4. Credit recipients in atomic batches (one vault lock acquisition per batch)
+	// A failed batch may have applied a prefix of its credits; those still count as paid.
+	credited := make([]string, 0, len(recipients))
+	creditedAmounts := make([]int64, 0, len(recipients))
+	for start := 0; start < len(recipients); start += dd.batchSize {
+		end := start + dd.batchSize
+		if end > len(recipients) {
+			end = len(recipients)
 		}
 
-		// Credit vault
-		_, err = dd.vaultMgr.CreditVault(ctx, vault.UserID, dividendPerDID, "integrity_dividend")
+		batch := recipients[start:end]
+		credits := make([]Credit, len(batch))
+		for i, did := range batch {
+			credits[i] = Credit{DID: did, Amount: amounts[start+i], Purpose: "integrity_dividend"}
+		}
+
+		txIDs, err := dd.vaultMgr.BatchCredit(ctx, credits)
 		if err != nil {
-			fmt.Printf("      ⚠️  Failed to credit %s: %v\n", did, err)
+			fmt.Printf("      ⚠️  Failed to credit batch of %d DIDs (%d applied): %v\n", len(batch), len(txIDs), err)
+			credited = append(credited, batch[:len(txIDs)]...)
+			creditedAmounts = append(creditedAmounts, amounts[start:start+len(txIDs)]...)
 			continue
 		}
 
-		// Send notification
-		err = dd.notifier.SendDividendNotification(ctx, did, dividendPerDID)
-		if err != nil {
+		credited = append(credited, batch...)
+		creditedAmounts = append(creditedAmounts, amounts[start:end]...)
+	}
+
+	// Notify credited DIDs (bounded parallelism; failures don't undo the credit)
+	dd.pool.Run(ctx, len(credited), func(ctx context.Context, i int) error {
+		did := credited[i]
+		if err := dd.notifier.SendDividendNotification(ctx, did, creditedAmounts[i]); err != nil {
 			fmt.Printf("      ⚠️  Failed to send notification to %s: %v\n", did, err)
-			// Continue even if notification fails
 		}
+		return nil
+	})
+
+	successCount := len(credited)
+	distributed := int64(0)
+	for _, amount := range creditedAmounts {
+		distributed += amount
+	}
+
+	// 5. Deduct only what was credited; remainder and failed shares carry forward
+	if distributed > 0 {
+		if err := dd.blockchainAPI.DeductSpokePool(ctx, spokeID, distributed); err != nil {
+			return distributed, successCount, fmt.Errorf("credited %d uSOV but failed to deduct it from pool: %w", distributed, err)
+		}
+	}
+
+	if carried := distributable - distributed; carried > 0 {
+		fmt.Printf("   %s: Carrying %d uSOV forward (%d uSOV rounding remainder, %d failed credits)\n",
+			spokeID, carried, distributable-planned, len(recipients)-successCount)
+	}
+	if reserve > 0 {
+		fmt.Printf("   %s: Retaining %d uSOV reserve\n", spokeID, reserve)
+	}
+
+	if len(recipients) < len(verifiedDIDs) {
+		dd.advanceRotation(spokeID, len(recipients), len(verifiedDIDs))
+	}
+
+	return distributed, successCount, nil
+}
+
+// DividendEstimate is the projected dividend for a DID from the current pool state
+type DividendEstimate struct {
+	DID              string           `json:"did"`
+	Eligible         bool             `json:"eligible"` // Vault is verified
+	EstimatedUSOV    int64            `json:"estimated_usov"`
+	SpokeEstimates   map[string]int64 `json:"spoke_estimates"` // spokeID -> projected share
+	EligibleDIDCount int              `json:"eligible_did_count"`
+	EstimatedAt      time.Time        `json:"estimated_at"`
+}
+
+// EstimateDividend projects what a DID would receive if distribution ran now
+// Uses the same per-spoke math as distributeSpokePool; actual amounts change
+// as pools fill and DIDs are verified before the monthly run.
+func (dd *DividendDistributor) EstimateDividend(ctx context.Context, did string) (*DividendEstimate, error) {
+	verifiedDIDs, err := dd.vaultMgr.GetVerifiedDIDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get verified DIDs: %w", err)
+	}
+
+	estimate := &DividendEstimate{
+		DID:              did,
+		SpokeEstimates:   make(map[string]int64),
+		EligibleDIDCount: len(verifiedDIDs),
+		EstimatedAt:      time.Now(),
+	}
+
+	for _, verifiedDID := range verifiedDIDs {
+		if verifiedDID == did {
+			estimate.Eligible = true
+			break
+		}
+	}
 
-		successCount++
+	if !estimate.Eligible {
+		return estimate, nil
 	}
 
-	// 5. Reset National_Spoke_Pool balance to 0
-	err = dd.blockchainAPI.ResetSpokePool(ctx, spokeID)
+	spokeIDs, err := dd.blockchainAPI.GetSpokeIDs(ctx)
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to reset pool: %w", err)
+		return nil, fmt.Errorf("failed to get spoke IDs: %w", err)
 	}
 
-	return totalPool, successCount, nil
+	for _, spokeID := range spokeIDs {
+		totalPool, err := dd.blockchainAPI.GetSpokePoolBalance(ctx, spokeID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s pool balance: %w", spokeID, err)
+		}
+
+		distributable, _ := dd.splitReserve(totalPool)
+		recipients, amounts, err := dd.planSpokeShares(ctx, spokeID, distributable, verifiedDIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		share := int64(0)
+		for i, recipient := range recipients {
+			if recipient == did {
+				share = amounts[i]
+				break
+			}
+		}
+		estimate.SpokeEstimates[spokeID] = share
+		estimate.EstimatedUSOV += share
+	}
+
+	return estimate, nil
+}
+
+// calculateDividendPerDID splits a spoke pool evenly across recipients (integer division)
+func calculateDividendPerDID(totalPool int64, recipients int) int64 {
+	if recipients == 0 {
+		return 0
+	}
+	return totalPool / int64(recipients)
 }
 
 // SetupCronJob sets up the monthly cron job
 //
-// SCHEDULE: "0 0 1 * *" = First day of every month at midnight (WAT)
+// SCHEDULE: the distributor's cron spec (default "0 0 1 * *" = first day of every month at midnight),
+// evaluated in the distributor's location (default WAT)
 //
 // USAGE:
-//   dd := NewDividendDistributor(vaultMgr, blockchainAPI, notifier)
+//   dd := NewDividendDistributor(vaultMgr, blockchainAPI, notifier, nil, "")
 //   dd.SetupCronJob()
 //   dd.Start()
 func (dd *DividendDistributor) SetupCronJob() error {
-	// Schedule: First day of every month at midnight
+	// Validate the spec up front so a bad schedule fails setup instead of never running
 	// Cron format: "minute hour day-of-month month day-of-week"
-	// "0 0 1 * *" = minute 0, hour 0, day 1, every month, any day of week
-	_, err := dd.cronScheduler.AddFunc("0 0 1 * *", func() {
+	if _, err := cron.ParseStandard(dd.schedule); err != nil {
+		return fmt.Errorf("invalid dividend schedule %q: %w", dd.schedule, err)
+	}
+
+	// Scheduled runs must remember paid periods across restarts
+	dd.mu.Lock()
+	_, inMemory := dd.ledger.(*MemoryDistributionLedger)
+	dd.mu.Unlock()
+	if inMemory {
+		fmt.Printf("Warning: dividend distribution ledger is in memory; a restart can pay a period twice (use SetDistributionLedger(NewFileDistributionLedger(%q)))\n", DefaultDistributionLedgerPath)
+	}
+
+	_, err := dd.cronScheduler.AddFunc(dd.schedule, func() {
 		ctx := context.Background()
 		err := dd.DistributeMonthlyIntegrityFunds(ctx)
 		if err != nil {
@@ -193,12 +419,22 @@ func (dd *DividendDistributor) SetupCronJob() error {
 	}
 
 	fmt.Println("✅ Monthly Integrity Dividend Cron Job Scheduled")
-	fmt.Println("   Schedule: First day of every month at midnight (WAT)")
+	fmt.Printf("   Schedule: %q (%s)\n", dd.schedule, dd.location)
 	fmt.Println("   Next run:", dd.GetNextRun())
 
 	return nil
 }
 
+// NextRunAfter returns the first scheduled run strictly after t, in the distributor's location
+func (dd *DividendDistributor) NextRunAfter(t time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(dd.schedule)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid dividend schedule %q: %w", dd.schedule, err)
+	}
+
+	return schedule.Next(t.In(dd.location)), nil
+}
+
 // Start starts the cron scheduler
 func (dd *DividendDistributor) Start() {
 	dd.cronScheduler.Start()
@@ -221,8 +457,30 @@ func (dd *DividendDistributor) GetNextRun() time.Time {
 }
 
 // RunNow executes the dividend distribution immediately (for testing)
-func (dd *DividendDistributor) RunNow(ctx context.Context) error {
+// Fails with ErrPeriodAlreadyDistributed if any spoke was already distributed
+// this period, unless force is set to re-run those spokes.
+func (dd *DividendDistributor) RunNow(ctx context.Context, force bool) error {
+	period := DistributionPeriod(time.Now().In(dd.location))
+
+	if !force {
+		spokeIDs, err := dd.blockchainAPI.GetSpokeIDs(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get spoke IDs: %w", err)
+		}
+
+		ledger := dd.distributionLedger()
+		for _, spokeID := range spokeIDs {
+			record, exists, err := ledger.GetRecord(ctx, spokeID, period)
+			if err != nil {
+				return fmt.Errorf("failed to read distribution ledger: %w", err)
+			}
+			if exists && record.settled() {
+				return fmt.Errorf("%w: %s %s (%s); pass force to re-run", ErrPeriodAlreadyDistributed, spokeID, period, record.Status)
+			}
+		}
+	}
+
 	fmt.Println("🧪 Running dividend distribution manually...")
-	return dd.DistributeMonthlyIntegrityFunds(ctx)
+	return dd.distributePeriod(ctx, period, force)
 }
 
@@ -0,0 +1,315 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Dividend Distribution Ledger
+//
+// Records which spoke pools were distributed in which period, so a restart
+// mid-run or a second RunNow in the same month cannot credit recipients
+// twice. The ledger is pluggable: a file-backed store survives restarts, and
+// production deployments can plug in the database.
+
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Distribution record statuses
+const (
+	DistributionInProgress = "in_progress" // Recorded before crediting; left behind if the run is interrupted
+	DistributionCompleted  = "completed"
+	DistributionIncomplete = "incomplete" // Failed after some credits were applied
+	DistributionFailed     = "failed"     // Failed before anything was credited (retried on the next run)
+)
+
+// ErrPeriodAlreadyDistributed is returned when a period was already distributed and the run was not forced
+var ErrPeriodAlreadyDistributed = errors.New("dividend period already distributed")
+
+// DistributionRecord is one spoke pool's distribution for one period
+type DistributionRecord struct {
+	SpokeID         string    `json:"spoke_id"`
+	Period          string    `json:"period"` // "2006-01"
+	Status          string    `json:"status"`
+	DistributedUSOV int64     `json:"distributed_usov"`
+	Recipients      int       `json:"recipients"`
+	ReserveUSOV     int64     `json:"reserve_usov"`
+	Forced          bool      `json:"forced"` // Re-run over an earlier record for the same period
+	Error           string    `json:"error,omitempty"`
+	StartedAt       time.Time `json:"started_at"`
+	CompletedAt     time.Time `json:"completed_at,omitempty"`
+}
+
+// settled reports whether the record blocks another unforced run for its period
+func (r *DistributionRecord) settled() bool {
+	return r.Status != DistributionFailed
+}
+
+// DistributionLedger persists per-spoke, per-period distribution records
+type DistributionLedger interface {
+	// GetRecord returns the record for a spoke and period, or false if none exists
+	GetRecord(ctx context.Context, spokeID string, period string) (*DistributionRecord, bool, error)
+
+	// PutRecord creates or replaces the record for its spoke and period
+	PutRecord(ctx context.Context, record *DistributionRecord) error
+
+	// ListRecords returns every record for a spoke
+	ListRecords(ctx context.Context, spokeID string) ([]*DistributionRecord, error)
+}
+
+// DistributionPeriod returns the monthly period a distribution at t belongs to
+func DistributionPeriod(t time.Time) string {
+	return t.Format("2006-01")
+}
+
+// distributionLedgerKey keys a record by spoke and period
+func distributionLedgerKey(spokeID string, period string) string {
+	return spokeID + "/" + period
+}
+
+// MemoryDistributionLedger keeps distribution records in memory (lost on restart)
+type MemoryDistributionLedger struct {
+	records map[string]*DistributionRecord
+	mu      sync.RWMutex
+}
+
+// NewMemoryDistributionLedger creates an empty in-memory ledger
+func NewMemoryDistributionLedger() *MemoryDistributionLedger {
+	return &MemoryDistributionLedger{
+		records: make(map[string]*DistributionRecord),
+	}
+}
+
+// GetRecord returns the record for a spoke and period
+func (ml *MemoryDistributionLedger) GetRecord(ctx context.Context, spokeID string, period string) (*DistributionRecord, bool, error) {
+	ml.mu.RLock()
+	defer ml.mu.RUnlock()
+
+	record, exists := ml.records[distributionLedgerKey(spokeID, period)]
+	return record, exists, nil
+}
+
+// PutRecord creates or replaces a record
+func (ml *MemoryDistributionLedger) PutRecord(ctx context.Context, record *DistributionRecord) error {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	ml.records[distributionLedgerKey(record.SpokeID, record.Period)] = record
+	return nil
+}
+
+// ListRecords returns every record for a spoke
+func (ml *MemoryDistributionLedger) ListRecords(ctx context.Context, spokeID string) ([]*DistributionRecord, error) {
+	ml.mu.RLock()
+	defer ml.mu.RUnlock()
+
+	return recordsForSpoke(ml.records, spokeID), nil
+}
+
+// DefaultDistributionLedgerPath is where server wiring keeps the file ledger
+const DefaultDistributionLedgerPath = "data/dividend_ledger.json"
+
+// FileDistributionLedger persists distribution records to a JSON file
+type FileDistributionLedger struct {
+	path    string
+	records map[string]*DistributionRecord
+	mu      sync.RWMutex
+}
+
+// NewFileDistributionLedger opens (or creates) a ledger persisted at path
+func NewFileDistributionLedger(path string) (*FileDistributionLedger, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create distribution ledger directory: %w", err)
+	}
+
+	fl := &FileDistributionLedger{
+		path:    path,
+		records: make(map[string]*DistributionRecord),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read distribution ledger: %w", err)
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &fl.records); err != nil {
+			return nil, fmt.Errorf("failed to decode distribution ledger: %w", err)
+		}
+	}
+
+	return fl, nil
+}
+
+// GetRecord returns the record for a spoke and period
+func (fl *FileDistributionLedger) GetRecord(ctx context.Context, spokeID string, period string) (*DistributionRecord, bool, error) {
+	fl.mu.RLock()
+	defer fl.mu.RUnlock()
+
+	record, exists := fl.records[distributionLedgerKey(spokeID, period)]
+	return record, exists, nil
+}
+
+// PutRecord creates or replaces a record and writes the ledger to disk
+func (fl *FileDistributionLedger) PutRecord(ctx context.Context, record *DistributionRecord) error {
+	fl.mu.Lock()
+	defer fl.mu.Unlock()
+
+	key := distributionLedgerKey(record.SpokeID, record.Period)
+	previous, existed := fl.records[key]
+	fl.records[key] = record
+
+	if err := fl.persistLocked(); err != nil {
+		// Roll back so memory and disk agree
+		if existed {
+			fl.records[key] = previous
+		} else {
+			delete(fl.records, key)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ListRecords returns every record for a spoke
+func (fl *FileDistributionLedger) ListRecords(ctx context.Context, spokeID string) ([]*DistributionRecord, error) {
+	fl.mu.RLock()
+	defer fl.mu.RUnlock()
+
+	return recordsForSpoke(fl.records, spokeID), nil
+}
+
+// persistLocked writes all records via a temp file and rename (caller holds fl.mu)
+func (fl *FileDistributionLedger) persistLocked() error {
+	data, err := json.Marshal(fl.records)
+	if err != nil {
+		return fmt.Errorf("failed to encode distribution ledger: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fl.path), filepath.Base(fl.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write distribution ledger: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write distribution ledger: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write distribution ledger: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), fl.path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to replace distribution ledger: %w", err)
+	}
+
+	return nil
+}
+
+// recordsForSpoke returns a spoke's records ordered by period
+func recordsForSpoke(records map[string]*DistributionRecord, spokeID string) []*DistributionRecord {
+	result := make([]*DistributionRecord, 0)
+	for _, record := range records {
+		if record.SpokeID == spokeID {
+			result = append(result, record)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Period < result[j].Period
+	})
+
+	return result
+}
+
+// SetDistributionLedger replaces the ledger of distributed periods (in-memory until set)
+func (dd *DividendDistributor) SetDistributionLedger(ledger DistributionLedger) error {
+	if ledger == nil {
+		return fmt.Errorf("distribution ledger required")
+	}
+
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	dd.ledger = ledger
+	return nil
+}
+
+// GetDistributionHistory returns a spoke's distribution records, oldest period first
+func (dd *DividendDistributor) GetDistributionHistory(ctx context.Context, spokeID string) ([]*DistributionRecord, error) {
+	return dd.distributionLedger().ListRecords(ctx, spokeID)
+}
+
+// distributionLedger returns the configured ledger
+func (dd *DividendDistributor) distributionLedger() DistributionLedger {
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	return dd.ledger
+}
+
+// distributeSpokePeriod distributes one spoke's pool at most once per period
+//
+// LEDGER LOGIC:
+// 1. A settled record (anything but "failed") for the period skips the spoke unless forced
+// 2. An "in_progress" record is written before any credit is applied
+// 3. The outcome replaces it: "completed", "incomplete" or "failed" (nothing credited; retried)
+// A crash after step 2 leaves "in_progress" behind, which blocks unforced re-runs.
+func (dd *DividendDistributor) distributeSpokePeriod(ctx context.Context, spokeID string, period string, force bool) (int64, int, error) {
+	ledger := dd.distributionLedger()
+
+	// 1. Skip settled periods
+	existing, exists, err := ledger.GetRecord(ctx, spokeID, period)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to read distribution ledger: %w", err)
+	}
+	if exists && existing.settled() && !force {
+		return 0, 0, fmt.Errorf("%w: %s %s (%s)", ErrPeriodAlreadyDistributed, spokeID, period, existing.Status)
+	}
+
+	// 2. Record the attempt before crediting
+	record := &DistributionRecord{
+		SpokeID:   spokeID,
+		Period:    period,
+		Status:    DistributionInProgress,
+		Forced:    exists && existing.settled(),
+		StartedAt: time.Now(),
+	}
+	if err := ledger.PutRecord(ctx, record); err != nil {
+		return 0, 0, fmt.Errorf("failed to record distribution start: %w", err)
+	}
+
+	// 3. Distribute and record the outcome
+	distributed, recipients, distErr := dd.distributeSpokePool(ctx, spokeID)
+
+	record.DistributedUSOV = distributed
+	record.Recipients = recipients
+	record.ReserveUSOV = dd.GetReserveBalance(spokeID)
+	record.CompletedAt = time.Now()
+
+	switch {
+	case distErr == nil:
+		record.Status = DistributionCompleted
+	case recipients > 0:
+		record.Status = DistributionIncomplete
+		record.Error = distErr.Error()
+	default:
+		record.Status = DistributionFailed
+		record.Error = distErr.Error()
+	}
+
+	if err := ledger.PutRecord(ctx, record); err != nil {
+		fmt.Printf("⚠️  Failed to record %s distribution for %s: %v\n", spokeID, period, err)
+	}
+
+	return distributed, recipients, distErr
+}
@@ -0,0 +1,97 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Spoke Pool Reserve
+//
+// Distributing a whole National_Spoke_Pool each month leaves nothing to cover
+// clawbacks or corrections. A configurable reserve ratio keeps a fraction of
+// each pool back at distribution time; the reserve is only spent through
+// governance-approved corrections.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+)
+
+// MaxReserveBasisPoints is 100% expressed in basis points
+const MaxReserveBasisPoints = 10_000
+
+// SetReserveRatio retains basisPoints/10000 of each spoke pool at distribution (0 disables)
+func (dd *DividendDistributor) SetReserveRatio(basisPoints int64) error {
+	if basisPoints < 0 || basisPoints >= MaxReserveBasisPoints {
+		return fmt.Errorf("reserve ratio must be between 0 and %d basis points (exclusive), got %d", MaxReserveBasisPoints, basisPoints)
+	}
+
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	dd.reserveBasisPoints = basisPoints
+	return nil
+}
+
+// GetReserveBalance returns the reserve a spoke's pool retained at its last distribution, less corrections
+func (dd *DividendDistributor) GetReserveBalance(spokeID string) int64 {
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	return dd.reserves[spokeID]
+}
+
+// splitReserve splits a pool into the distributable amount and the reserve to retain
+func (dd *DividendDistributor) splitReserve(totalPool int64) (distributable int64, reserve int64) {
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	reserve = totalPool * dd.reserveBasisPoints / MaxReserveBasisPoints
+	return totalPool - reserve, reserve
+}
+
+// setReserve records the reserve a spoke's pool retained after a distribution
+func (dd *DividendDistributor) setReserve(spokeID string, reserve int64) {
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	dd.reserves[spokeID] = reserve
+}
+
+// ApplyReserveCorrection pays a correction out of a spoke's reserve
+//
+// CORRECTION LOGIC:
+// 1. A governance proposal ID is required (approval happens on-chain)
+// 2. The amount must be positive and covered by the spoke's reserve
+// 3. The user's vault is credited and the amount deducted from the spoke pool
+// Returns the credit transaction ID.
+func (dd *DividendDistributor) ApplyReserveCorrection(ctx context.Context, spokeID string, userID string, amount int64, proposalID string) (string, error) {
+	// 1. Governance reference
+	if proposalID == "" {
+		return "", fmt.Errorf("governance proposal ID required for reserve correction")
+	}
+
+	// 2. Reserve must cover the correction
+	if amount <= 0 {
+		return "", fmt.Errorf("correction amount must be positive, got %d", amount)
+	}
+
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	if dd.reserves[spokeID] < amount {
+		return "", fmt.Errorf("insufficient %s reserve: have %d uSOV, need %d uSOV", spokeID, dd.reserves[spokeID], amount)
+	}
+
+	// 3. Credit the user, then take the amount out of the pool
+	txID, err := dd.vaultMgr.CreditVault(ctx, userID, amount, "dividend_reserve_correction")
+	if err != nil {
+		return "", fmt.Errorf("failed to credit correction: %w", err)
+	}
+
+	if err := dd.blockchainAPI.DeductSpokePool(ctx, spokeID, amount); err != nil {
+		return txID, fmt.Errorf("correction %s credited but pool deduction failed: %w", txID, err)
+	}
+
+	dd.reserves[spokeID] -= amount
+
+	fmt.Printf("✅ Reserve correction: %d uSOV from %s reserve to %s (proposal %s, tx %s)\n", amount, spokeID, userID, proposalID, txID)
+
+	return txID, nil
+}
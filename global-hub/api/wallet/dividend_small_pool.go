@@ -0,0 +1,92 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Small Spoke Pool Policy
+//
+// When a spoke pool is too small to give every verified DID the minimum
+// dividend (e.g., fewer uSOV than recipients), an even split would credit
+// everyone 0. The policy decides instead whether the
+// pool is carried forward to the next period or paid to a rotating subset.
+
+package wallet
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SmallPoolPolicy determines how sub-threshold spoke pools are handled
+type SmallPoolPolicy string
+
+const (
+	// SmallPoolCarryForward leaves the pool intact for the next distribution
+	SmallPoolCarryForward SmallPoolPolicy = "carry_forward"
+
+	// SmallPoolRotate pays the minimum dividend to as many DIDs as the pool
+	// covers, starting where the previous rotation for the spoke stopped
+	SmallPoolRotate SmallPoolPolicy = "rotate"
+)
+
+// DefaultMinDividendPerDID is the smallest dividend worth distributing (1 uSOV)
+const DefaultMinDividendPerDID = int64(1)
+
+// SetSmallPoolPolicy configures handling of pools below minPerDID per verified DID
+func (dd *DividendDistributor) SetSmallPoolPolicy(policy SmallPoolPolicy, minPerDID int64) error {
+	if policy != SmallPoolCarryForward && policy != SmallPoolRotate {
+		return fmt.Errorf("invalid small pool policy: %s", policy)
+	}
+	if minPerDID <= 0 {
+		return fmt.Errorf("minimum dividend per DID must be positive")
+	}
+
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	dd.smallPoolPolicy = policy
+	dd.minDividendPerDID = minPerDID
+
+	return nil
+}
+
+// planSpokeDistribution returns the recipients and per-DID dividend for a spoke pool
+// A nil recipient list means the pool is carried forward untouched.
+func (dd *DividendDistributor) planSpokeDistribution(spokeID string, totalPool int64, verifiedDIDs []string) ([]string, int64) {
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	dividendPerDID := calculateDividendPerDID(totalPool, len(verifiedDIDs))
+	if dividendPerDID >= dd.minDividendPerDID {
+		return verifiedDIDs, dividendPerDID
+	}
+
+	if dd.smallPoolPolicy != SmallPoolRotate {
+		return nil, 0
+	}
+
+	count := int(totalPool / dd.minDividendPerDID)
+	if count == 0 {
+		return nil, 0
+	}
+
+	// Stable order so the rotation is fair across periods
+	ordered := append([]string(nil), verifiedDIDs...)
+	sort.Strings(ordered)
+
+	offset := dd.rotationOffsets[spokeID] % len(ordered)
+	recipients := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		recipients = append(recipients, ordered[(offset+i)%len(ordered)])
+	}
+
+	return recipients, dd.minDividendPerDID
+}
+
+// advanceRotation moves a spoke's rotation past the DIDs paid this period
+func (dd *DividendDistributor) advanceRotation(spokeID string, paid int, eligible int) {
+	if eligible == 0 {
+		return
+	}
+
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	dd.rotationOffsets[spokeID] = (dd.rotationOffsets[spokeID] + paid) % eligible
+}
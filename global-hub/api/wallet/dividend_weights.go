@@ -0,0 +1,152 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Weighted Integrity Dividends
+//
+// An equal split pays dormant accounts the same as active verifiers. A
+// DividendWeightFunc lets operators weight each verified DID's share of a
+// spoke pool, e.g. by recent verifications or integrity score. Shares are
+// proportional to weight and always add up to the distributable pool exactly.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// DividendWeightFunc returns the relative weight of each verified DID in a spoke's distribution
+// Weights must not be negative. DIDs missing from the result weigh 0 and receive nothing.
+type DividendWeightFunc func(ctx context.Context, spokeID string, dids []string) (map[string]int64, error)
+
+// SetDividendWeightFunc weights each DID's share of a spoke pool (nil restores the equal split)
+func (dd *DividendDistributor) SetDividendWeightFunc(weightFunc DividendWeightFunc) {
+	dd.mu.Lock()
+	defer dd.mu.Unlock()
+
+	dd.weightFunc = weightFunc
+}
+
+// VerificationCountWeights weights DIDs by their successful PFF payments within window (0 = all time)
+func VerificationCountWeights(vaultMgr *SovereignVaultManager, window time.Duration) DividendWeightFunc {
+	return func(ctx context.Context, spokeID string, dids []string) (map[string]int64, error) {
+		since := time.Time{}
+		if window > 0 {
+			since = time.Now().Add(-window)
+		}
+		return vaultMgr.CountVerifications(ctx, since)
+	}
+}
+
+// CountVerifications returns the number of successful PFF payments (debited or fee-waived) per DID since a time
+func (svm *SovereignVaultManager) CountVerifications(ctx context.Context, since time.Time) (map[string]int64, error) {
+	svm.mu.RLock()
+	defer svm.mu.RUnlock()
+
+	txs, err := svm.listTransactionsLocked(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64)
+	for _, tx := range txs {
+		if tx.PFFHash == "" || tx.Status != "success" || tx.Timestamp.Before(since) {
+			continue
+		}
+		if tx.Type == "debit" || tx.Type == "exempt" {
+			counts[tx.DID]++
+		}
+	}
+
+	return counts, nil
+}
+
+// planSpokeShares returns the recipients of a spoke pool and the amount each receives
+// Without a weight function this is the equal split of planSpokeDistribution.
+// A nil recipient list means the pool is carried forward untouched.
+func (dd *DividendDistributor) planSpokeShares(ctx context.Context, spokeID string, distributable int64, verifiedDIDs []string) ([]string, []int64, error) {
+	dd.mu.Lock()
+	weightFunc := dd.weightFunc
+	dd.mu.Unlock()
+
+	if weightFunc == nil {
+		recipients, dividendPerDID := dd.planSpokeDistribution(spokeID, distributable, verifiedDIDs)
+		amounts := make([]int64, len(recipients))
+		for i := range amounts {
+			amounts[i] = dividendPerDID
+		}
+		return recipients, amounts, nil
+	}
+
+	weights, err := weightFunc(ctx, spokeID, verifiedDIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to weight %s dividends: %w", spokeID, err)
+	}
+
+	recipients, amounts, err := weightedShares(distributable, verifiedDIDs, weights)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid %s dividend weights: %w", spokeID, err)
+	}
+	return recipients, amounts, nil
+}
+
+// weightedShares splits a pool in proportion to weight (largest remainder method)
+//
+// NORMALIZATION LOGIC:
+// 1. Each DID's exact share is pool * weight / total weight
+// 2. Every DID receives the integer part of its exact share
+// 3. The uSOV left over (fewer than the number of DIDs) go one each to the largest fractional parts, ties by DID
+// Shares add up to the pool exactly. DIDs with a zero share are left out, and a zero total weight carries the pool forward.
+func weightedShares(pool int64, dids []string, weights map[string]int64) ([]string, []int64, error) {
+	// Stable order so ties are broken the same way every period
+	ordered := append([]string(nil), dids...)
+	sort.Strings(ordered)
+
+	totalWeight := new(big.Int)
+	for _, did := range ordered {
+		weight := weights[did]
+		if weight < 0 {
+			return nil, nil, fmt.Errorf("negative weight %d for %s", weight, did)
+		}
+		totalWeight.Add(totalWeight, big.NewInt(weight))
+	}
+	if pool <= 0 || totalWeight.Sign() == 0 {
+		return nil, nil, nil
+	}
+
+	// 1-2. Integer parts (big.Int: pool * weight can overflow int64)
+	shares := make([]int64, len(ordered))
+	remainders := make([]*big.Int, len(ordered))
+	allocated := int64(0)
+	for i, did := range ordered {
+		product := new(big.Int).Mul(big.NewInt(pool), big.NewInt(weights[did]))
+		quotient, remainder := new(big.Int).QuoRem(product, totalWeight, new(big.Int))
+		shares[i] = quotient.Int64()
+		remainders[i] = remainder
+		allocated += shares[i]
+	}
+
+	// 3. Hand out the leftover by largest remainder
+	byRemainder := make([]int, len(ordered))
+	for i := range byRemainder {
+		byRemainder[i] = i
+	}
+	sort.SliceStable(byRemainder, func(a, b int) bool {
+		return remainders[byRemainder[a]].Cmp(remainders[byRemainder[b]]) > 0
+	})
+	for _, i := range byRemainder[:pool-allocated] {
+		shares[i]++
+	}
+
+	recipients := make([]string, 0, len(ordered))
+	amounts := make([]int64, 0, len(ordered))
+	for i, did := range ordered {
+		if shares[i] > 0 {
+			recipients = append(recipients, did)
+			amounts = append(amounts, shares[i])
+		}
+	}
+
+	return recipients, amounts, nil
+}
@@ -0,0 +1,120 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Biometric Payment Reversal
+//
+// A scan later found to be a deepfake by Consensus_of_Presence should not
+// leave its fee stranded. Reversing the payment credits the vault back and
+// records a reversal transaction linked to the original debit.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReverseBiometricPayment credits back the fee of a completed biometric payment
+// Returns the reversal transaction. Each debit can be reversed only once.
+func (sdh *SeamlessDebitHandshake) ReverseBiometricPayment(ctx context.Context, transactionID string, reason string) (*VaultTransaction, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("reversal reason required")
+	}
+
+	reversal, err := sdh.vaultMgr.ReverseDebit(ctx, transactionID, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("✅ Biometric payment %s reversed: %.6f SOV credited back to %s (%s)\n",
+		transactionID, float64(reversal.Amount)/1_000_000, reversal.UserID, reason)
+
+	return reversal, nil
+}
+
+// ReverseDebit credits a successful biometric payment debit back to its vault and links the two transactions
+//
+// REVERSAL LOGIC:
+// 1. Original transaction must be a successful ExecuteBiometricPayment debit (biometric purpose and PFF hash)
+// 2. It must not already have been reversed
+// 3. Vault is credited with the debited amount
+// 4. A "reversal" transaction is recorded and linked both ways
+func (svm *SovereignVaultManager) ReverseDebit(ctx context.Context, transactionID string, reason string) (*VaultTransaction, error) {
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	// 1. Validate original transaction
+	original, exists, err := svm.store.GetTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transaction %s: %w", transactionID, err)
+	}
+	if !exists {
+		if svm.archive != nil {
+			if _, archived, err := svm.archive.GetArchivedTransaction(ctx, transactionID); err == nil && archived {
+				return nil, fmt.Errorf("transaction %s has been archived and can no longer be reversed", transactionID)
+			}
+		}
+		return nil, fmt.Errorf("transaction not found: %s", transactionID)
+	}
+	if original.Type != "debit" || original.Status != "success" {
+		return nil, fmt.Errorf("transaction %s is not a successful debit (type %s, status %s)", transactionID, original.Type, original.Status)
+	}
+	if !isBiometricPaymentPurpose(original.Purpose) || original.PFFHash == "" {
+		return nil, fmt.Errorf("transaction %s is not a biometric payment (purpose %q) and cannot be reversed", transactionID, original.Purpose)
+	}
+
+	// 2. Refuse double reversal
+	if original.ReversedBy != "" {
+		return nil, fmt.Errorf("transaction %s already reversed by %s", transactionID, original.ReversedBy)
+	}
+
+	vault, err := svm.getVaultLocked(ctx, original.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Credit the fee back
+	before := *vault
+	balanceBefore := vault.Balance
+	vault.Balance += original.Amount
+	vault.UpdatedAt = time.Now()
+
+	// 4. Record the linked reversal
+	reversal := &VaultTransaction{
+		TransactionID: uuid.New().String(),
+		UserID:        original.UserID,
+		DID:           vault.DID,
+		Type:          "reversal",
+		Amount:        original.Amount,
+		BalanceBefore: balanceBefore,
+		BalanceAfter:  vault.Balance,
+		Purpose:       original.Purpose,
+		PFFHash:       original.PFFHash,
+		ReversalOf:    original.TransactionID,
+		Metadata:      map[string]interface{}{"reason": reason},
+		Timestamp:     time.Now(),
+		Status:        "success",
+	}
+
+	if err := svm.commitLocked(ctx, vault, before, reversal); err != nil {
+		return nil, err
+	}
+
+	original.ReversedBy = reversal.TransactionID
+	if err := svm.store.UpdateTransaction(ctx, original); err != nil {
+		// The credit is recorded; the reversal's ReversalOf still links the pair
+		fmt.Printf("Warning: failed to link reversal %s to debit %s: %v\n", reversal.TransactionID, transactionID, err)
+	}
+
+	return reversal, nil
+}
+
+// isBiometricPaymentPurpose reports whether a debit purpose is one ExecuteBiometricPayment records
+func isBiometricPaymentPurpose(purpose string) bool {
+	switch TransactionType(purpose) {
+	case TransactionTypeFastTrack, TransactionTypeStandard:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Proof of Presence Verification
+//
+// A Proof_of_Presence is signed with the key bound to the traveler's DID, and
+// its PFF hash must not appear in the VLT_Core (Consensus_of_Presence)
+// blacklist. Both checks run before any autonomous debit.
+
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// SignatureVerifier checks a signature against the public key bound to a DID
+type SignatureVerifier interface {
+	// Verify returns an error if sig is not a valid signature of message by did
+	Verify(did string, message []byte, sig []byte) error
+}
+
+// BlacklistChecker queries the VLT_Core blacklist of revoked PFF hashes
+type BlacklistChecker interface {
+	// IsBlacklisted reports whether a PFF hash has been blacklisted
+	IsBlacklisted(ctx context.Context, pffHash string) (bool, error)
+}
+
+// ProofMessage returns the bytes a traveler signs for a Proof_of_Presence
+func ProofMessage(proof *ProofOfPresence) []byte {
+	return []byte(proof.PFFHash + "|" + proof.DID + "|" +
+		strconv.Itoa(int(proof.LivenessScore)) + "|" +
+		strconv.FormatInt(proof.Timestamp.UnixNano(), 10))
+}
+
+// verifyProofSignature checks the proof's signature against its DID key
+func (sdh *SeamlessDebitHandshake) verifyProofSignature(proof *ProofOfPresence) error {
+	if len(proof.Signature) == 0 {
+		return fmt.Errorf("missing signature")
+	}
+
+	if sdh.signatureVerifier == nil {
+		return fmt.Errorf("signature verifier not configured: cannot accept proofs")
+	}
+
+	if err := sdh.signatureVerifier.Verify(proof.DID, ProofMessage(proof), proof.Signature); err != nil {
+		return fmt.Errorf("invalid proof signature from %s: %w", proof.DID, err)
+	}
+
+	return nil
+}
+
+// checkBlacklist rejects PFF hashes present in the VLT_Core blacklist
+func (sdh *SeamlessDebitHandshake) checkBlacklist(ctx context.Context, pffHash string) error {
+	if sdh.blacklist == nil {
+		return fmt.Errorf("blacklist checker not configured: cannot accept proofs")
+	}
+
+	blacklisted, err := sdh.blacklist.IsBlacklisted(ctx, pffHash)
+	if err != nil {
+		return fmt.Errorf("failed to query PFF blacklist: %w", err)
+	}
+	if blacklisted {
+		return fmt.Errorf("PFF hash is blacklisted")
+	}
+
+	return nil
+}
+
+// MockSignatureVerifier is a mock implementation for testing
+// A signature is valid if it equals SHA-256(did | message), as produced by Sign.
+type MockSignatureVerifier struct{}
+
+// NewMockSignatureVerifier creates a mock signature verifier
+func NewMockSignatureVerifier() *MockSignatureVerifier {
+	return &MockSignatureVerifier{}
+}
+
+// Sign produces the signature the mock accepts for did over message
+func (m *MockSignatureVerifier) Sign(did string, message []byte) []byte {
+	hash := sha256.Sum256(append([]byte(did+"|"), message...))
+	return hash[:]
+}
+
+// Verify implements the SignatureVerifier interface
+func (m *MockSignatureVerifier) Verify(did string, message []byte, sig []byte) error {
+	if !bytes.Equal(sig, m.Sign(did, message)) {
+		return fmt.Errorf("signature does not match DID key")
+	}
+	return nil
+}
+
+// MockBlacklistChecker is an in-memory blacklist for testing
+type MockBlacklistChecker struct {
+	hashes map[string]bool
+	mu     sync.RWMutex
+}
+
+// NewMockBlacklistChecker creates an empty mock blacklist
+func NewMockBlacklistChecker() *MockBlacklistChecker {
+	return &MockBlacklistChecker{
+		hashes: make(map[string]bool),
+	}
+}
+
+// Add blacklists a PFF hash
+func (m *MockBlacklistChecker) Add(pffHash string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.hashes[pffHash] = true
+}
+
+// IsBlacklisted implements the BlacklistChecker interface
+func (m *MockBlacklistChecker) IsBlacklisted(ctx context.Context, pffHash string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.hashes[pffHash], nil
+}
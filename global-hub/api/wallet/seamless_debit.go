@@ -12,6 +12,10 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/guard"
+	"github.com/sovrn-protocol/sovrn/hub/api/pricing"
+	"github.com/sovrn-protocol/sovrn/hub/api/waiver"
 )
 
 // TransactionType represents the type of biometric payment
@@ -22,16 +26,14 @@ const (
 	TransactionTypeStandard  TransactionType = "standard"    // 10 SOV fee
 )
 
-// GetFeeAmount returns the fee amount in uSOV for a transaction type
+// GetFeeAmount returns the default fee amount in uSOV for a transaction type
+// Handshakes resolve fees through their PricingService; this reflects the default schedule only.
 func (tt TransactionType) GetFeeAmount() int64 {
-	switch tt {
-	case TransactionTypeFastTrack:
-		return 1_000_000 // 1 SOV = 1,000,000 uSOV
-	case TransactionTypeStandard:
-		return 10_000_000 // 10 SOV = 10,000,000 uSOV
-	default:
+	fee, err := pricing.NewPricingService().VerificationFee(string(tt))
+	if err != nil {
 		return 0
 	}
+	return fee
 }
 
 // ProofOfPresence represents a validated PFF liveness proof
@@ -56,22 +58,86 @@ type BiometricPaymentResult struct {
 	PFFHash         string          `json:"pff_hash"`
 	LivenessScore   uint8           `json:"liveness_score"`
 	Status          string          `json:"status"`            // "success", "failed"
+	WaiverReason    string          `json:"waiver_reason,omitempty"` // Set when the fee was waived (FeeAmount 0)
+	VaultVerified   bool            `json:"vault_verified,omitempty"` // Set when this payment promoted the vault from pending to verified
 	ErrorMessage    string          `json:"error_message,omitempty"`
 	Timestamp       time.Time       `json:"timestamp"`
 }
 
 // SeamlessDebitHandshake manages autonomous biometric payments
 type SeamlessDebitHandshake struct {
-	vaultMgr *SovereignVaultManager
+	vaultMgr          *SovereignVaultManager
+	signatureVerifier SignatureVerifier         // Verifies proof signatures against DID keys
+	blacklist         BlacklistChecker          // VLT_Core PFF hash blacklist
+	paymentGuard      *guard.PaymentGuard       // Optional kill-switch checked before every debit
+	usedProofs        *UsedProofRegistry        // Optional anti-replay registry (survives restarts if store is durable)
+	feeWaivers        *waiver.FeeWaiverRegistry // Optional DID/program fee exemptions
+	pricing           *pricing.PricingService   // Fee schedule (defaults unless shared via SetPricingService)
+	autoVerify        bool                      // Promote pending vaults to verified on their first successful payment
 }
 
 // NewSeamlessDebitHandshake creates a new seamless debit handshake
-func NewSeamlessDebitHandshake(vaultMgr *SovereignVaultManager) *SeamlessDebitHandshake {
+// Proofs are only accepted if signatureVerifier validates their signature and
+// blacklist does not list their PFF hash.
+func NewSeamlessDebitHandshake(vaultMgr *SovereignVaultManager, signatureVerifier SignatureVerifier, blacklist BlacklistChecker) *SeamlessDebitHandshake {
 	return &SeamlessDebitHandshake{
-		vaultMgr: vaultMgr,
+		vaultMgr:          vaultMgr,
+		signatureVerifier: signatureVerifier,
+		blacklist:         blacklist,
+		pricing:           pricing.NewPricingService(),
+		autoVerify:        true,
 	}
 }
 
+// SetPaymentGuard attaches the kill-switch that can halt autonomous biometric payments
+func (sdh *SeamlessDebitHandshake) SetPaymentGuard(paymentGuard *guard.PaymentGuard) {
+	sdh.paymentGuard = paymentGuard
+}
+
+// SetUsedProofRegistry rejects proofs already used for a payment within the replay window
+func (sdh *SeamlessDebitHandshake) SetUsedProofRegistry(registry *UsedProofRegistry) {
+	sdh.usedProofs = registry
+}
+
+// SetFeeWaivers sets the registry of DIDs and programs exempt from fees
+func (sdh *SeamlessDebitHandshake) SetFeeWaivers(registry *waiver.FeeWaiverRegistry) {
+	sdh.feeWaivers = registry
+}
+
+// SetPricingService shares the hub-wide fee schedule with this handshake
+func (sdh *SeamlessDebitHandshake) SetPricingService(pricingService *pricing.PricingService) {
+	sdh.pricing = pricingService
+}
+
+// SetAutoVerify enables or disables promoting pending vaults to verified on their first successful payment (enabled by default)
+func (sdh *SeamlessDebitHandshake) SetAutoVerify(enabled bool) {
+	sdh.autoVerify = enabled
+}
+
+// promoteVault promotes a pending vault to verified after a successful validated PFF payment
+// Returns whether the vault was promoted. A failed promotion never fails the payment.
+func (sdh *SeamlessDebitHandshake) promoteVault(ctx context.Context, userID string) bool {
+	if !sdh.autoVerify {
+		return false
+	}
+
+	promoted, err := sdh.vaultMgr.PromoteToVerified(ctx, userID)
+	if err != nil {
+		fmt.Printf("⚠️  Failed to verify vault for %s after PFF payment: %v\n", userID, err)
+		return false
+	}
+	return promoted
+}
+
+// feeFor returns the verification fee for a transaction type (0 if unpriced)
+func (sdh *SeamlessDebitHandshake) feeFor(txType TransactionType) int64 {
+	fee, err := sdh.pricing.VerificationFee(string(txType))
+	if err != nil {
+		return 0
+	}
+	return fee
+}
+
 // ExecuteBiometricPayment executes an autonomous payment based on PFF validation
 //
 // AUTONOMOUS LOGIC:
@@ -95,12 +161,12 @@ func (sdh *SeamlessDebitHandshake) ExecuteBiometricPayment(
 	startTime := time.Now()
 	
 	// 1. Validate Proof_of_Presence
-	if err := sdh.validateProofOfPresence(proof); err != nil {
+	if err := sdh.validateProofOfPresence(ctx, proof); err != nil {
 		return &BiometricPaymentResult{
 			TransactionID:   uuid.New().String(),
 			DID:             proof.DID,
 			TransactionType: txType,
-			FeeAmount:       txType.GetFeeAmount(),
+			FeeAmount:       sdh.feeFor(txType),
 			PFFHash:         proof.PFFHash,
 			LivenessScore:   proof.LivenessScore,
 			Status:          "failed",
@@ -109,11 +175,45 @@ func (sdh *SeamlessDebitHandshake) ExecuteBiometricPayment(
 		}, err
 	}
 
+	// Reject proofs already used within the replay window
+	paid := false
+	if sdh.usedProofs != nil {
+		if err := sdh.usedProofs.CheckAndMark(ctx, proof.PFFHash, proof.Timestamp); err != nil {
+			return &BiometricPaymentResult{
+				TransactionID:   uuid.New().String(),
+				DID:             proof.DID,
+				TransactionType: txType,
+				FeeAmount:       sdh.feeFor(txType),
+				PFFHash:         proof.PFFHash,
+				LivenessScore:   proof.LivenessScore,
+				Status:          "failed",
+				ErrorMessage:    err.Error(),
+				Timestamp:       time.Now(),
+			}, err
+		}
+
+		// The proof stays marked only if the payment goes through; any failure below releases it
+		defer func() {
+			if !paid {
+				if err := sdh.usedProofs.Release(ctx, proof.PFFHash); err != nil {
+					fmt.Printf("Warning: failed to release proof %s after failed payment: %v\n", proof.PFFHash, err)
+				}
+			}
+		}()
+	}
+
 	// 2. Extract user ID from DID
 	userID := proof.DID // In production, parse DID to get user ID
 
-	// 3. Get fee amount
-	feeAmount := txType.GetFeeAmount()
+	// 3. Get fee amount (waived identities are recorded as exempt instead of debited)
+	feeAmount := sdh.feeFor(txType)
+	if sdh.feeWaivers != nil {
+		if feeWaiver, waived := sdh.feeWaivers.Lookup(proof.DID); waived {
+			result, err := sdh.recordExemptPayment(ctx, userID, proof, txType, feeWaiver)
+			paid = err == nil
+			return result, err
+		}
+	}
 
 	// 4. Get current balance
 	vault, err := sdh.vaultMgr.GetVault(ctx, userID)
@@ -134,6 +234,25 @@ func (sdh *SeamlessDebitHandshake) ExecuteBiometricPayment(
 
 	balanceBefore := vault.Balance
 
+	// Refuse to debit while the payment kill-switch is active or above the fee ceiling
+	if sdh.paymentGuard != nil {
+		if err := sdh.paymentGuard.Authorize(feeAmount); err != nil {
+			return &BiometricPaymentResult{
+				TransactionID:   uuid.New().String(),
+				UserID:          userID,
+				DID:             proof.DID,
+				TransactionType: txType,
+				FeeAmount:       feeAmount,
+				BalanceBefore:   balanceBefore,
+				PFFHash:         proof.PFFHash,
+				LivenessScore:   proof.LivenessScore,
+				Status:          "failed",
+				ErrorMessage:    err.Error(),
+				Timestamp:       time.Now(),
+			}, err
+		}
+	}
+
 	// 5. AUTONOMOUS DEBIT: Deduct fee from Sovereign_Vault
 	txID, err := sdh.vaultMgr.DebitVault(ctx, userID, feeAmount, string(txType), proof.PFFHash)
 	if err != nil {
@@ -152,13 +271,18 @@ func (sdh *SeamlessDebitHandshake) ExecuteBiometricPayment(
 		}, err
 	}
 
-	// 6. Get updated balance
+	paid = true
+
+	// 6. First successful payment verifies a pending vault (dividend eligibility)
+	vaultVerified := sdh.promoteVault(ctx, userID)
+
+	// 7. Get updated balance
 	vaultAfter, _ := sdh.vaultMgr.GetVault(ctx, userID)
 	balanceAfter := vaultAfter.Balance
 
 	executionTime := time.Since(startTime)
 
-	// 7. Return success result
+	// 8. Return success result
 	return &BiometricPaymentResult{
 		TransactionID:   txID,
 		UserID:          userID,
@@ -170,6 +294,55 @@ func (sdh *SeamlessDebitHandshake) ExecuteBiometricPayment(
 		PFFHash:         proof.PFFHash,
 		LivenessScore:   proof.LivenessScore,
 		Status:          "success",
+		VaultVerified:   vaultVerified,
+		Timestamp:       time.Now(),
+	}, nil
+}
+
+// recordExemptPayment records a waived payment as a zero-amount exempt transaction
+func (sdh *SeamlessDebitHandshake) recordExemptPayment(
+	ctx context.Context,
+	userID string,
+	proof *ProofOfPresence,
+	txType TransactionType,
+	feeWaiver *waiver.FeeWaiver,
+) (*BiometricPaymentResult, error) {
+	txID, err := sdh.vaultMgr.RecordExemptTransaction(ctx, userID, string(txType), proof.PFFHash, feeWaiver.Reason)
+	if err != nil {
+		return &BiometricPaymentResult{
+			TransactionID:   uuid.New().String(),
+			UserID:          userID,
+			DID:             proof.DID,
+			TransactionType: txType,
+			PFFHash:         proof.PFFHash,
+			LivenessScore:   proof.LivenessScore,
+			Status:          "failed",
+			WaiverReason:    feeWaiver.Reason,
+			ErrorMessage:    fmt.Sprintf("Exempt transaction failed: %v", err),
+			Timestamp:       time.Now(),
+		}, err
+	}
+
+	vaultVerified := sdh.promoteVault(ctx, userID)
+
+	vault, err := sdh.vaultMgr.GetVault(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BiometricPaymentResult{
+		TransactionID:   txID,
+		UserID:          userID,
+		DID:             proof.DID,
+		TransactionType: txType,
+		FeeAmount:       0,
+		BalanceBefore:   vault.Balance,
+		BalanceAfter:    vault.Balance,
+		PFFHash:         proof.PFFHash,
+		LivenessScore:   proof.LivenessScore,
+		Status:          "success",
+		WaiverReason:    feeWaiver.Reason,
+		VaultVerified:   vaultVerified,
 		Timestamp:       time.Now(),
 	}, nil
 }
@@ -182,7 +355,7 @@ func (sdh *SeamlessDebitHandshake) ExecuteBiometricPayment(
 // 3. Timestamp must not be expired (< 5 minutes old)
 // 4. Signature must be valid
 // 5. PFF hash must not be blacklisted
-func (sdh *SeamlessDebitHandshake) validateProofOfPresence(proof *ProofOfPresence) error {
+func (sdh *SeamlessDebitHandshake) validateProofOfPresence(ctx context.Context, proof *ProofOfPresence) error {
 	// 1. Check AI validation result
 	if !proof.IsValid {
 		return fmt.Errorf("AI validation failed: proof marked as invalid")
@@ -201,14 +374,15 @@ func (sdh *SeamlessDebitHandshake) validateProofOfPresence(proof *ProofOfPresenc
 		return fmt.Errorf("proof expired: age %v exceeds maximum %v", proofAge, MaxProofAge)
 	}
 
-	// 4. Verify signature (in production, use proper cryptographic verification)
-	if len(proof.Signature) == 0 {
-		return fmt.Errorf("missing signature")
+	// 4. Verify signature against the DID's key
+	if err := sdh.verifyProofSignature(proof); err != nil {
+		return err
 	}
 
-	// 5. Check if PFF hash is blacklisted (in production, query VLT_Core blacklist)
-	// This would query the blockchain's Consensus_of_Presence blacklist
-	// For now, we assume it's not blacklisted
+	// 5. Check the VLT_Core (Consensus_of_Presence) blacklist
+	if err := sdh.checkBlacklist(ctx, proof.PFFHash); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,138 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newTestHandshake returns a handshake with a replay registry and one empty vault for did
+func newTestHandshake(t *testing.T, did string) (*SeamlessDebitHandshake, *SovereignVaultManager) {
+	t.Helper()
+
+	vaultMgr := NewSovereignVaultManager()
+	if _, err := vaultMgr.CreateVault(context.Background(), did, did); err != nil {
+		t.Fatalf("CreateVault: %v", err)
+	}
+
+	registry, err := NewUsedProofRegistry(NewMemoryUsedProofStore(), DefaultReplayWindow)
+	if err != nil {
+		t.Fatalf("NewUsedProofRegistry: %v", err)
+	}
+
+	sdh := NewSeamlessDebitHandshake(vaultMgr, NewMockSignatureVerifier(), NewMockBlacklistChecker())
+	sdh.SetUsedProofRegistry(registry)
+
+	return sdh, vaultMgr
+}
+
+// signedProof returns a valid Proof_of_Presence for did signed with the mock verifier
+func signedProof(did string, pffHash string) *ProofOfPresence {
+	proof := &ProofOfPresence{
+		PFFHash:       pffHash,
+		DID:           did,
+		LivenessScore: 95,
+		Timestamp:     time.Now(),
+		IsValid:       true,
+	}
+	proof.Signature = NewMockSignatureVerifier().Sign(did, ProofMessage(proof))
+	return proof
+}
+
+func TestExecuteBiometricPaymentReleasesProofOnFailedDebit(t *testing.T) {
+	ctx := context.Background()
+	did := "did:sovra:ng:traveler-1"
+	sdh, vaultMgr := newTestHandshake(t, did)
+	proof := signedProof(did, "pff-hash-1")
+
+	// Empty vault: the debit fails and the proof must stay usable
+	if _, err := sdh.ExecuteBiometricPayment(ctx, proof, TransactionTypeFastTrack); err == nil {
+		t.Fatal("payment from an empty vault succeeded")
+	}
+
+	if _, err := vaultMgr.CreditVault(ctx, did, 10*sdh.feeFor(TransactionTypeFastTrack), "test_funding"); err != nil {
+		t.Fatalf("CreditVault: %v", err)
+	}
+
+	result, err := sdh.ExecuteBiometricPayment(ctx, proof, TransactionTypeFastTrack)
+	if err != nil {
+		t.Fatalf("retry with the same proof after a failed debit: %v", err)
+	}
+	if result.Status != "success" {
+		t.Fatalf("retry status = %q, want success", result.Status)
+	}
+
+	// The paid proof stays marked: replaying it is rejected without a second debit
+	balance := result.BalanceAfter
+	if _, err := sdh.ExecuteBiometricPayment(ctx, proof, TransactionTypeFastTrack); !errors.Is(err, ErrProofReplayed) {
+		t.Fatalf("replay error = %v, want ErrProofReplayed", err)
+	}
+
+	vault, err := vaultMgr.GetVault(ctx, did)
+	if err != nil {
+		t.Fatalf("GetVault: %v", err)
+	}
+	if vault.Balance != balance {
+		t.Errorf("balance after replay = %d, want %d", vault.Balance, balance)
+	}
+}
+
+func TestUsedProofStores(t *testing.T) {
+	fileStore, err := NewFileUsedProofStore(filepath.Join(t.TempDir(), "used_proofs.json"))
+	if err != nil {
+		t.Fatalf("NewFileUsedProofStore: %v", err)
+	}
+
+	stores := map[string]UsedProofStore{
+		"memory": NewMemoryUsedProofStore(),
+		"file":   fileStore,
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			registry, err := NewUsedProofRegistry(store, DefaultReplayWindow)
+			if err != nil {
+				t.Fatalf("NewUsedProofRegistry: %v", err)
+			}
+
+			now := time.Now()
+			if err := registry.CheckAndMark(ctx, "pff-hash", now); err != nil {
+				t.Fatalf("first CheckAndMark: %v", err)
+			}
+			if err := registry.CheckAndMark(ctx, "pff-hash", now); !errors.Is(err, ErrProofReplayed) {
+				t.Fatalf("second CheckAndMark error = %v, want ErrProofReplayed", err)
+			}
+
+			if err := registry.Release(ctx, "pff-hash"); err != nil {
+				t.Fatalf("Release: %v", err)
+			}
+			if err := registry.CheckAndMark(ctx, "pff-hash", now); err != nil {
+				t.Errorf("CheckAndMark after Release: %v", err)
+			}
+		})
+	}
+}
+
+func TestFileUsedProofStoreSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "used_proofs.json")
+
+	store, err := NewFileUsedProofStore(path)
+	if err != nil {
+		t.Fatalf("NewFileUsedProofStore: %v", err)
+	}
+	if fresh, err := store.MarkUsed(ctx, "pff-hash", time.Now().Add(time.Minute)); err != nil || !fresh {
+		t.Fatalf("MarkUsed = %v, %v; want true, nil", fresh, err)
+	}
+
+	reopened, err := NewFileUsedProofStore(path)
+	if err != nil {
+		t.Fatalf("reopen: %v", err)
+	}
+	if fresh, err := reopened.MarkUsed(ctx, "pff-hash", time.Now().Add(time.Minute)); err != nil || fresh {
+		t.Errorf("MarkUsed after restart = %v, %v; want false, nil", fresh, err)
+	}
+}
@@ -9,10 +9,13 @@ package wallet
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/sovrn-protocol/sovrn/hub/api/historycursor"
 )
 
 // SovereignVault represents a user's SOV balance
@@ -23,6 +26,8 @@ type SovereignVault struct {
 	Status    string    `json:"status"`           // "verified", "pending", "suspended"
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	ReservedBalance int64 `json:"reserved_balance"` // uSOV the owner keeps back from debits and transfers (SetReserved)
 }
 
 // VaultTransaction represents a vault transaction
@@ -30,43 +35,115 @@ type VaultTransaction struct {
 	TransactionID string                 `json:"transaction_id"`
 	UserID        string                 `json:"user_id"`
 	DID           string                 `json:"did"`
-	Type          string                 `json:"type"`           // "credit", "debit"
+	Type          string                 `json:"type"`           // "credit", "debit", "exempt", "reversal", "transfer_out", "transfer_in"
 	Amount        int64                  `json:"amount"`         // uSOV
 	BalanceBefore int64                  `json:"balance_before"`
 	BalanceAfter  int64                  `json:"balance_after"`
 	Purpose       string                 `json:"purpose"`        // "fast_track", "standard", "integrity_dividend", etc.
 	PFFHash       string                 `json:"pff_hash,omitempty"` // Associated PFF hash (for payments)
+	ReversalOf    string                 `json:"reversal_of,omitempty"` // Debit this reversal credits back
+	ReversedBy    string                 `json:"reversed_by,omitempty"` // Reversal that credited this debit back
+	TransferID    string                 `json:"transfer_id,omitempty"` // Shared by both legs of a vault transfer
 	Metadata      map[string]interface{} `json:"metadata,omitempty"`
 	Timestamp     time.Time              `json:"timestamp"`
 	Status        string                 `json:"status"`         // "success", "failed"
 }
 
+// VaultCreationPolicy controls whether missing vaults are created on demand
+type VaultCreationPolicy string
+
+const (
+	VaultCreationLenient VaultCreationPolicy = "lenient" // Missing vaults are auto-created (default)
+	VaultCreationStrict  VaultCreationPolicy = "strict"  // Missing vaults are an error; use CreateVault
+)
+
 // SovereignVaultManager manages user vaults
 type SovereignVaultManager struct {
-	vaults       map[string]*SovereignVault
-	transactions map[string]*VaultTransaction
-	mu           sync.RWMutex
+	store          VaultStore // Vaults and transaction log (see vault_store.go)
+	creationPolicy VaultCreationPolicy
+	mu             sync.RWMutex
+
+	// Transaction log compaction (see vault_compaction.go)
+	archive         VaultArchive  // Cold storage for compacted transactions (nil = no compaction)
+	compactedBefore time.Time     // Newest compaction cutoff this process has run
+	stopCompaction  chan struct{} // Closes the StartCompaction loop
 }
 
-// NewSovereignVaultManager creates a new vault manager
+// NewSovereignVaultManager creates a new vault manager backed by an in-memory store
 func NewSovereignVaultManager() *SovereignVaultManager {
 	return &SovereignVaultManager{
-		vaults:       make(map[string]*SovereignVault),
-		transactions: make(map[string]*VaultTransaction),
+		store:          NewMemoryVaultStore(),
+		creationPolicy: VaultCreationLenient,
+	}
+}
+
+// NewSovereignVaultManagerWithStore creates a vault manager backed by the given store
+func NewSovereignVaultManagerWithStore(store VaultStore) (*SovereignVaultManager, error) {
+	if store == nil {
+		return nil, fmt.Errorf("vault store required")
+	}
+
+	return &SovereignVaultManager{
+		store:          store,
+		creationPolicy: VaultCreationLenient,
+	}, nil
+}
+
+// SetCreationPolicy sets whether missing vaults are auto-created (lenient) or rejected (strict)
+func (svm *SovereignVaultManager) SetCreationPolicy(policy VaultCreationPolicy) error {
+	if policy != VaultCreationLenient && policy != VaultCreationStrict {
+		return fmt.Errorf("invalid vault creation policy: %s", policy)
+	}
+
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	svm.creationPolicy = policy
+	return nil
+}
+
+// CreateVault explicitly creates a vault for a user
+// Returns an error if the vault already exists
+func (svm *SovereignVaultManager) CreateVault(ctx context.Context, userID string, did string) (*SovereignVault, error) {
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	_, exists, err := svm.store.GetVault(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load vault for user %s: %w", userID, err)
 	}
+	if exists {
+		return nil, fmt.Errorf("vault already exists for user: %s", userID)
+	}
+
+	return svm.createVaultLocked(ctx, userID, did)
 }
 
 // GetOrCreateVault gets or creates a vault for a user
+// In strict mode, a missing vault is an error instead of being created
 func (svm *SovereignVaultManager) GetOrCreateVault(ctx context.Context, userID string, did string) (*SovereignVault, error) {
 	svm.mu.Lock()
 	defer svm.mu.Unlock()
 
 	// Check if vault exists
-	if vault, exists := svm.vaults[userID]; exists {
+	vault, exists, err := svm.store.GetVault(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load vault for user %s: %w", userID, err)
+	}
+	if exists {
 		return vault, nil
 	}
 
-	// Create new vault
+	// Strict policy: vaults must be created explicitly
+	if svm.creationPolicy == VaultCreationStrict {
+		return nil, fmt.Errorf("vault not found for user: %s (strict creation policy, create it explicitly)", userID)
+	}
+
+	return svm.createVaultLocked(ctx, userID, did)
+}
+
+// createVaultLocked creates a new pending vault (caller must hold svm.mu)
+func (svm *SovereignVaultManager) createVaultLocked(ctx context.Context, userID string, did string) (*SovereignVault, error) {
 	vault := &SovereignVault{
 		UserID:    userID,
 		DID:       did,
@@ -76,7 +153,10 @@ func (svm *SovereignVaultManager) GetOrCreateVault(ctx context.Context, userID s
 		UpdatedAt: time.Now(),
 	}
 
-	svm.vaults[userID] = vault
+	if err := svm.store.PutVault(ctx, vault); err != nil {
+		return nil, fmt.Errorf("failed to persist vault for user %s: %w", userID, err)
+	}
+
 	return vault, nil
 }
 
@@ -85,12 +165,7 @@ func (svm *SovereignVaultManager) GetVault(ctx context.Context, userID string) (
 	svm.mu.RLock()
 	defer svm.mu.RUnlock()
 
-	vault, exists := svm.vaults[userID]
-	if !exists {
-		return nil, fmt.Errorf("vault not found for user: %s", userID)
-	}
-
-	return vault, nil
+	return svm.getVaultLocked(ctx, userID)
 }
 
 // CreditVault credits a user's vault
@@ -98,12 +173,13 @@ func (svm *SovereignVaultManager) CreditVault(ctx context.Context, userID string
 	svm.mu.Lock()
 	defer svm.mu.Unlock()
 
-	vault, exists := svm.vaults[userID]
-	if !exists {
-		return "", fmt.Errorf("vault not found for user: %s", userID)
+	vault, err := svm.getVaultLocked(ctx, userID)
+	if err != nil {
+		return "", err
 	}
 
 	// Record balance before
+	before := *vault
 	balanceBefore := vault.Balance
 
 	// Credit balance
@@ -125,7 +201,9 @@ func (svm *SovereignVaultManager) CreditVault(ctx context.Context, userID string
 		Status:        "success",
 	}
 
-	svm.transactions[txID] = tx
+	if err := svm.commitLocked(ctx, vault, before, tx); err != nil {
+		return "", err
+	}
 
 	return txID, nil
 }
@@ -135,17 +213,18 @@ func (svm *SovereignVaultManager) DebitVault(ctx context.Context, userID string,
 	svm.mu.Lock()
 	defer svm.mu.Unlock()
 
-	vault, exists := svm.vaults[userID]
-	if !exists {
-		return "", fmt.Errorf("vault not found for user: %s", userID)
+	vault, err := svm.getVaultLocked(ctx, userID)
+	if err != nil {
+		return "", err
 	}
 
-	// Check sufficient balance
-	if vault.Balance < amount {
-		return "", fmt.Errorf("insufficient balance: have %d uSOV, need %d uSOV", vault.Balance, amount)
+	// Check sufficient spendable balance (reserved funds cannot be debited)
+	if err := checkSpendable(vault, amount); err != nil {
+		return "", err
 	}
 
 	// Record balance before
+	before := *vault
 	balanceBefore := vault.Balance
 
 	// Debit balance
@@ -168,7 +247,43 @@ func (svm *SovereignVaultManager) DebitVault(ctx context.Context, userID string,
 		Status:        "success",
 	}
 
-	svm.transactions[txID] = tx
+	if err := svm.commitLocked(ctx, vault, before, tx); err != nil {
+		return "", err
+	}
+
+	return txID, nil
+}
+
+// RecordExemptTransaction records a fee-waived payment (amount 0, balance untouched)
+// The waiver reason is kept in the transaction metadata for accounting.
+func (svm *SovereignVaultManager) RecordExemptTransaction(ctx context.Context, userID string, purpose string, pffHash string, waiverReason string) (string, error) {
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	vault, err := svm.getVaultLocked(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	txID := uuid.New().String()
+	tx := &VaultTransaction{
+		TransactionID: txID,
+		UserID:        userID,
+		DID:           vault.DID,
+		Type:          "exempt",
+		Amount:        0,
+		BalanceBefore: vault.Balance,
+		BalanceAfter:  vault.Balance,
+		Purpose:       purpose,
+		PFFHash:       pffHash,
+		Metadata:      map[string]interface{}{"waiver_reason": waiverReason},
+		Timestamp:     time.Now(),
+		Status:        "success",
+	}
+
+	if err := svm.store.AppendTransaction(ctx, tx); err != nil {
+		return "", fmt.Errorf("failed to record transaction %s: %w", txID, err)
+	}
 
 	return txID, nil
 }
@@ -179,8 +294,13 @@ func (svm *SovereignVaultManager) GetVerifiedDIDs(ctx context.Context) ([]string
 	svm.mu.RLock()
 	defer svm.mu.RUnlock()
 
+	vaults, err := svm.store.ListVaults(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vaults: %w", err)
+	}
+
 	var verifiedDIDs []string
-	for _, vault := range svm.vaults {
+	for _, vault := range vaults {
 		if vault.Status == "verified" {
 			verifiedDIDs = append(verifiedDIDs, vault.DID)
 		}
@@ -194,9 +314,9 @@ func (svm *SovereignVaultManager) UpdateVaultStatus(ctx context.Context, userID
 	svm.mu.Lock()
 	defer svm.mu.Unlock()
 
-	vault, exists := svm.vaults[userID]
-	if !exists {
-		return fmt.Errorf("vault not found for user: %s", userID)
+	vault, err := svm.getVaultLocked(ctx, userID)
+	if err != nil {
+		return err
 	}
 
 	// Validate status
@@ -210,30 +330,129 @@ func (svm *SovereignVaultManager) UpdateVaultStatus(ctx context.Context, userID
 		return fmt.Errorf("invalid status: %s", status)
 	}
 
+	before := *vault
 	vault.Status = status
 	vault.UpdatedAt = time.Now()
 
+	if err := svm.store.PutVault(ctx, vault); err != nil {
+		*vault = before
+		return fmt.Errorf("failed to persist vault for user %s: %w", userID, err)
+	}
+
 	return nil
 }
 
-// GetTransactionHistory gets transaction history for a user
-func (svm *SovereignVaultManager) GetTransactionHistory(ctx context.Context, userID string, limit int) ([]*VaultTransaction, error) {
+// PromoteToVerified moves a vault from "pending" to "verified"
+// Called after the vault's first successful validated PFF payment. Vaults that are
+// already verified or suspended are left unchanged. Returns whether the vault was promoted.
+func (svm *SovereignVaultManager) PromoteToVerified(ctx context.Context, userID string) (bool, error) {
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	vault, err := svm.getVaultLocked(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	if vault.Status != "pending" {
+		return false, nil
+	}
+
+	before := *vault
+	vault.Status = "verified"
+	vault.UpdatedAt = time.Now()
+
+	if err := svm.store.PutVault(ctx, vault); err != nil {
+		*vault = before
+		return false, fmt.Errorf("failed to persist vault for user %s: %w", userID, err)
+	}
+
+	fmt.Printf("✅ Vault %s verified after first PFF payment\n", userID)
+
+	return true, nil
+}
+
+// GetTransactionHistory gets a page of transaction history for a user, most recent first
+// Pass the returned cursor to fetch the next page; it is empty once history is exhausted.
+// A limit <= 0 returns everything after the cursor.
+func (svm *SovereignVaultManager) GetTransactionHistory(ctx context.Context, userID string, limit int, cursor string) ([]*VaultTransaction, string, error) {
+	after, err := historycursor.Decode(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
 	svm.mu.RLock()
 	defer svm.mu.RUnlock()
 
+	txs, err := svm.store.ListTransactions(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list transactions: %w", err)
+	}
+
 	var userTxs []*VaultTransaction
-	for _, tx := range svm.transactions {
-		if tx.UserID == userID {
-			userTxs = append(userTxs, tx)
+	for _, tx := range txs {
+		if tx.UserID != userID {
+			continue
+		}
+		if after != nil && !after.Precedes(tx.Timestamp, tx.TransactionID) {
+			continue
 		}
+		userTxs = append(userTxs, tx)
 	}
 
-	// In production, sort by timestamp (most recent first)
-	if len(userTxs) > limit && limit > 0 {
-		userTxs = userTxs[:limit]
+	// Hot transactions cannot fill the page -> continue into the archive (older than any hot transaction)
+	if svm.archive != nil && (limit <= 0 || len(userTxs) <= limit) {
+		archived, err := svm.archive.ListArchivedTransactions(ctx, userID)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to list archived transactions: %w", err)
+		}
+
+		older := make([]*VaultTransaction, 0, len(archived))
+		for _, tx := range archived {
+			if after == nil || after.Precedes(tx.Timestamp, tx.TransactionID) {
+				older = append(older, tx)
+			}
+		}
+		userTxs = mergeArchived(userTxs, older)
+	}
+
+	// Sort by timestamp (most recent first), ties by transaction ID
+	sort.Slice(userTxs, func(i, j int) bool {
+		if userTxs[i].Timestamp.Equal(userTxs[j].Timestamp) {
+			return userTxs[i].TransactionID > userTxs[j].TransactionID
+		}
+		return userTxs[i].Timestamp.After(userTxs[j].Timestamp)
+	})
+
+	if limit <= 0 || len(userTxs) <= limit {
+		return userTxs, "", nil
+	}
+
+	userTxs = userTxs[:limit]
+	last := userTxs[limit-1]
+
+	return userTxs, historycursor.Encode(last.Timestamp, last.TransactionID), nil
+}
+
+// GetAllTransactions returns every vault transaction, archived ones included (used for reconciliation)
+func (svm *SovereignVaultManager) GetAllTransactions(ctx context.Context) ([]*VaultTransaction, error) {
+	svm.mu.RLock()
+	defer svm.mu.RUnlock()
+
+	return svm.listTransactionsLocked(ctx, time.Time{})
+}
+
+// GetAllVaults returns every vault (used for reconciliation)
+func (svm *SovereignVaultManager) GetAllVaults(ctx context.Context) ([]*SovereignVault, error) {
+	svm.mu.RLock()
+	defer svm.mu.RUnlock()
+
+	vaults, err := svm.store.ListVaults(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vaults: %w", err)
 	}
 
-	return userTxs, nil
+	return vaults, nil
 }
 
 // GetVaultByDID gets a vault by DID
@@ -241,7 +460,12 @@ func (svm *SovereignVaultManager) GetVaultByDID(ctx context.Context, did string)
 	svm.mu.RLock()
 	defer svm.mu.RUnlock()
 
-	for _, vault := range svm.vaults {
+	vaults, err := svm.store.ListVaults(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vaults: %w", err)
+	}
+
+	for _, vault := range vaults {
 		if vault.DID == did {
 			return vault, nil
 		}
@@ -250,3 +474,41 @@ func (svm *SovereignVaultManager) GetVaultByDID(ctx context.Context, did string)
 	return nil, fmt.Errorf("vault not found for DID: %s", did)
 }
 
+
+// SnapshotVaults returns a copy of every vault taken under the manager lock
+// Unlike GetAllVaults, the copies are safe to read while payments run.
+func (svm *SovereignVaultManager) SnapshotVaults(ctx context.Context) ([]SovereignVault, error) {
+	svm.mu.RLock()
+	defer svm.mu.RUnlock()
+
+	vaults, err := svm.store.ListVaults(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vaults: %w", err)
+	}
+
+	snapshots := make([]SovereignVault, len(vaults))
+	for i, vault := range vaults {
+		snapshots[i] = *vault
+	}
+
+	return snapshots, nil
+}
+
+// SnapshotVaultByDID returns a copy of the vault for a DID taken under the manager lock, or false if none exists
+func (svm *SovereignVaultManager) SnapshotVaultByDID(ctx context.Context, did string) (SovereignVault, bool, error) {
+	svm.mu.RLock()
+	defer svm.mu.RUnlock()
+
+	vaults, err := svm.store.ListVaults(ctx)
+	if err != nil {
+		return SovereignVault{}, false, fmt.Errorf("failed to list vaults: %w", err)
+	}
+
+	for _, vault := range vaults {
+		if vault.DID == did {
+			return *vault, true, nil
+		}
+	}
+
+	return SovereignVault{}, false, nil
+}
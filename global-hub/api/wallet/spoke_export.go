@@ -0,0 +1,210 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Spoke Transaction Export
+//
+// Regulators auditing a National Spoke need every vault transaction touching
+// that spoke's DIDs over a period. The export is written page by page to the
+// caller's writer, so only the matching transaction IDs are held in memory and
+// the vault lock is released between pages.
+
+package wallet
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ExportFormat selects the encoding of a spoke export
+type ExportFormat string
+
+const (
+	ExportFormatCSV   ExportFormat = "csv"   // Header row, then one row per transaction
+	ExportFormatJSONL ExportFormat = "jsonl" // One JSON-encoded VaultTransaction per line
+)
+
+// DefaultExportPageSize is how many transactions are read per page of an export
+const DefaultExportPageSize = 1000
+
+// spokeExportHeader is the CSV column order of a spoke export
+var spokeExportHeader = []string{
+	"transaction_id", "did", "user_id", "type", "amount", "balance_before",
+	"balance_after", "purpose", "pff_hash", "timestamp", "status",
+}
+
+// spokeExportKey orders exported transactions without holding the records
+type spokeExportKey struct {
+	transactionID string
+	timestamp     time.Time
+}
+
+// ExportSpokeTransactions writes every vault transaction for a spoke's DIDs in [from, to) to w
+//
+// EXPORT LOGIC:
+// 1. Match transactions whose DID (did:sovra:{country}:{id}) names the spoke's country
+// 2. Order the matching IDs by timestamp (oldest first)
+// 3. Encode them to w in pages of DefaultExportPageSize, re-reading each page under the vault lock
+// Returns the number of transactions written. A cancelled context stops the export between pages.
+func (svm *SovereignVaultManager) ExportSpokeTransactions(
+	ctx context.Context,
+	spokeID string,
+	from time.Time,
+	to time.Time,
+	format ExportFormat,
+	w io.Writer,
+) (int, error) {
+	if spokeID == "" {
+		return 0, fmt.Errorf("spoke ID required")
+	}
+	if !to.After(from) {
+		return 0, fmt.Errorf("export range end must be after start")
+	}
+	if format != ExportFormatCSV && format != ExportFormatJSONL {
+		return 0, fmt.Errorf("unsupported export format: %s", format)
+	}
+
+	// 1-2. Collect and order the matching transaction IDs
+	keys, err := svm.spokeExportKeys(ctx, spokeID, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	var csvWriter *csv.Writer
+	var jsonEncoder *json.Encoder
+	if format == ExportFormatCSV {
+		csvWriter = csv.NewWriter(w)
+		if err := csvWriter.Write(spokeExportHeader); err != nil {
+			return 0, fmt.Errorf("failed to write export header: %w", err)
+		}
+	} else {
+		jsonEncoder = json.NewEncoder(w)
+	}
+
+	// 3. Stream page by page
+	written := 0
+	for start := 0; start < len(keys); start += DefaultExportPageSize {
+		if err := ctx.Err(); err != nil {
+			return written, fmt.Errorf("export cancelled after %d transactions: %w", written, err)
+		}
+
+		end := start + DefaultExportPageSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		page, err := svm.spokeExportPage(ctx, keys[start:end])
+		if err != nil {
+			return written, err
+		}
+
+		for _, tx := range page {
+			var err error
+			if csvWriter != nil {
+				err = csvWriter.Write(spokeExportRow(&tx))
+			} else {
+				err = jsonEncoder.Encode(&tx)
+			}
+			if err != nil {
+				return written, fmt.Errorf("failed to write transaction %s: %w", tx.TransactionID, err)
+			}
+			written++
+		}
+
+		if csvWriter != nil {
+			csvWriter.Flush()
+			if err := csvWriter.Error(); err != nil {
+				return written, fmt.Errorf("failed to flush export page: %w", err)
+			}
+		}
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return written, fmt.Errorf("failed to flush export: %w", err)
+		}
+	}
+
+	return written, nil
+}
+
+// spokeExportKeys returns the IDs of the spoke's transactions in [from, to), oldest first
+func (svm *SovereignVaultManager) spokeExportKeys(ctx context.Context, spokeID string, from time.Time, to time.Time) ([]spokeExportKey, error) {
+	svm.mu.RLock()
+	defer svm.mu.RUnlock()
+
+	txs, err := svm.listTransactionsLocked(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]spokeExportKey, 0)
+	for _, tx := range txs {
+		if tx.Timestamp.Before(from) || !tx.Timestamp.Before(to) {
+			continue
+		}
+		if !strings.EqualFold(didCountry(tx.DID), spokeID) {
+			continue
+		}
+		keys = append(keys, spokeExportKey{transactionID: tx.TransactionID, timestamp: tx.Timestamp})
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].timestamp.Equal(keys[j].timestamp) {
+			return keys[i].transactionID < keys[j].transactionID
+		}
+		return keys[i].timestamp.Before(keys[j].timestamp)
+	})
+
+	return keys, nil
+}
+
+// spokeExportPage copies one page of transactions out from under the vault lock
+func (svm *SovereignVaultManager) spokeExportPage(ctx context.Context, keys []spokeExportKey) ([]VaultTransaction, error) {
+	svm.mu.RLock()
+	defer svm.mu.RUnlock()
+
+	page := make([]VaultTransaction, 0, len(keys))
+	for _, key := range keys {
+		tx, exists, err := svm.getTransactionLocked(ctx, key.transactionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load transaction %s: %w", key.transactionID, err)
+		}
+		if exists {
+			page = append(page, *tx)
+		}
+	}
+
+	return page, nil
+}
+
+// spokeExportRow formats a transaction as a CSV row in spokeExportHeader order
+func spokeExportRow(tx *VaultTransaction) []string {
+	return []string{
+		tx.TransactionID,
+		tx.DID,
+		tx.UserID,
+		tx.Type,
+		strconv.FormatInt(tx.Amount, 10),
+		strconv.FormatInt(tx.BalanceBefore, 10),
+		strconv.FormatInt(tx.BalanceAfter, 10),
+		tx.Purpose,
+		tx.PFFHash,
+		tx.Timestamp.UTC().Format(time.RFC3339Nano),
+		tx.Status,
+	}
+}
+
+// didCountry extracts the country segment of a did:sovra:{country}:{id} DID ("" if malformed)
+func didCountry(did string) string {
+	parts := strings.Split(did, ":")
+	if len(parts) < 4 || parts[0] != "did" || parts[2] == "" {
+		return ""
+	}
+	return parts[2]
+}
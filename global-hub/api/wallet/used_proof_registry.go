@@ -0,0 +1,272 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - API-Layer Anti-Replay Registry
+//
+// Rejects a Proof_of_Presence that has already paid for something while it is
+// still within its validity window. The registry is backed by a pluggable
+// store so the window survives process restarts: a file-backed store is
+// provided, and production deployments can plug in Redis or the database.
+
+package wallet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultReplayWindow is how long a used proof stays rejected (matches the 5-minute proof age limit)
+const DefaultReplayWindow = 5 * time.Minute
+
+// ErrProofReplayed is returned when a proof has already been used within its window
+var ErrProofReplayed = errors.New("proof already used: replay detected")
+
+// UsedProofStore persists used proof hashes with an expiry
+type UsedProofStore interface {
+	// MarkUsed records the hash unless it is already recorded and unexpired
+	// Returns false if the hash was already in use (check-and-set must be atomic)
+	MarkUsed(ctx context.Context, pffHash string, expiresAt time.Time) (bool, error)
+
+	// Unmark removes the hash so the proof can be used again
+	Unmark(ctx context.Context, pffHash string) error
+
+	// PurgeExpired removes entries that expired before now and returns how many were removed
+	PurgeExpired(ctx context.Context, now time.Time) (int, error)
+}
+
+// UsedProofRegistry rejects replayed proofs within the replay window
+type UsedProofRegistry struct {
+	store  UsedProofStore
+	window time.Duration
+}
+
+// NewUsedProofRegistry creates a registry backed by the given store
+func NewUsedProofRegistry(store UsedProofStore, window time.Duration) (*UsedProofRegistry, error) {
+	if store == nil {
+		return nil, fmt.Errorf("used proof store required")
+	}
+	if window <= 0 {
+		return nil, fmt.Errorf("replay window must be positive")
+	}
+
+	return &UsedProofRegistry{
+		store:  store,
+		window: window,
+	}, nil
+}
+
+// CheckAndMark records a proof as used, or returns ErrProofReplayed if it already was
+// The entry expires one replay window after the proof's own timestamp.
+func (upr *UsedProofRegistry) CheckAndMark(ctx context.Context, pffHash string, proofTime time.Time) error {
+	if pffHash == "" {
+		return fmt.Errorf("PFF hash required")
+	}
+
+	fresh, err := upr.store.MarkUsed(ctx, pffHash, proofTime.Add(upr.window))
+	if err != nil {
+		return fmt.Errorf("failed to record used proof: %w", err)
+	}
+
+	if !fresh {
+		return ErrProofReplayed
+	}
+
+	return nil
+}
+
+// Release un-marks a proof whose payment did not go through, so it can be retried
+func (upr *UsedProofRegistry) Release(ctx context.Context, pffHash string) error {
+	if err := upr.store.Unmark(ctx, pffHash); err != nil {
+		return fmt.Errorf("failed to release used proof: %w", err)
+	}
+	return nil
+}
+
+// Purge removes expired entries from the store
+func (upr *UsedProofRegistry) Purge(ctx context.Context) (int, error) {
+	return upr.store.PurgeExpired(ctx, time.Now())
+}
+
+// MemoryUsedProofStore keeps used proofs in memory (lost on restart)
+type MemoryUsedProofStore struct {
+	entries map[string]time.Time // pffHash -> expiry
+	mu      sync.Mutex
+}
+
+// NewMemoryUsedProofStore creates an in-memory used proof store
+func NewMemoryUsedProofStore() *MemoryUsedProofStore {
+	return &MemoryUsedProofStore{
+		entries: make(map[string]time.Time),
+	}
+}
+
+// MarkUsed records the hash unless it is already recorded and unexpired
+func (ms *MemoryUsedProofStore) MarkUsed(ctx context.Context, pffHash string, expiresAt time.Time) (bool, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if expiry, exists := ms.entries[pffHash]; exists && time.Now().Before(expiry) {
+		return false, nil
+	}
+
+	ms.entries[pffHash] = expiresAt
+	return true, nil
+}
+
+// Unmark removes the hash so the proof can be used again
+func (ms *MemoryUsedProofStore) Unmark(ctx context.Context, pffHash string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	delete(ms.entries, pffHash)
+	return nil
+}
+
+// PurgeExpired removes entries that expired before now
+func (ms *MemoryUsedProofStore) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	return purgeExpiredEntries(ms.entries, now), nil
+}
+
+// FileUsedProofStore persists used proofs to a JSON file so the window survives restarts
+// Suitable for a single hub process; the file is rewritten atomically on every change.
+type FileUsedProofStore struct {
+	path    string
+	entries map[string]time.Time // pffHash -> expiry
+	mu      sync.Mutex
+}
+
+// NewFileUsedProofStore opens (or creates) a file-backed used proof store
+func NewFileUsedProofStore(path string) (*FileUsedProofStore, error) {
+	fs := &FileUsedProofStore{
+		path:    path,
+		entries: make(map[string]time.Time),
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read used proof store: %w", err)
+	}
+
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &fs.entries); err != nil {
+			return nil, fmt.Errorf("failed to decode used proof store: %w", err)
+		}
+	}
+
+	// Entries that expired while the process was down are no longer needed
+	purgeExpiredEntries(fs.entries, time.Now())
+
+	return fs, nil
+}
+
+// MarkUsed records the hash unless it is already recorded and unexpired
+func (fs *FileUsedProofStore) MarkUsed(ctx context.Context, pffHash string, expiresAt time.Time) (bool, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if expiry, exists := fs.entries[pffHash]; exists && time.Now().Before(expiry) {
+		return false, nil
+	}
+
+	previous, existed := fs.entries[pffHash]
+	fs.entries[pffHash] = expiresAt
+
+	if err := fs.persistLocked(); err != nil {
+		// Roll back so memory and disk agree
+		if existed {
+			fs.entries[pffHash] = previous
+		} else {
+			delete(fs.entries, pffHash)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Unmark removes the hash so the proof can be used again
+func (fs *FileUsedProofStore) Unmark(ctx context.Context, pffHash string) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	expiry, exists := fs.entries[pffHash]
+	if !exists {
+		return nil
+	}
+
+	delete(fs.entries, pffHash)
+	if err := fs.persistLocked(); err != nil {
+		// Roll back so memory and disk agree
+		fs.entries[pffHash] = expiry
+		return err
+	}
+
+	return nil
+}
+
+// PurgeExpired removes entries that expired before now
+func (fs *FileUsedProofStore) PurgeExpired(ctx context.Context, now time.Time) (int, error) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	removed := purgeExpiredEntries(fs.entries, now)
+	if removed == 0 {
+		return 0, nil
+	}
+
+	if err := fs.persistLocked(); err != nil {
+		return removed, err
+	}
+
+	return removed, nil
+}
+
+// persistLocked writes all entries via a temp file and rename (caller holds fs.mu)
+func (fs *FileUsedProofStore) persistLocked() error {
+	data, err := json.Marshal(fs.entries)
+	if err != nil {
+		return fmt.Errorf("failed to encode used proof store: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fs.path), filepath.Base(fs.path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write used proof store: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write used proof store: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write used proof store: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), fs.path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to replace used proof store: %w", err)
+	}
+
+	return nil
+}
+
+// purgeExpiredEntries deletes entries expired before now and returns how many were removed
+func purgeExpiredEntries(entries map[string]time.Time, now time.Time) int {
+	removed := 0
+	for pffHash, expiry := range entries {
+		if !now.Before(expiry) {
+			delete(entries, pffHash)
+			removed++
+		}
+	}
+	return removed
+}
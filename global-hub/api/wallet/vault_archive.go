@@ -0,0 +1,330 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Vault Transaction Archive
+//
+// Cold storage for transactions moved out of the hot VaultStore by
+// CompactTransactions, together with per-user balance snapshots. The
+// in-memory archive suits tests; FileVaultArchive keeps archived
+// transactions on disk and only reads them back when a query needs them.
+
+package wallet
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// VaultSnapshot is a user's vault balance as of their last archived transaction
+type VaultSnapshot struct {
+	UserID            string    `json:"user_id"`
+	DID               string    `json:"did"`
+	Balance           int64     `json:"balance"`             // uSOV after the last archived successful transaction
+	AsOf              time.Time `json:"as_of"`               // Timestamp of that transaction
+	LastTransactionID string    `json:"last_transaction_id"` // That transaction
+	TransactionCount  int       `json:"transaction_count"`   // Transactions archived for the user so far
+	TakenAt           time.Time `json:"taken_at"`
+}
+
+// VaultArchive stores archived transactions and balance snapshots
+// Archived transactions are immutable. ArchiveTransactions skips IDs that are
+// already archived, so a compaction interrupted after archiving can be rerun.
+type VaultArchive interface {
+	// ArchiveTransactions stores transactions removed from the hot store
+	ArchiveTransactions(ctx context.Context, txs []*VaultTransaction) error
+
+	// ListArchivedTransactions returns a user's archived transactions ("" = every user)
+	ListArchivedTransactions(ctx context.Context, userID string) ([]*VaultTransaction, error)
+
+	// GetArchivedTransaction returns an archived transaction by ID, or false if none exists
+	GetArchivedTransaction(ctx context.Context, transactionID string) (*VaultTransaction, bool, error)
+
+	// PutSnapshot creates or replaces a user's balance snapshot
+	PutSnapshot(ctx context.Context, snapshot *VaultSnapshot) error
+
+	// GetSnapshot returns a user's balance snapshot, or false if none exists
+	GetSnapshot(ctx context.Context, userID string) (*VaultSnapshot, bool, error)
+}
+
+// MemoryVaultArchive keeps archived transactions and snapshots in memory
+type MemoryVaultArchive struct {
+	transactions map[string]*VaultTransaction
+	snapshots    map[string]*VaultSnapshot
+	mu           sync.RWMutex
+}
+
+// NewMemoryVaultArchive creates an empty in-memory archive
+func NewMemoryVaultArchive() *MemoryVaultArchive {
+	return &MemoryVaultArchive{
+		transactions: make(map[string]*VaultTransaction),
+		snapshots:    make(map[string]*VaultSnapshot),
+	}
+}
+
+// ArchiveTransactions stores transactions (already archived IDs are skipped)
+func (ma *MemoryVaultArchive) ArchiveTransactions(ctx context.Context, txs []*VaultTransaction) error {
+	ma.mu.Lock()
+	defer ma.mu.Unlock()
+
+	for _, tx := range txs {
+		if _, exists := ma.transactions[tx.TransactionID]; !exists {
+			ma.transactions[tx.TransactionID] = tx
+		}
+	}
+	return nil
+}
+
+// ListArchivedTransactions returns a user's archived transactions ("" = every user)
+func (ma *MemoryVaultArchive) ListArchivedTransactions(ctx context.Context, userID string) ([]*VaultTransaction, error) {
+	ma.mu.RLock()
+	defer ma.mu.RUnlock()
+
+	txs := make([]*VaultTransaction, 0)
+	for _, tx := range ma.transactions {
+		if userID == "" || tx.UserID == userID {
+			txs = append(txs, tx)
+		}
+	}
+	return txs, nil
+}
+
+// GetArchivedTransaction returns an archived transaction by ID
+func (ma *MemoryVaultArchive) GetArchivedTransaction(ctx context.Context, transactionID string) (*VaultTransaction, bool, error) {
+	ma.mu.RLock()
+	defer ma.mu.RUnlock()
+
+	tx, exists := ma.transactions[transactionID]
+	return tx, exists, nil
+}
+
+// PutSnapshot creates or replaces a user's balance snapshot
+func (ma *MemoryVaultArchive) PutSnapshot(ctx context.Context, snapshot *VaultSnapshot) error {
+	ma.mu.Lock()
+	defer ma.mu.Unlock()
+
+	ma.snapshots[snapshot.UserID] = snapshot
+	return nil
+}
+
+// GetSnapshot returns a user's balance snapshot
+func (ma *MemoryVaultArchive) GetSnapshot(ctx context.Context, userID string) (*VaultSnapshot, bool, error) {
+	ma.mu.RLock()
+	defer ma.mu.RUnlock()
+
+	snapshot, exists := ma.snapshots[userID]
+	return snapshot, exists, nil
+}
+
+// FileVaultArchive appends archived transactions to a JSON-lines file
+// Transactions are not held in memory; queries scan the file. Snapshots are
+// small (one per user) and kept in memory, persisted to a separate JSON file.
+type FileVaultArchive struct {
+	transactionsPath string
+	snapshotsPath    string
+	snapshots        map[string]*VaultSnapshot
+	mu               sync.RWMutex
+}
+
+// NewFileVaultArchive opens (or creates) a file archive in dir
+func NewFileVaultArchive(dir string) (*FileVaultArchive, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create vault archive directory: %w", err)
+	}
+
+	fa := &FileVaultArchive{
+		transactionsPath: filepath.Join(dir, "vault_transactions.jsonl"),
+		snapshotsPath:    filepath.Join(dir, "vault_snapshots.json"),
+		snapshots:        make(map[string]*VaultSnapshot),
+	}
+
+	data, err := os.ReadFile(fa.snapshotsPath)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read vault snapshots: %w", err)
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &fa.snapshots); err != nil {
+			return nil, fmt.Errorf("failed to decode vault snapshots: %w", err)
+		}
+	}
+
+	return fa, nil
+}
+
+// ArchiveTransactions appends transactions and syncs the file (already archived IDs are skipped)
+func (fa *FileVaultArchive) ArchiveTransactions(ctx context.Context, txs []*VaultTransaction) error {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+
+	archived := make(map[string]bool)
+	if err := fa.scanLocked(func(tx *VaultTransaction) bool {
+		archived[tx.TransactionID] = true
+		return true
+	}); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(fa.transactionsPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open vault archive: %w", err)
+	}
+	defer file.Close()
+
+	writer := bufio.NewWriter(file)
+	for _, tx := range txs {
+		if archived[tx.TransactionID] {
+			continue
+		}
+		line, err := json.Marshal(tx)
+		if err != nil {
+			return fmt.Errorf("failed to encode transaction %s: %w", tx.TransactionID, err)
+		}
+		if _, err := writer.Write(append(line, '\n')); err != nil {
+			return fmt.Errorf("failed to archive transaction %s: %w", tx.TransactionID, err)
+		}
+		archived[tx.TransactionID] = true
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("failed to write vault archive: %w", err)
+	}
+
+	if err := file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync vault archive: %w", err)
+	}
+
+	return nil
+}
+
+// ListArchivedTransactions scans the archive for a user's transactions ("" = every user)
+func (fa *FileVaultArchive) ListArchivedTransactions(ctx context.Context, userID string) ([]*VaultTransaction, error) {
+	fa.mu.RLock()
+	defer fa.mu.RUnlock()
+
+	txs := make([]*VaultTransaction, 0)
+	err := fa.scanLocked(func(tx *VaultTransaction) bool {
+		if userID == "" || tx.UserID == userID {
+			txs = append(txs, tx)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txs, nil
+}
+
+// GetArchivedTransaction scans the archive for a transaction
+func (fa *FileVaultArchive) GetArchivedTransaction(ctx context.Context, transactionID string) (*VaultTransaction, bool, error) {
+	fa.mu.RLock()
+	defer fa.mu.RUnlock()
+
+	var found *VaultTransaction
+	err := fa.scanLocked(func(tx *VaultTransaction) bool {
+		if tx.TransactionID == transactionID {
+			found = tx
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return found, found != nil, nil
+}
+
+// PutSnapshot stores a user's snapshot and rewrites the snapshot file
+func (fa *FileVaultArchive) PutSnapshot(ctx context.Context, snapshot *VaultSnapshot) error {
+	fa.mu.Lock()
+	defer fa.mu.Unlock()
+
+	previous, existed := fa.snapshots[snapshot.UserID]
+	fa.snapshots[snapshot.UserID] = snapshot
+
+	if err := fa.persistSnapshotsLocked(); err != nil {
+		// Roll back so memory and disk agree
+		if existed {
+			fa.snapshots[snapshot.UserID] = previous
+		} else {
+			delete(fa.snapshots, snapshot.UserID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// GetSnapshot returns a user's balance snapshot
+func (fa *FileVaultArchive) GetSnapshot(ctx context.Context, userID string) (*VaultSnapshot, bool, error) {
+	fa.mu.RLock()
+	defer fa.mu.RUnlock()
+
+	snapshot, exists := fa.snapshots[userID]
+	return snapshot, exists, nil
+}
+
+// scanLocked calls visit for each archived transaction until it returns false (caller holds fa.mu)
+func (fa *FileVaultArchive) scanLocked(visit func(tx *VaultTransaction) bool) error {
+	file, err := os.Open(fa.transactionsPath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open vault archive: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var tx VaultTransaction
+		if err := json.Unmarshal(scanner.Bytes(), &tx); err != nil {
+			return fmt.Errorf("failed to decode archived transaction: %w", err)
+		}
+		if !visit(&tx) {
+			return nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read vault archive: %w", err)
+	}
+
+	return nil
+}
+
+// persistSnapshotsLocked writes all snapshots via a temp file and rename (caller holds fa.mu)
+func (fa *FileVaultArchive) persistSnapshotsLocked() error {
+	data, err := json.Marshal(fa.snapshots)
+	if err != nil {
+		return fmt.Errorf("failed to encode vault snapshots: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(fa.snapshotsPath), filepath.Base(fa.snapshotsPath)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to write vault snapshots: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write vault snapshots: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write vault snapshots: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), fa.snapshotsPath); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to replace vault snapshots: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Batch Vault Credits
+//
+// Applies many credits under a single lock acquisition. Used by the monthly
+// dividend run, where crediting millions of DIDs one CreditVault call at a
+// time is slow and contends heavily on the vault lock.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultCreditBatchSize is how many credits the dividend run applies per batch
+const DefaultCreditBatchSize = 1000
+
+// Credit is a single entry in a batch credit
+type Credit struct {
+	UserID  string // Target vault (takes precedence over DID)
+	DID     string // Resolved to a vault when UserID is empty
+	Amount  int64  // uSOV
+	Purpose string
+}
+
+// BatchCredit applies all credits atomically under one lock acquisition
+//
+// BATCH LOGIC:
+// 1. Resolve every credit to a vault (DIDs are indexed once per batch)
+// 2. Validate every credit; any failure rejects the whole batch untouched
+// 3. Apply all balances and record one transaction per credit
+// Returns the transaction IDs in credit order. A store failure while applying
+// stops the batch; the IDs of credits already applied are returned with the error.
+func (svm *SovereignVaultManager) BatchCredit(ctx context.Context, credits []Credit) ([]string, error) {
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("batch credit cancelled: %w", err)
+	}
+
+	// 1. Resolve vaults
+	var didIndex map[string]*SovereignVault
+	vaults := make([]*SovereignVault, len(credits))
+
+	for i, credit := range credits {
+		if credit.UserID != "" {
+			vault, err := svm.getVaultLocked(ctx, credit.UserID)
+			if err != nil {
+				return nil, fmt.Errorf("credit %d: %w", i, err)
+			}
+			vaults[i] = vault
+			continue
+		}
+
+		if credit.DID == "" {
+			return nil, fmt.Errorf("credit %d: user ID or DID required", i)
+		}
+
+		if didIndex == nil {
+			all, err := svm.store.ListVaults(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list vaults: %w", err)
+			}
+			didIndex = make(map[string]*SovereignVault, len(all))
+			for _, vault := range all {
+				didIndex[vault.DID] = vault
+			}
+		}
+
+		vault, exists := didIndex[credit.DID]
+		if !exists {
+			return nil, fmt.Errorf("credit %d: vault not found for DID: %s", i, credit.DID)
+		}
+		vaults[i] = vault
+	}
+
+	// 2. Validate amounts
+	for i, credit := range credits {
+		if credit.Amount <= 0 {
+			return nil, fmt.Errorf("credit %d: amount must be positive, got %d", i, credit.Amount)
+		}
+	}
+
+	// 3. Apply
+	now := time.Now()
+	txIDs := make([]string, len(credits))
+
+	for i, credit := range credits {
+		vault := vaults[i]
+		before := *vault
+		balanceBefore := vault.Balance
+
+		vault.Balance += credit.Amount
+		vault.UpdatedAt = now
+
+		txID := uuid.New().String()
+		tx := &VaultTransaction{
+			TransactionID: txID,
+			UserID:        vault.UserID,
+			DID:           vault.DID,
+			Type:          "credit",
+			Amount:        credit.Amount,
+			BalanceBefore: balanceBefore,
+			BalanceAfter:  vault.Balance,
+			Purpose:       credit.Purpose,
+			Timestamp:     now,
+			Status:        "success",
+		}
+
+		if err := svm.commitLocked(ctx, vault, before, tx); err != nil {
+			return txIDs[:i], fmt.Errorf("credit %d: %w", i, err)
+		}
+
+		txIDs[i] = txID
+	}
+
+	return txIDs, nil
+}
@@ -0,0 +1,90 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Bulk Vault Query
+//
+// UIs and batch jobs (e.g. loading every traveler on a flight manifest) need
+// many vaults at once. Looking them up one GetVault call at a time takes the
+// manager lock N times; the bulk queries take it once.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetVaults returns the vaults of many users under one read lock
+// Found vaults are keyed by user ID; IDs without a vault are returned in missing,
+// in request order. Duplicate IDs are looked up once.
+func (svm *SovereignVaultManager) GetVaults(ctx context.Context, userIDs []string) (map[string]*SovereignVault, []string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, fmt.Errorf("bulk vault query cancelled: %w", err)
+	}
+
+	svm.mu.RLock()
+	defer svm.mu.RUnlock()
+
+	found := make(map[string]*SovereignVault, len(userIDs))
+	missing := make([]string, 0)
+	seen := make(map[string]bool, len(userIDs))
+
+	for _, userID := range userIDs {
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		vault, exists, err := svm.store.GetVault(ctx, userID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load vault for user %s: %w", userID, err)
+		}
+		if !exists {
+			missing = append(missing, userID)
+			continue
+		}
+		found[userID] = vault
+	}
+
+	return found, missing, nil
+}
+
+// GetVaultsByDIDs returns the vaults of many DIDs under one read lock
+// Found vaults are keyed by DID; DIDs without a vault are returned in missing,
+// in request order. The store is listed once to index vaults by DID.
+func (svm *SovereignVaultManager) GetVaultsByDIDs(ctx context.Context, dids []string) (map[string]*SovereignVault, []string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, fmt.Errorf("bulk vault query cancelled: %w", err)
+	}
+
+	svm.mu.RLock()
+	defer svm.mu.RUnlock()
+
+	all, err := svm.store.ListVaults(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list vaults: %w", err)
+	}
+
+	didIndex := make(map[string]*SovereignVault, len(all))
+	for _, vault := range all {
+		didIndex[vault.DID] = vault
+	}
+
+	found := make(map[string]*SovereignVault, len(dids))
+	missing := make([]string, 0)
+	seen := make(map[string]bool, len(dids))
+
+	for _, did := range dids {
+		if seen[did] {
+			continue
+		}
+		seen[did] = true
+
+		vault, exists := didIndex[did]
+		if !exists {
+			missing = append(missing, did)
+			continue
+		}
+		found[did] = vault
+	}
+
+	return found, missing, nil
+}
@@ -0,0 +1,313 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Vault Transaction Log Compaction
+//
+// The hot transaction log grows with every payment, and history queries scan
+// all of it. Compaction moves transactions older than a cutoff into a
+// VaultArchive and snapshots each user's balance, keeping the hot log bounded.
+// Vault balances are not touched. History, reconciliation and spoke export
+// queries read the archive transparently when a range reaches past the cutoff.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DefaultCompactionInterval and DefaultCompactionRetention configure StartCompaction defaults
+const (
+	DefaultCompactionInterval  = 24 * time.Hour
+	DefaultCompactionRetention = 90 * 24 * time.Hour // Transactions younger than this stay hot
+)
+
+// TransactionPruner is implemented by vault stores that can delete archived transactions
+type TransactionPruner interface {
+	// DeleteTransactions removes transactions from the hot store (unknown IDs are ignored)
+	DeleteTransactions(ctx context.Context, transactionIDs []string) error
+}
+
+// DeleteTransactions removes transactions from the store
+func (ms *MemoryVaultStore) DeleteTransactions(ctx context.Context, transactionIDs []string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	for _, transactionID := range transactionIDs {
+		delete(ms.transactions, transactionID)
+	}
+	return nil
+}
+
+// CompactionResult summarizes a compaction run
+type CompactionResult struct {
+	Cutoff    time.Time `json:"cutoff"`
+	Archived  int       `json:"archived"`  // Transactions moved to the archive
+	Snapshots int       `json:"snapshots"` // Users whose balance snapshot was updated
+	Retained  int       `json:"retained"`  // Transactions left in the hot store
+}
+
+// SetTransactionArchive sets the archive that compaction moves old transactions into
+func (svm *SovereignVaultManager) SetTransactionArchive(archive VaultArchive) {
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	svm.archive = archive
+}
+
+// CompactTransactions archives every transaction older than cutoff
+//
+// COMPACTION LOGIC:
+// 1. Select hot transactions with Timestamp before cutoff
+// 2. Append them to the archive (oldest first)
+// 3. Update each affected user's snapshot from their last archived successful transaction
+// 4. Delete them from the hot store
+// Runs under the manager lock, so no payment interleaves with a run. A run that
+// fails after step 2 leaves the transactions in both places; reads prefer the
+// hot copy, and rerunning completes it. Archived debits can no longer be reversed.
+func (svm *SovereignVaultManager) CompactTransactions(ctx context.Context, cutoff time.Time) (*CompactionResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("compaction cancelled: %w", err)
+	}
+
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	if svm.archive == nil {
+		return nil, fmt.Errorf("no transaction archive configured")
+	}
+
+	pruner, ok := svm.store.(TransactionPruner)
+	if !ok {
+		return nil, fmt.Errorf("vault store does not support transaction compaction")
+	}
+
+	// 1. Select
+	txs, err := svm.store.ListTransactions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	old := make([]*VaultTransaction, 0)
+	for _, tx := range txs {
+		if tx.Timestamp.Before(cutoff) {
+			old = append(old, tx)
+		}
+	}
+
+	result := &CompactionResult{Cutoff: cutoff, Retained: len(txs) - len(old)}
+	if len(old) == 0 {
+		svm.markCompactedLocked(cutoff)
+		return result, nil
+	}
+
+	sort.Slice(old, func(i, j int) bool {
+		if old[i].Timestamp.Equal(old[j].Timestamp) {
+			return old[i].TransactionID < old[j].TransactionID
+		}
+		return old[i].Timestamp.Before(old[j].Timestamp)
+	})
+
+	// 2. Archive
+	if err := svm.archive.ArchiveTransactions(ctx, old); err != nil {
+		return nil, fmt.Errorf("failed to archive transactions: %w", err)
+	}
+
+	// 3. Snapshots
+	snapshots, err := svm.snapshotLocked(ctx, old)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Prune the hot store
+	ids := make([]string, len(old))
+	for i, tx := range old {
+		ids[i] = tx.TransactionID
+	}
+	if err := pruner.DeleteTransactions(ctx, ids); err != nil {
+		return nil, fmt.Errorf("failed to prune archived transactions: %w", err)
+	}
+
+	svm.markCompactedLocked(cutoff)
+
+	result.Archived = len(old)
+	result.Snapshots = snapshots
+	return result, nil
+}
+
+// snapshotLocked folds newly archived transactions (oldest first) into each user's snapshot (caller holds svm.mu)
+func (svm *SovereignVaultManager) snapshotLocked(ctx context.Context, archived []*VaultTransaction) (int, error) {
+	snapshots := make(map[string]*VaultSnapshot)
+	for _, tx := range archived {
+		snapshot, exists := snapshots[tx.UserID]
+		if !exists {
+			previous, found, err := svm.archive.GetSnapshot(ctx, tx.UserID)
+			if err != nil {
+				return 0, fmt.Errorf("failed to load snapshot for user %s: %w", tx.UserID, err)
+			}
+			snapshot = &VaultSnapshot{UserID: tx.UserID, DID: tx.DID}
+			if found {
+				copied := *previous
+				snapshot = &copied
+			}
+			snapshots[tx.UserID] = snapshot
+		}
+
+		if tx.Status == "success" && !tx.Timestamp.Before(snapshot.AsOf) {
+			snapshot.Balance = tx.BalanceAfter
+			snapshot.AsOf = tx.Timestamp
+			snapshot.LastTransactionID = tx.TransactionID
+		}
+	}
+
+	now := time.Now()
+	for userID, snapshot := range snapshots {
+		// Count from the archive rather than incrementing, so rerunning a
+		// partially failed compaction does not count its transactions twice
+		archivedTxs, err := svm.archive.ListArchivedTransactions(ctx, userID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count archived transactions for user %s: %w", userID, err)
+		}
+		snapshot.TransactionCount = len(archivedTxs)
+		snapshot.TakenAt = now
+		if err := svm.archive.PutSnapshot(ctx, snapshot); err != nil {
+			return 0, fmt.Errorf("failed to store snapshot for user %s: %w", userID, err)
+		}
+	}
+
+	return len(snapshots), nil
+}
+
+// markCompactedLocked records the newest cutoff compacted so far (caller holds svm.mu)
+func (svm *SovereignVaultManager) markCompactedLocked(cutoff time.Time) {
+	if cutoff.After(svm.compactedBefore) {
+		svm.compactedBefore = cutoff
+	}
+}
+
+// GetBalanceSnapshot returns a user's balance as of their last archived transaction
+func (svm *SovereignVaultManager) GetBalanceSnapshot(ctx context.Context, userID string) (*VaultSnapshot, error) {
+	svm.mu.RLock()
+	defer svm.mu.RUnlock()
+
+	if svm.archive == nil {
+		return nil, fmt.Errorf("no transaction archive configured")
+	}
+
+	snapshot, exists, err := svm.archive.GetSnapshot(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load snapshot for user %s: %w", userID, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("no snapshot for user: %s", userID)
+	}
+
+	return snapshot, nil
+}
+
+// StartCompaction compacts every interval, archiving transactions older than retention
+// Zero values use DefaultCompactionInterval and DefaultCompactionRetention.
+func (svm *SovereignVaultManager) StartCompaction(interval time.Duration, retention time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultCompactionInterval
+	}
+	if retention <= 0 {
+		retention = DefaultCompactionRetention
+	}
+
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	if svm.archive == nil {
+		return fmt.Errorf("no transaction archive configured")
+	}
+	if svm.stopCompaction != nil {
+		return fmt.Errorf("compaction already running")
+	}
+
+	stop := make(chan struct{})
+	svm.stopCompaction = stop
+	go svm.compactionLoop(stop, interval, retention)
+
+	return nil
+}
+
+// StopCompaction stops the loop started by StartCompaction
+func (svm *SovereignVaultManager) StopCompaction() {
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	if svm.stopCompaction != nil {
+		close(svm.stopCompaction)
+		svm.stopCompaction = nil
+	}
+}
+
+// compactionLoop runs CompactTransactions every interval until stop is closed
+func (svm *SovereignVaultManager) compactionLoop(stop <-chan struct{}, interval time.Duration, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			result, err := svm.CompactTransactions(context.Background(), time.Now().Add(-retention))
+			if err != nil {
+				fmt.Printf("Warning: vault transaction compaction failed: %v\n", err)
+				continue
+			}
+			if result.Archived > 0 {
+				fmt.Printf("✅ Archived %d vault transactions older than %s (%d kept hot)\n",
+					result.Archived, result.Cutoff.Format(time.RFC3339), result.Retained)
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// listTransactionsLocked returns hot transactions, plus archived ones when since precedes the compacted range (caller holds svm.mu)
+// A zero since always includes the archive. Transactions present in both are returned once (hot copy).
+func (svm *SovereignVaultManager) listTransactionsLocked(ctx context.Context, since time.Time) ([]*VaultTransaction, error) {
+	txs, err := svm.store.ListTransactions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	if svm.archive == nil || (!svm.compactedBefore.IsZero() && !since.Before(svm.compactedBefore)) {
+		return txs, nil
+	}
+
+	archived, err := svm.archive.ListArchivedTransactions(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list archived transactions: %w", err)
+	}
+
+	return mergeArchived(txs, archived), nil
+}
+
+// getTransactionLocked looks a transaction up in the hot store, then the archive (caller holds svm.mu)
+func (svm *SovereignVaultManager) getTransactionLocked(ctx context.Context, transactionID string) (*VaultTransaction, bool, error) {
+	tx, exists, err := svm.store.GetTransaction(ctx, transactionID)
+	if err != nil || exists || svm.archive == nil {
+		return tx, exists, err
+	}
+
+	return svm.archive.GetArchivedTransaction(ctx, transactionID)
+}
+
+// mergeArchived appends archived transactions not already in hot
+func mergeArchived(hot []*VaultTransaction, archived []*VaultTransaction) []*VaultTransaction {
+	seen := make(map[string]bool, len(hot))
+	for _, tx := range hot {
+		seen[tx.TransactionID] = true
+	}
+
+	merged := hot
+	for _, tx := range archived {
+		if !seen[tx.TransactionID] {
+			merged = append(merged, tx)
+		}
+	}
+	return merged
+}
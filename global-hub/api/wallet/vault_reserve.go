@@ -0,0 +1,94 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Vault Reserve
+//
+// A vault owner can set aside part of their balance as a reserve (for
+// example, boarding funds). Reserved funds stay in the vault but cannot be
+// spent on PFF fees or transfers until the owner lowers the reserve.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// vaultOwnerKey is the context key carrying the authenticated vault owner's DID
+type vaultOwnerKey struct{}
+
+// WithVaultOwner returns a context acting on behalf of the vault owner with this DID
+// Set by the layer that authenticated the owner (e.g. a verified PFF scan).
+func WithVaultOwner(ctx context.Context, did string) context.Context {
+	return context.WithValue(ctx, vaultOwnerKey{}, did)
+}
+
+// vaultOwnerFromContext returns the owner DID attached with WithVaultOwner
+func vaultOwnerFromContext(ctx context.Context) (string, bool) {
+	did, ok := ctx.Value(vaultOwnerKey{}).(string)
+	return did, ok && did != ""
+}
+
+// SpendableBalance returns the balance available for debits and transfers (balance minus reserve)
+func (v *SovereignVault) SpendableBalance() int64 {
+	if v.ReservedBalance >= v.Balance {
+		return 0
+	}
+
+	return v.Balance - v.ReservedBalance
+}
+
+// SetReserved sets the amount of a vault's balance that cannot be spent
+//
+// RESERVE LOGIC:
+// 1. Amount must not be negative (0 clears the reserve)
+// 2. ctx must carry the vault owner's DID (WithVaultOwner)
+// 3. The reserve may exceed the current balance; spendable is then 0 until credited
+func (svm *SovereignVaultManager) SetReserved(ctx context.Context, userID string, amount int64) error {
+	// 1. Validate amount
+	if amount < 0 {
+		return fmt.Errorf("reserved balance cannot be negative, got %d", amount)
+	}
+
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	vault, err := svm.getVaultLocked(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// 2. Only the owner may adjust their reserve
+	ownerDID, ok := vaultOwnerFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("vault owner required to set reserve for user %s", userID)
+	}
+	if ownerDID != vault.DID {
+		return fmt.Errorf("%s is not the owner of vault for user %s", ownerDID, userID)
+	}
+
+	// 3. Persist the new reserve
+	before := *vault
+	vault.ReservedBalance = amount
+	vault.UpdatedAt = time.Now()
+
+	if err := svm.store.PutVault(ctx, vault); err != nil {
+		*vault = before
+		return fmt.Errorf("failed to persist vault for user %s: %w", userID, err)
+	}
+
+	return nil
+}
+
+// checkSpendable rejects a debit that would dip into the vault's reserve
+func checkSpendable(vault *SovereignVault, amount int64) error {
+	if vault.Balance < amount {
+		return fmt.Errorf("insufficient balance: have %d uSOV, need %d uSOV", vault.Balance, amount)
+	}
+
+	if spendable := vault.SpendableBalance(); spendable < amount {
+		return fmt.Errorf("insufficient spendable balance: have %d uSOV (%d uSOV reserved), need %d uSOV",
+			spendable, vault.ReservedBalance, amount)
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Vault Store
+//
+// SovereignVaultManager keeps its vaults and transactions in a pluggable
+// VaultStore so balances can survive a restart. The in-memory store is the
+// default; operators plug in a database by implementing the interface.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// VaultStore persists vaults and the transaction log
+// The manager serializes all access under its own lock, so a store only needs
+// to be safe for one writer at a time. Vaults returned by GetVault may be
+// modified by the manager, which always calls PutVault afterwards.
+type VaultStore interface {
+	// GetVault returns the vault for a user, or false if none exists
+	GetVault(ctx context.Context, userID string) (*SovereignVault, bool, error)
+
+	// PutVault creates or replaces a vault
+	PutVault(ctx context.Context, vault *SovereignVault) error
+
+	// ListVaults returns every vault
+	ListVaults(ctx context.Context) ([]*SovereignVault, error)
+
+	// AppendTransaction records a new transaction (IDs are unique)
+	AppendTransaction(ctx context.Context, tx *VaultTransaction) error
+
+	// GetTransaction returns a transaction by ID, or false if none exists
+	GetTransaction(ctx context.Context, transactionID string) (*VaultTransaction, bool, error)
+
+	// UpdateTransaction replaces an existing transaction (used to link reversals)
+	UpdateTransaction(ctx context.Context, tx *VaultTransaction) error
+
+	// ListTransactions returns every transaction
+	ListTransactions(ctx context.Context) ([]*VaultTransaction, error)
+}
+
+// MemoryVaultStore keeps vaults and transactions in memory (lost on restart)
+type MemoryVaultStore struct {
+	vaults       map[string]*SovereignVault
+	transactions map[string]*VaultTransaction
+	mu           sync.RWMutex
+}
+
+// NewMemoryVaultStore creates an empty in-memory vault store
+func NewMemoryVaultStore() *MemoryVaultStore {
+	return &MemoryVaultStore{
+		vaults:       make(map[string]*SovereignVault),
+		transactions: make(map[string]*VaultTransaction),
+	}
+}
+
+// GetVault returns the vault for a user
+func (ms *MemoryVaultStore) GetVault(ctx context.Context, userID string) (*SovereignVault, bool, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	vault, exists := ms.vaults[userID]
+	return vault, exists, nil
+}
+
+// PutVault creates or replaces a vault
+func (ms *MemoryVaultStore) PutVault(ctx context.Context, vault *SovereignVault) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.vaults[vault.UserID] = vault
+	return nil
+}
+
+// ListVaults returns every vault
+func (ms *MemoryVaultStore) ListVaults(ctx context.Context) ([]*SovereignVault, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	vaults := make([]*SovereignVault, 0, len(ms.vaults))
+	for _, vault := range ms.vaults {
+		vaults = append(vaults, vault)
+	}
+
+	return vaults, nil
+}
+
+// AppendTransaction records a new transaction
+func (ms *MemoryVaultStore) AppendTransaction(ctx context.Context, tx *VaultTransaction) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, exists := ms.transactions[tx.TransactionID]; exists {
+		return fmt.Errorf("transaction already recorded: %s", tx.TransactionID)
+	}
+
+	ms.transactions[tx.TransactionID] = tx
+	return nil
+}
+
+// GetTransaction returns a transaction by ID
+func (ms *MemoryVaultStore) GetTransaction(ctx context.Context, transactionID string) (*VaultTransaction, bool, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	tx, exists := ms.transactions[transactionID]
+	return tx, exists, nil
+}
+
+// UpdateTransaction replaces an existing transaction
+func (ms *MemoryVaultStore) UpdateTransaction(ctx context.Context, tx *VaultTransaction) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, exists := ms.transactions[tx.TransactionID]; !exists {
+		return fmt.Errorf("transaction not found: %s", tx.TransactionID)
+	}
+
+	ms.transactions[tx.TransactionID] = tx
+	return nil
+}
+
+// ListTransactions returns every transaction
+func (ms *MemoryVaultStore) ListTransactions(ctx context.Context) ([]*VaultTransaction, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	txs := make([]*VaultTransaction, 0, len(ms.transactions))
+	for _, tx := range ms.transactions {
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+// getVaultLocked looks up a vault, treating a missing vault as an error (caller must hold svm.mu)
+// Fails with the context's error if the caller has cancelled or timed out, so no
+// vault operation starts on behalf of an abandoned request.
+func (svm *SovereignVaultManager) getVaultLocked(ctx context.Context, userID string) (*SovereignVault, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("vault operation for user %s cancelled: %w", userID, err)
+	}
+
+	vault, exists, err := svm.store.GetVault(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load vault for user %s: %w", userID, err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("vault not found for user: %s", userID)
+	}
+
+	return vault, nil
+}
+
+// commitLocked persists a changed vault and the transaction recording the change (caller must hold svm.mu)
+// If either write fails, the vault is restored to before so balance and log stay consistent.
+func (svm *SovereignVaultManager) commitLocked(ctx context.Context, vault *SovereignVault, before SovereignVault, tx *VaultTransaction) error {
+	if err := svm.store.PutVault(ctx, vault); err != nil {
+		*vault = before
+		return fmt.Errorf("failed to persist vault for user %s: %w", vault.UserID, err)
+	}
+
+	if err := svm.store.AppendTransaction(ctx, tx); err != nil {
+		*vault = before
+		if restoreErr := svm.store.PutVault(ctx, vault); restoreErr != nil {
+			return fmt.Errorf("failed to record transaction %s: %w (vault restore failed: %v)", tx.TransactionID, err, restoreErr)
+		}
+		return fmt.Errorf("failed to record transaction %s: %w", tx.TransactionID, err)
+	}
+
+	return nil
+}
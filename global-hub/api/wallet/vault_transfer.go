@@ -0,0 +1,160 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Vault Transfers
+//
+// Peer-to-peer SOV sends move funds between two vaults in one step. Separate
+// DebitVault and CreditVault calls could leave one leg applied after a crash,
+// so both legs are applied under a single lock and undone together on failure.
+
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VaultTransfer is the result of a completed transfer
+type VaultTransfer struct {
+	TransferID       string `json:"transfer_id"`
+	FromUserID       string `json:"from_user_id"`
+	ToUserID         string `json:"to_user_id"`
+	Amount           int64  `json:"amount"`             // uSOV
+	OutTransactionID string `json:"out_transaction_id"` // "transfer_out" leg on the sender
+	InTransactionID  string `json:"in_transaction_id"`  // "transfer_in" leg on the recipient
+}
+
+// TransferVault moves amount from one user's vault to another atomically
+//
+// TRANSFER LOGIC:
+// 1. Reject self-transfers and non-positive amounts
+// 2. Both vaults must exist and the sender's spendable balance (outside its reserve) must cover the amount
+// 3. Debit sender and credit recipient under one lock
+// 4. Record "transfer_out" and "transfer_in" transactions sharing a transfer ID
+// If any write fails, both balances are restored and no transfer is returned.
+func (svm *SovereignVaultManager) TransferVault(ctx context.Context, fromUserID string, toUserID string, amount int64, purpose string) (*VaultTransfer, error) {
+	// 1. Validate request
+	if fromUserID == toUserID {
+		return nil, fmt.Errorf("cannot transfer to the same vault: %s", fromUserID)
+	}
+	if amount <= 0 {
+		return nil, fmt.Errorf("transfer amount must be positive, got %d", amount)
+	}
+
+	svm.mu.Lock()
+	defer svm.mu.Unlock()
+
+	// 2. Resolve vaults and check balance
+	from, err := svm.getVaultLocked(ctx, fromUserID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := svm.getVaultLocked(ctx, toUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSpendable(from, amount); err != nil {
+		return nil, err
+	}
+
+	// 3. Apply both legs
+	fromBefore := *from
+	toBefore := *to
+	now := time.Now()
+
+	from.Balance -= amount
+	from.UpdatedAt = now
+	to.Balance += amount
+	to.UpdatedAt = now
+
+	// 4. Record the linked pair
+	transferID := uuid.New().String()
+	out := &VaultTransaction{
+		TransactionID: uuid.New().String(),
+		UserID:        fromUserID,
+		DID:           from.DID,
+		Type:          "transfer_out",
+		Amount:        amount,
+		BalanceBefore: fromBefore.Balance,
+		BalanceAfter:  from.Balance,
+		Purpose:       purpose,
+		TransferID:    transferID,
+		Metadata:      map[string]interface{}{"counterparty": toUserID},
+		Timestamp:     now,
+		Status:        "success",
+	}
+	in := &VaultTransaction{
+		TransactionID: uuid.New().String(),
+		UserID:        toUserID,
+		DID:           to.DID,
+		Type:          "transfer_in",
+		Amount:        amount,
+		BalanceBefore: toBefore.Balance,
+		BalanceAfter:  to.Balance,
+		Purpose:       purpose,
+		TransferID:    transferID,
+		Metadata:      map[string]interface{}{"counterparty": fromUserID},
+		Timestamp:     now,
+		Status:        "success",
+	}
+
+	if err := svm.commitTransferLocked(ctx, from, fromBefore, to, toBefore, out, in); err != nil {
+		return nil, fmt.Errorf("transfer %s rolled back: %w", transferID, err)
+	}
+
+	return &VaultTransfer{
+		TransferID:       transferID,
+		FromUserID:       fromUserID,
+		ToUserID:         toUserID,
+		Amount:           amount,
+		OutTransactionID: out.TransactionID,
+		InTransactionID:  in.TransactionID,
+	}, nil
+}
+
+// commitTransferLocked persists both legs of a transfer, restoring both vaults on failure (caller must hold svm.mu)
+// A leg already appended when a later write fails is kept in the log as "failed".
+func (svm *SovereignVaultManager) commitTransferLocked(
+	ctx context.Context,
+	from *SovereignVault,
+	fromBefore SovereignVault,
+	to *SovereignVault,
+	toBefore SovereignVault,
+	out *VaultTransaction,
+	in *VaultTransaction,
+) error {
+	var appended []*VaultTransaction
+
+	err := svm.store.PutVault(ctx, from)
+	if err == nil {
+		err = svm.store.PutVault(ctx, to)
+	}
+	if err == nil {
+		if err = svm.store.AppendTransaction(ctx, out); err == nil {
+			appended = append(appended, out)
+			err = svm.store.AppendTransaction(ctx, in)
+		}
+	}
+	if err == nil {
+		return nil
+	}
+
+	// Roll back balances and void any recorded leg
+	*from = fromBefore
+	*to = toBefore
+	for _, vault := range []*SovereignVault{from, to} {
+		if restoreErr := svm.store.PutVault(ctx, vault); restoreErr != nil {
+			fmt.Printf("Warning: failed to restore vault %s after transfer failure: %v\n", vault.UserID, restoreErr)
+		}
+	}
+	for _, tx := range appended {
+		tx.Status = "failed"
+		if voidErr := svm.store.UpdateTransaction(ctx, tx); voidErr != nil {
+			fmt.Printf("Warning: failed to void transfer leg %s: %v\n", tx.TransactionID, voidErr)
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,79 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Bounded Worker Pool
+//
+// Shared helper for batch operations (dividend distribution, batch settlement,
+// cache warming). Runs items in parallel with a fixed concurrency limit so
+// large batches finish quickly without overwhelming downstream stores.
+
+package workerpool
+
+import (
+	"context"
+	"sync"
+)
+
+// DefaultConcurrency is the worker limit used when none is configured
+const DefaultConcurrency = 8
+
+// Pool runs batch items with at most Concurrency workers at a time
+type Pool struct {
+	concurrency int
+}
+
+// New creates a worker pool (limits below 1 are treated as 1, i.e. sequential)
+func New(concurrency int) *Pool {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	return &Pool{
+		concurrency: concurrency,
+	}
+}
+
+// Concurrency returns the pool's worker limit
+func (p *Pool) Concurrency() int {
+	return p.concurrency
+}
+
+// Run calls fn for every index in [0, n) using at most Concurrency workers
+// Returns one error per item, in item order (nil on success). Callers keep
+// per-item results association by writing into a pre-sized slice at index.
+// Items not yet started when ctx is cancelled get ctx.Err().
+func (p *Pool) Run(ctx context.Context, n int, fn func(ctx context.Context, index int) error) []error {
+	errs := make([]error, n)
+	if n == 0 {
+		return errs
+	}
+
+	workers := p.concurrency
+	if workers > n {
+		workers = n
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					errs[i] = err
+					continue
+				}
+				errs[i] = fn(ctx, i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return errs
+}
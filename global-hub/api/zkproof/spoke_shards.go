@@ -0,0 +1,108 @@
+package zkproof
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// ShardVirtualNodes is the number of ring points per shard (smooths the load spread)
+const ShardVirtualNodes = 64
+
+// spokeShardRing places a spoke's shards on a consistent-hash ring
+// Adding a shard only moves the hashes that land on its new ring points.
+type spokeShardRing struct {
+	points  []uint64          // Sorted ring positions
+	owners  map[uint64]string // Ring position -> shard ID
+	clients map[string]SpokeClient
+}
+
+// RegisterSpokeShard adds a regional shard endpoint for a spoke
+// Once a spoke has shards, biometric hashes are routed to the shard that owns
+// them on the ring instead of the spoke's single client.
+func (zk *ZKProofEngine) RegisterSpokeShard(spokeID string, shardID string, client SpokeClient) error {
+	if spokeID == "" || shardID == "" {
+		return fmt.Errorf("spoke ID and shard ID required")
+	}
+	if client == nil {
+		return fmt.Errorf("shard client required")
+	}
+
+	zk.mu.Lock()
+	defer zk.mu.Unlock()
+
+	ring, exists := zk.spokeShards[spokeID]
+	if !exists {
+		ring = &spokeShardRing{
+			owners:  make(map[uint64]string),
+			clients: make(map[string]SpokeClient),
+		}
+		zk.spokeShards[spokeID] = ring
+	}
+
+	if _, exists := ring.clients[shardID]; exists {
+		return fmt.Errorf("shard %s already registered for spoke %s", shardID, spokeID)
+	}
+
+	ring.clients[shardID] = client
+	for i := 0; i < ShardVirtualNodes; i++ {
+		point := ringPosition(fmt.Sprintf("%s#%d", shardID, i))
+		if _, taken := ring.owners[point]; taken {
+			continue
+		}
+		ring.owners[point] = shardID
+		ring.points = append(ring.points, point)
+	}
+	sort.Slice(ring.points, func(i, j int) bool { return ring.points[i] < ring.points[j] })
+
+	return nil
+}
+
+// ShardFor returns the shard ID that holds a biometric hash for a spoke
+func (zk *ZKProofEngine) ShardFor(spokeID string, biometricHash string) (string, error) {
+	zk.mu.RLock()
+	defer zk.mu.RUnlock()
+
+	ring, exists := zk.spokeShards[spokeID]
+	if !exists {
+		return "", fmt.Errorf("spoke %s has no shards", spokeID)
+	}
+
+	return ring.owner(biometricHash), nil
+}
+
+// spokeClientFor resolves the client for a hash: the owning shard, or the spoke's single client
+func (zk *ZKProofEngine) spokeClientFor(spokeID string, biometricHash string) (SpokeClient, error) {
+	zk.mu.RLock()
+	ring, sharded := zk.spokeShards[spokeID]
+	if sharded {
+		client := ring.clients[ring.owner(biometricHash)]
+		zk.mu.RUnlock()
+		return client, nil
+	}
+	zk.mu.RUnlock()
+
+	client, exists := zk.spokeClients[spokeID]
+	if !exists {
+		return nil, fmt.Errorf("spoke not found: %s", spokeID)
+	}
+
+	return client, nil
+}
+
+// owner returns the shard at the first ring point at or after the hash position
+func (r *spokeShardRing) owner(biometricHash string) string {
+	position := ringPosition(biometricHash)
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= position })
+	if i == len(r.points) {
+		i = 0 // Wrap around the ring
+	}
+	return r.owners[r.points[i]]
+}
+
+// ringPosition maps a key to a position on the ring
+func ringPosition(key string) uint64 {
+	hash := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(hash[:8])
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -14,6 +15,10 @@ import (
 type ZKProofEngine struct {
 	// spokeClients maps spoke IDs to their API endpoints
 	spokeClients map[string]SpokeClient
+
+	// spokeShards maps spoke IDs to regional shard endpoints (see RegisterSpokeShard)
+	spokeShards map[string]*spokeShardRing
+	mu          sync.RWMutex
 }
 
 // SpokeClient interface for communicating with National Spokes
@@ -43,6 +48,7 @@ type ZKProofResponse struct {
 func NewZKProofEngine(spokeClients map[string]SpokeClient) *ZKProofEngine {
 	return &ZKProofEngine{
 		spokeClients: spokeClients,
+		spokeShards:  make(map[string]*spokeShardRing),
 	}
 }
 
@@ -73,10 +79,10 @@ func (zk *ZKProofEngine) VerifyWithSpoke(
 		return nil, fmt.Errorf("failed to generate challenge: %w", err)
 	}
 	
-	// 2. Get spoke client
-	spokeClient, exists := zk.spokeClients[spokeID]
-	if !exists {
-		return nil, fmt.Errorf("spoke not found: %s", spokeID)
+	// 2. Get spoke client (the owning shard if the spoke is sharded)
+	spokeClient, err := zk.spokeClientFor(spokeID, biometricHash)
+	if err != nil {
+		return nil, err
 	}
 	
 	// 3. Send ZK-proof request to spoke
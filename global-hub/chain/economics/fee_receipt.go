@@ -0,0 +1,243 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Fee Distribution Receipts
+//
+// Gives a citizen verifiable proof that their exact fee was split across the
+// Four Pillars: the amount and destination of each portion (and any regulatory
+// levy) plus the transaction context. Receipts are committed to the economics
+// store keyed by the originating transaction or verification ID, so every
+// validator records the same bytes; they carry a content hash on-chain and are
+// signed off-chain by whichever service hands them to citizens.
+
+package economics
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Pillar names used in distribution receipts
+const (
+	PillarCitizenDividend      = "citizen_dividend"
+	PillarProjectRnD           = "project_rnd"
+	PillarNationInfrastructure = "nation_infrastructure"
+	PillarDeflationBurn        = "deflation_burn"
+
+	// PillarRegulatoryLevy is the jurisdiction levy carved out before the split
+	PillarRegulatoryLevy = "regulatory_levy"
+)
+
+// ReceiptStoreKey is the store key distribution receipts are committed under
+const ReceiptStoreKey = "economics"
+
+// FeeReceiptPrefix is the store prefix for distribution receipts, keyed by origin ID
+var FeeReceiptPrefix = []byte{0x01}
+
+// GetFeeReceiptKey returns the store key for an origin ID's distribution receipt
+func GetFeeReceiptKey(originID string) []byte {
+	return append(append([]byte{}, FeeReceiptPrefix...), []byte(originID)...)
+}
+
+// PillarPortion is the share of a fee sent to one pillar
+type PillarPortion struct {
+	Pillar      string    `json:"pillar"`
+	Destination string    `json:"destination"` // Module account name, or the black hole address for burns
+	Amount      sdk.Coins `json:"amount"`
+}
+
+// FeeDistributionReceipt proves how a single fee was distributed
+type FeeDistributionReceipt struct {
+	OriginID    string          `json:"origin_id"` // Transaction or verification ID that paid the fee
+	TotalFee    sdk.Coins       `json:"total_fee"`
+	Portions    []PillarPortion `json:"portions"`
+	ChainID     string          `json:"chain_id"`
+	BlockHeight int64           `json:"block_height"`
+	BlockTime   time.Time       `json:"block_time"`
+	TxHash      string          `json:"tx_hash,omitempty"`   // SHA-256 of the tx bytes, if executed within a tx
+	ReceiptHash string          `json:"receipt_hash"`        // SHA-256 of SigningBytes(), committed on-chain
+	Signature   []byte          `json:"signature,omitempty"` // Off-chain ed25519 signature over SigningBytes()
+}
+
+// SetReceiptStore sets the store distribution receipts are committed to
+// Without a receipt store no receipts are recorded.
+func (qss *QuadraticSovereignSplit) SetReceiptStore(key sdk.StoreKey) {
+	qss.receiptStoreKey = key
+}
+
+// ExecuteFourWaySplitWithReceipt distributes a fee and records a receipt for originID
+//
+// RECEIPT LOGIC:
+// 1. Reject origin IDs that already have a receipt in the store
+// 2. Execute the Four-Way Split as usual
+// 3. Record each pillar's portion and destination (portions sum back to the fee)
+// 4. Attach the block/tx context and commit the receipt under the origin ID
+func (qss *QuadraticSovereignSplit) ExecuteFourWaySplitWithReceipt(
+	ctx sdk.Context,
+	totalFee sdk.Coins,
+	feeCollectorModule string,
+	originID string,
+) (*FeeDistributionReceipt, error) {
+	// 1. Reject duplicates
+	if err := qss.checkReceiptOrigin(ctx, originID); err != nil {
+		return nil, err
+	}
+
+	// 2. Execute the split
+	if err := qss.ExecuteFourWaySplit(ctx, totalFee, feeCollectorModule); err != nil {
+		return nil, err
+	}
+
+	// 3-4. Record and commit the receipt
+	return qss.recordFeeReceipt(ctx, originID, totalFee, qss.splitPortions(totalFee))
+}
+
+// checkReceiptOrigin ensures a receipt can be recorded for originID
+func (qss *QuadraticSovereignSplit) checkReceiptOrigin(ctx sdk.Context, originID string) error {
+	if qss.receiptStoreKey == nil {
+		return fmt.Errorf("receipt store not configured")
+	}
+	if originID == "" {
+		return fmt.Errorf("origin ID required for a distribution receipt")
+	}
+	if ctx.KVStore(qss.receiptStoreKey).Has(GetFeeReceiptKey(originID)) {
+		return fmt.Errorf("distribution receipt already exists for %s", originID)
+	}
+	return nil
+}
+
+// splitPortions returns the four pillar portions ExecuteFourWaySplit sends for a fee
+func (qss *QuadraticSovereignSplit) splitPortions(totalFee sdk.Coins) []PillarPortion {
+	citizenCoins, rndCoins, infraCoins, burnCoins := sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins(), sdk.NewCoins()
+	for _, fee := range totalFee {
+		citizenAmount, rndAmount, infraAmount, burnAmount := qss.splitAmount(fee.Amount)
+		citizenCoins = citizenCoins.Add(sdk.NewCoin(fee.Denom, citizenAmount))
+		rndCoins = rndCoins.Add(sdk.NewCoin(fee.Denom, rndAmount))
+		infraCoins = infraCoins.Add(sdk.NewCoin(fee.Denom, infraAmount))
+		burnCoins = burnCoins.Add(sdk.NewCoin(fee.Denom, burnAmount))
+	}
+
+	return []PillarPortion{
+		{Pillar: PillarCitizenDividend, Destination: CitizenDividendPool, Amount: citizenCoins},
+		{Pillar: PillarProjectRnD, Destination: ProjectRnDVault, Amount: rndCoins},
+		{Pillar: PillarNationInfrastructure, Destination: NationInfrastructurePool, Amount: infraCoins},
+		{Pillar: PillarDeflationBurn, Destination: BlackHoleAddress, Amount: burnCoins},
+	}
+}
+
+// recordFeeReceipt builds the receipt for an executed distribution and commits it to the store
+func (qss *QuadraticSovereignSplit) recordFeeReceipt(
+	ctx sdk.Context,
+	originID string,
+	totalFee sdk.Coins,
+	portions []PillarPortion,
+) (*FeeDistributionReceipt, error) {
+	receipt := &FeeDistributionReceipt{
+		OriginID:    originID,
+		TotalFee:    totalFee,
+		Portions:    portions,
+		ChainID:     ctx.ChainID(),
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime(),
+	}
+
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		hash := sha256.Sum256(txBytes)
+		receipt.TxHash = hex.EncodeToString(hash[:])
+	}
+	receipt.ReceiptHash = receipt.ContentHash()
+
+	bz, err := json.Marshal(receipt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal distribution receipt: %w", err)
+	}
+	ctx.KVStore(qss.receiptStoreKey).Set(GetFeeReceiptKey(originID), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"fee_distribution_receipt",
+			sdk.NewAttribute("origin_id", originID),
+			sdk.NewAttribute("total_amount", totalFee.String()),
+			sdk.NewAttribute("receipt_hash", receipt.ReceiptHash),
+		),
+	)
+
+	return receipt, nil
+}
+
+// GetFeeDistributionReceipt returns the receipt committed for a transaction/verification ID
+func (qss *QuadraticSovereignSplit) GetFeeDistributionReceipt(ctx sdk.Context, originID string) (*FeeDistributionReceipt, error) {
+	if qss.receiptStoreKey == nil {
+		return nil, fmt.Errorf("receipt store not configured")
+	}
+
+	bz := ctx.KVStore(qss.receiptStoreKey).Get(GetFeeReceiptKey(originID))
+	if bz == nil {
+		return nil, fmt.Errorf("distribution receipt not found: %s", originID)
+	}
+
+	var receipt FeeDistributionReceipt
+	if err := json.Unmarshal(bz, &receipt); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal distribution receipt %s: %w", originID, err)
+	}
+	return &receipt, nil
+}
+
+// SigningBytes returns the canonical bytes covered by the receipt signature
+func (r *FeeDistributionReceipt) SigningBytes() []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "origin_id=%s\n", r.OriginID)
+	fmt.Fprintf(&b, "total_fee=%s\n", r.TotalFee.String())
+	for _, portion := range r.Portions {
+		fmt.Fprintf(&b, "portion=%s|%s|%s\n", portion.Pillar, portion.Destination, portion.Amount.String())
+	}
+	fmt.Fprintf(&b, "chain_id=%s\n", r.ChainID)
+	fmt.Fprintf(&b, "block_height=%d\n", r.BlockHeight)
+	fmt.Fprintf(&b, "block_time=%s\n", r.BlockTime.UTC().Format(time.RFC3339Nano))
+	fmt.Fprintf(&b, "tx_hash=%s\n", r.TxHash)
+	return []byte(b.String())
+}
+
+// ContentHash returns the hex SHA-256 of the receipt's signing bytes
+func (r *FeeDistributionReceipt) ContentHash() string {
+	hash := sha256.Sum256(r.SigningBytes())
+	return hex.EncodeToString(hash[:])
+}
+
+// Sign signs the receipt off-chain (e.g. by the service serving it to the citizen)
+func (r *FeeDistributionReceipt) Sign(key ed25519.PrivateKey) error {
+	if len(key) != ed25519.PrivateKeySize {
+		return fmt.Errorf("invalid receipt signing key size: %d", len(key))
+	}
+	r.Signature = ed25519.Sign(key, r.SigningBytes())
+	return nil
+}
+
+// Verify checks the receipt's signature, content hash and that its portions sum back to the total fee
+func (r *FeeDistributionReceipt) Verify(pubKey ed25519.PublicKey) error {
+	sum := sdk.NewCoins()
+	for _, portion := range r.Portions {
+		sum = sum.Add(portion.Amount...)
+	}
+	if !sum.IsAllGTE(r.TotalFee) || !r.TotalFee.IsAllGTE(sum) {
+		return fmt.Errorf("receipt portions %s do not sum to total fee %s", sum, r.TotalFee)
+	}
+
+	if r.ReceiptHash != r.ContentHash() {
+		return fmt.Errorf("receipt hash does not match receipt contents")
+	}
+
+	if len(r.Signature) == 0 {
+		return fmt.Errorf("receipt is not signed")
+	}
+	if !ed25519.Verify(pubKey, r.SigningBytes(), r.Signature) {
+		return fmt.Errorf("invalid receipt signature")
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package economics
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// newReceiptTestSplit returns a split with a receipt store and a context backed by it
+func newReceiptTestSplit(t *testing.T) (*QuadraticSovereignSplit, sdk.Context) {
+	t.Helper()
+
+	key := sdk.NewKVStoreKey(ReceiptStoreKey)
+	ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_"+ReceiptStoreKey)).
+		WithChainID("sovra-test-1").
+		WithBlockHeight(42).
+		WithBlockTime(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	qss := NewQuadraticSovereignSplit(nil)
+	qss.SetReceiptStore(key)
+
+	return qss, ctx
+}
+
+func TestFeeReceiptRecordedAndQueried(t *testing.T) {
+	qss, ctx := newReceiptTestSplit(t)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("usov", 1_000_003))
+
+	recorded, err := qss.recordFeeReceipt(ctx, "verification-1", fee, qss.splitPortions(fee))
+	if err != nil {
+		t.Fatalf("recordFeeReceipt: %v", err)
+	}
+
+	stored, err := qss.GetFeeDistributionReceipt(ctx, "verification-1")
+	if err != nil {
+		t.Fatalf("GetFeeDistributionReceipt: %v", err)
+	}
+	if stored.ReceiptHash != recorded.ReceiptHash || stored.ContentHash() != recorded.ReceiptHash {
+		t.Errorf("stored receipt hash = %s, want %s", stored.ReceiptHash, recorded.ReceiptHash)
+	}
+	if stored.ChainID != "sovra-test-1" || stored.BlockHeight != 42 {
+		t.Errorf("stored receipt context = %s@%d, want sovra-test-1@42", stored.ChainID, stored.BlockHeight)
+	}
+
+	// Portions sum back to the fee even when it does not divide by four
+	sum := sdk.NewCoins()
+	for _, portion := range stored.Portions {
+		sum = sum.Add(portion.Amount...)
+	}
+	if !sum.IsEqual(fee) {
+		t.Errorf("portions sum to %s, want %s", sum, fee)
+	}
+
+	// One receipt per origin ID
+	if err := qss.checkReceiptOrigin(ctx, "verification-1"); err == nil {
+		t.Error("checkReceiptOrigin accepted an origin ID that already has a receipt")
+	}
+	if _, err := qss.GetFeeDistributionReceipt(ctx, "verification-2"); err == nil {
+		t.Error("GetFeeDistributionReceipt returned a receipt that was never recorded")
+	}
+}
+
+func TestFeeReceiptHashIsDeterministic(t *testing.T) {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("usov", 4_000_000))
+
+	// Two validators recording the same distribution commit the same receipt hash
+	var hashes []string
+	for i := 0; i < 2; i++ {
+		qss, ctx := newReceiptTestSplit(t)
+		receipt, err := qss.recordFeeReceipt(ctx, "tx-1", fee, qss.splitPortions(fee))
+		if err != nil {
+			t.Fatalf("recordFeeReceipt: %v", err)
+		}
+		hashes = append(hashes, receipt.ReceiptHash)
+	}
+
+	if hashes[0] != hashes[1] {
+		t.Errorf("receipt hashes differ across validators: %s vs %s", hashes[0], hashes[1])
+	}
+}
+
+func TestFeeReceiptSignAndVerify(t *testing.T) {
+	qss, ctx := newReceiptTestSplit(t)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("usov", 2_000_000))
+
+	receipt, err := qss.recordFeeReceipt(ctx, "tx-1", fee, qss.splitPortions(fee))
+	if err != nil {
+		t.Fatalf("recordFeeReceipt: %v", err)
+	}
+
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+
+	if err := receipt.Verify(pubKey); err == nil {
+		t.Fatal("Verify accepted an unsigned receipt")
+	}
+
+	if err := receipt.Sign(privKey); err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if err := receipt.Verify(pubKey); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+
+	// Tampering with a portion breaks the sum, hash and signature checks
+	receipt.Portions[0].Amount = sdk.NewCoins(sdk.NewInt64Coin("usov", 1))
+	if err := receipt.Verify(pubKey); err == nil {
+		t.Error("Verify accepted a tampered receipt")
+	}
+}
@@ -53,12 +53,22 @@ const (
 // QuadraticSovereignSplit implements the Four Pillars economic kernel
 type QuadraticSovereignSplit struct {
 	bankKeeper BankKeeper
+
+	// levyKeeper supplies per-country regulatory levies deducted before the split
+	levyKeeper LevyKeeper
+
+	// rounding converts decimal shares to whole uSOV (default: truncate)
+	rounding RoundingMode
+
+	// receiptStoreKey is the store per-fee distribution receipts are committed to
+	receiptStoreKey sdk.StoreKey
 }
 
 // NewQuadraticSovereignSplit creates a new Four Pillars kernel
 func NewQuadraticSovereignSplit(bk BankKeeper) *QuadraticSovereignSplit {
 	return &QuadraticSovereignSplit{
 		bankKeeper: bk,
+		rounding:   RoundingTruncate,
 	}
 }
 
@@ -74,13 +84,8 @@ func (qss *QuadraticSovereignSplit) ExecuteFourWaySplit(ctx sdk.Context, totalFe
 	for _, fee := range totalFee {
 		totalAmount := fee.Amount
 
-		// Calculate 25% for each pillar
-		citizenAmount := totalAmount.ToDec().Mul(sdk.MustNewDecFromStr("0.25")).TruncateInt()
-		rndAmount := totalAmount.ToDec().Mul(sdk.MustNewDecFromStr("0.25")).TruncateInt()
-		infraAmount := totalAmount.ToDec().Mul(sdk.MustNewDecFromStr("0.25")).TruncateInt()
-		
-		// Burn amount is remaining to handle rounding
-		burnAmount := totalAmount.Sub(citizenAmount).Sub(rndAmount).Sub(infraAmount)
+		// Calculate 25% for each pillar (rounded with the configured mode)
+		citizenAmount, rndAmount, infraAmount, burnAmount := qss.splitAmount(totalAmount)
 
 		// Create coin objects
 		citizenCoins := sdk.NewCoins(sdk.NewCoin(fee.Denom, citizenAmount))
@@ -138,6 +143,25 @@ func (qss *QuadraticSovereignSplit) ExecuteFourWaySplit(ctx sdk.Context, totalFe
 	return nil
 }
 
+// splitAmount computes the four pillar shares of a single-denom amount
+// Burn takes the remainder so the shares always sum back to the total.
+func (qss *QuadraticSovereignSplit) splitAmount(totalAmount sdk.Int) (citizenAmount, rndAmount, infraAmount, burnAmount sdk.Int) {
+	citizenAmount = qss.share(totalAmount, sdk.MustNewDecFromStr("0.25"))
+	rndAmount = qss.share(totalAmount, sdk.MustNewDecFromStr("0.25"))
+	infraAmount = qss.share(totalAmount, sdk.MustNewDecFromStr("0.25"))
+
+	// Burn amount is remaining to handle rounding
+	burnAmount = totalAmount.Sub(citizenAmount).Sub(rndAmount).Sub(infraAmount)
+
+	// Rounding tiny fees up can overshoot the total by 1 uSOV; take it back from infrastructure
+	if burnAmount.IsNegative() {
+		infraAmount = infraAmount.Add(burnAmount)
+		burnAmount = sdk.ZeroInt()
+	}
+
+	return citizenAmount, rndAmount, infraAmount, burnAmount
+}
+
 // BankKeeper defines the expected bank keeper interface
 type BankKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
@@ -11,6 +11,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sovrn-protocol/sovrn/chain/shared"
 )
 
 // Vault Configuration Constants
@@ -80,6 +82,13 @@ const (
 	StatusCancelled ProposalStatus = "cancelled"
 )
 
+// ProposalTransitions defines the legal withdrawal proposal status changes
+// An approved proposal lapses to rejected if signed after the voting period ends.
+var ProposalTransitions = shared.NewStateMachine("withdrawal proposal", map[string][]string{
+	string(StatusPending):  {string(StatusApproved), string(StatusRejected), string(StatusCancelled)},
+	string(StatusApproved): {string(StatusExecuted), string(StatusRejected)},
+})
+
 // MultisigVault implements the time-locked multisig vault for R&D funds
 type MultisigVault struct {
 	bankKeeper BankKeeper
@@ -157,6 +166,11 @@ func (mv *MultisigVault) SignProposal(ctx sdk.Context, proposalID uint64, signer
 		return fmt.Errorf("signer %s is not authorized", signer)
 	}
 
+	// Finalized proposals accept no further signatures
+	if ProposalTransitions.IsTerminal(string(proposal.Status)) {
+		return fmt.Errorf("proposal %d is %s and cannot be signed", proposalID, proposal.Status)
+	}
+
 	// Check if already signed
 	for _, sig := range proposal.Signatures {
 		if sig == signer {
@@ -166,6 +180,9 @@ func (mv *MultisigVault) SignProposal(ctx sdk.Context, proposalID uint64, signer
 
 	// Check if voting period has expired
 	if ctx.BlockTime().After(proposal.VotingEndsAt) {
+		if err := ProposalTransitions.ValidateTransition(string(proposal.Status), string(StatusRejected)); err != nil {
+			return err
+		}
 		proposal.Status = StatusRejected
 		return fmt.Errorf("voting period has expired for proposal %d", proposalID)
 	}
@@ -174,7 +191,10 @@ func (mv *MultisigVault) SignProposal(ctx sdk.Context, proposalID uint64, signer
 	proposal.Signatures = append(proposal.Signatures, signer)
 
 	// Check if we have enough signatures
-	if len(proposal.Signatures) >= MINIMUM_SIGNERS {
+	if len(proposal.Signatures) >= MINIMUM_SIGNERS && proposal.Status == StatusPending {
+		if err := ProposalTransitions.ValidateTransition(string(proposal.Status), string(StatusApproved)); err != nil {
+			return err
+		}
 		proposal.Status = StatusApproved
 	}
 
@@ -195,7 +215,7 @@ func (mv *MultisigVault) ExecuteProposal(ctx sdk.Context, proposalID uint64, exe
 	}
 
 	// Check proposal status
-	if proposal.Status != StatusApproved {
+	if !ProposalTransitions.CanTransition(string(proposal.Status), string(StatusExecuted)) {
 		return fmt.Errorf("proposal %d is not approved (status: %s)", proposalID, proposal.Status)
 	}
 
@@ -0,0 +1,181 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Jurisdiction Regulatory Levy
+//
+// Implements per-country regulatory levies carved out of verification fees.
+// The levy is resolved from the requester DID's country, routed to a
+// jurisdiction-specific account, and the remainder goes through the Four-Way Split.
+// Levy rates are x/mint params (JurisdictionLevies), set at genesis and changed
+// by governance; the kernel reads them from committed state on every fee.
+
+package economics
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/sovrn-protocol/sovrn/x/mint/types"
+	pfftypes "github.com/sovrn-protocol/sovrn/x/pff/types"
+)
+
+// MAX_REGULATORY_LEVY is the highest levy rate a jurisdiction may configure (10%)
+const MAX_REGULATORY_LEVY = minttypes.MaxRegulatoryLevy
+
+// LevyKeeper resolves the configured regulatory levy for a country (x/mint keeper)
+type LevyKeeper interface {
+	GetJurisdictionLevy(ctx sdk.Context, country string) (minttypes.JurisdictionLevy, bool)
+}
+
+// JurisdictionLevy defines the regulatory levy for a single country
+type JurisdictionLevy struct {
+	// Country is the DID country code (e.g., "nigeria")
+	Country string `json:"country"`
+
+	// Rate is the fraction of each fee taken as levy (e.g., 0.02 = 2%)
+	Rate sdk.Dec `json:"rate"`
+
+	// LevyModule is the module account that receives the levy
+	LevyModule string `json:"levy_module"`
+}
+
+// GetLevyModuleName returns the module account name for a country's regulatory levy
+// Module account name format: regulatory_levy_{country}
+func GetLevyModuleName(country string) string {
+	return fmt.Sprintf("regulatory_levy_%s", country)
+}
+
+// SetLevyKeeper sets where levy rates are read from
+// Without a levy keeper no levy is deducted.
+func (qss *QuadraticSovereignSplit) SetLevyKeeper(lk LevyKeeper) {
+	qss.levyKeeper = lk
+}
+
+// GetJurisdictionLevy returns the regulatory levy configured for a country
+func (qss *QuadraticSovereignSplit) GetJurisdictionLevy(ctx sdk.Context, country string) (JurisdictionLevy, bool) {
+	if qss.levyKeeper == nil {
+		return JurisdictionLevy{}, false
+	}
+
+	levy, exists := qss.levyKeeper.GetJurisdictionLevy(ctx, country)
+	if !exists {
+		return JurisdictionLevy{}, false
+	}
+
+	return JurisdictionLevy{
+		Country:    country,
+		Rate:       levy.Rate,
+		LevyModule: GetLevyModuleName(country),
+	}, true
+}
+
+// ExecuteFourWaySplitWithLevy deducts the jurisdiction levy and splits the remainder
+//
+// LEVY LOGIC:
+// 1. Resolve country from requester DID (did:sovrn:{country}:{identifier})
+// 2. If the country has a levy, send levy share to regulatory_levy_{country}
+// 3. Emit "regulatory_levy" event for the levy
+// 4. Execute Four-Way Split on the remainder
+// 5. Record a distribution receipt (levy + pillars) under originID when a receipt store is configured
+func (qss *QuadraticSovereignSplit) ExecuteFourWaySplitWithLevy(
+	ctx sdk.Context,
+	totalFee sdk.Coins,
+	feeCollectorModule string,
+	requesterDID string,
+	originID string,
+) error {
+	recordReceipt := qss.receiptStoreKey != nil
+	if recordReceipt {
+		if err := qss.checkReceiptOrigin(ctx, originID); err != nil {
+			return err
+		}
+	}
+
+	// 1-3. Resolve and route the levy
+	levyCoins, levyModule, err := qss.deductJurisdictionLevy(ctx, totalFee, feeCollectorModule, requesterDID)
+	if err != nil {
+		return err
+	}
+
+	// 4. Split the remainder across the four pillars
+	remainder := totalFee.Sub(levyCoins)
+	if !remainder.IsZero() {
+		if err := qss.ExecuteFourWaySplit(ctx, remainder, feeCollectorModule); err != nil {
+			return err
+		}
+	}
+
+	// 5. Record the receipt
+	if !recordReceipt {
+		return nil
+	}
+
+	portions := qss.splitPortions(remainder)
+	if !levyCoins.IsZero() {
+		portions = append([]PillarPortion{
+			{Pillar: PillarRegulatoryLevy, Destination: levyModule, Amount: levyCoins},
+		}, portions...)
+	}
+
+	_, err = qss.recordFeeReceipt(ctx, originID, totalFee, portions)
+	return err
+}
+
+// deductJurisdictionLevy sends the requester jurisdiction's levy share of totalFee to its levy account
+// Returns the levy taken (empty when no jurisdiction or levy applies) and the receiving module.
+func (qss *QuadraticSovereignSplit) deductJurisdictionLevy(
+	ctx sdk.Context,
+	totalFee sdk.Coins,
+	feeCollectorModule string,
+	requesterDID string,
+) (sdk.Coins, string, error) {
+	// 1. Resolve country from requester DID
+	country, err := pfftypes.ParseDIDCountry(requesterDID)
+	if err != nil {
+		ctx.Logger().Info("SOVRA Economics: No jurisdiction resolved, skipping levy",
+			"requester_did", requesterDID,
+			"error", err.Error(),
+		)
+		return sdk.NewCoins(), "", nil
+	}
+
+	levy, exists := qss.GetJurisdictionLevy(ctx, country)
+	if !exists || levy.Rate.IsZero() {
+		return sdk.NewCoins(), "", nil
+	}
+
+	// 2. Calculate and route levy
+	levyCoins := sdk.NewCoins()
+	for _, fee := range totalFee {
+		levyAmount := qss.share(fee.Amount, levy.Rate)
+		if levyAmount.IsPositive() {
+			levyCoins = levyCoins.Add(sdk.NewCoin(fee.Denom, levyAmount))
+		}
+	}
+
+	if levyCoins.IsZero() {
+		return levyCoins, levy.LevyModule, nil
+	}
+
+	if err := qss.bankKeeper.SendCoinsFromModuleToModule(ctx, feeCollectorModule, levy.LevyModule, levyCoins); err != nil {
+		return nil, "", fmt.Errorf("failed to send regulatory levy to %s: %w", levy.LevyModule, err)
+	}
+
+	// 3. Emit levy event separately from the split
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			"regulatory_levy",
+			sdk.NewAttribute("country", country),
+			sdk.NewAttribute("requester_did", requesterDID),
+			sdk.NewAttribute("levy_rate", levy.Rate.String()),
+			sdk.NewAttribute("levy_amount", levyCoins.String()),
+			sdk.NewAttribute("levy_account", levy.LevyModule),
+		),
+	)
+
+	ctx.Logger().Info("SOVRA Economics: Regulatory levy deducted",
+		"country", country,
+		"levy_amount", levyCoins.String(),
+		"levy_account", levy.LevyModule,
+	)
+
+	return levyCoins, levy.LevyModule, nil
+}
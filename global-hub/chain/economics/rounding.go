@@ -0,0 +1,73 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Fee Rounding Modes
+//
+// Fee and split shares are computed as decimals and converted to whole uSOV.
+// Truncation (the default) always rounds down, which systematically
+// under-distributes by sub-uSOV amounts; revenue-sensitive deployments can
+// switch to round-half-up or banker's rounding.
+
+package economics
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RoundingMode determines how decimal uSOV amounts become whole uSOV
+type RoundingMode string
+
+const (
+	// RoundingTruncate rounds toward zero (default, matches historical behavior)
+	RoundingTruncate RoundingMode = "truncate"
+
+	// RoundingHalfUp rounds halves away from zero (2.5 -> 3)
+	RoundingHalfUp RoundingMode = "half_up"
+
+	// RoundingHalfEven rounds halves to the nearest even integer (banker's: 2.5 -> 2, 3.5 -> 4)
+	RoundingHalfEven RoundingMode = "half_even"
+)
+
+// Validate returns an error for unknown rounding modes
+func (m RoundingMode) Validate() error {
+	switch m {
+	case RoundingTruncate, RoundingHalfUp, RoundingHalfEven:
+		return nil
+	}
+	return fmt.Errorf("unknown rounding mode: %s", m)
+}
+
+// Apply converts a decimal amount to whole uSOV using the rounding mode
+func (m RoundingMode) Apply(amount sdk.Dec) sdk.Int {
+	switch m {
+	case RoundingHalfUp:
+		half := sdk.NewDecWithPrec(5, 1)
+		if amount.IsNegative() {
+			return amount.Neg().Add(half).TruncateInt().Neg()
+		}
+		return amount.Add(half).TruncateInt()
+	case RoundingHalfEven:
+		return amount.RoundInt() // sdk.Dec rounds half to even
+	default:
+		return amount.TruncateInt()
+	}
+}
+
+// SetRoundingMode sets the rounding applied to split shares and levies
+func (qss *QuadraticSovereignSplit) SetRoundingMode(mode RoundingMode) error {
+	if err := mode.Validate(); err != nil {
+		return err
+	}
+	qss.rounding = mode
+	return nil
+}
+
+// GetRoundingMode returns the rounding applied to split shares and levies
+func (qss *QuadraticSovereignSplit) GetRoundingMode() RoundingMode {
+	return qss.rounding
+}
+
+// share computes rate * amount in whole uSOV using the configured rounding mode
+func (qss *QuadraticSovereignSplit) share(amount sdk.Int, rate sdk.Dec) sdk.Int {
+	return qss.rounding.Apply(amount.ToDec().Mul(rate))
+}
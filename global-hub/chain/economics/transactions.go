@@ -23,6 +23,9 @@ const (
 	STATUS_SUFFICIENT_FUNDS                  = "sufficient_funds"
 )
 
+// PROXY_PAYMENT_PURPOSE_PREFIX prefixes the vault debit purpose of every proxy payment
+const PROXY_PAYMENT_PURPOSE_PREFIX = "proxy_payment_for_traveler_"
+
 // ProxyPaymentRequest represents a request for third-party payment
 type ProxyPaymentRequest struct {
 	TravelerDID string    // DID of the traveler being verified
@@ -84,11 +87,17 @@ type SovereignVault struct {
 	UpdatedAt time.Time
 }
 
+// FeeLimiter enforces a maximum fee ceiling (implemented by the hub's payment guard)
+type FeeLimiter interface {
+	CheckFee(feeUSOV int64) error
+}
+
 // ProxyPaymentProtocol implements the proxy payment system
 type ProxyPaymentProtocol struct {
 	vaultMgr        VaultManager
 	economicsKernel *QuadraticSovereignSplit
 	vitalianRecords map[string]*VitalianRecord // In-memory storage (use DB in production)
+	feeLimiter      FeeLimiter                 // Optional global fee ceiling
 }
 
 // NewProxyPaymentProtocol creates a new proxy payment protocol instance
@@ -100,6 +109,11 @@ func NewProxyPaymentProtocol(vaultMgr VaultManager, economicsKernel *QuadraticSo
 	}
 }
 
+// SetFeeLimiter enables the global maximum fee ceiling for proxy payments
+func (ppp *ProxyPaymentProtocol) SetFeeLimiter(feeLimiter FeeLimiter) {
+	ppp.feeLimiter = feeLimiter
+}
+
 // CheckBalanceBeforeTransaction validates user has sufficient funds
 // Returns STATUS_INSUFFICIENT_FUNDS_PROXY_REQUIRED if balance < fee
 //
@@ -172,6 +186,13 @@ func (ppp *ProxyPaymentProtocol) ExecuteProxyPayment(
 	fee int64,
 	pffHash string,
 ) (*ProxyPaymentResult, error) {
+	// Last-line guard: refuse fees above the global ceiling
+	if ppp.feeLimiter != nil {
+		if err := ppp.feeLimiter.CheckFee(fee); err != nil {
+			return nil, fmt.Errorf("proxy payment refused: %w", err)
+		}
+	}
+
 	// 1. Get proxy (airport) vault and check balance
 	proxyVault, err := ppp.vaultMgr.GetVault(context.Background(), proxyDID)
 	if err != nil {
@@ -189,7 +210,7 @@ func (ppp *ProxyPaymentProtocol) ExecuteProxyPayment(
 		context.Background(),
 		proxyDID,
 		fee,
-		fmt.Sprintf("proxy_payment_for_traveler_%s", travelerDID),
+		PROXY_PAYMENT_PURPOSE_PREFIX+travelerDID,
 		pffHash,
 	)
 	if err != nil {
@@ -295,6 +316,15 @@ func (ppp *ProxyPaymentProtocol) GetVitalianRecord(recordID string) (*VitalianRe
 	return record, nil
 }
 
+// GetAllVitalianRecords retrieves every Vitalian record (used for reconciliation)
+func (ppp *ProxyPaymentProtocol) GetAllVitalianRecords() []*VitalianRecord {
+	records := make([]*VitalianRecord, 0, len(ppp.vitalianRecords))
+	for _, record := range ppp.vitalianRecords {
+		records = append(records, record)
+	}
+	return records
+}
+
 // GetTravelerVerificationHistory retrieves all verification records for a traveler
 func (ppp *ProxyPaymentProtocol) GetTravelerVerificationHistory(travelerDID string) []*VitalianRecord {
 	var records []*VitalianRecord
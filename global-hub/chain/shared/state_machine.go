@@ -0,0 +1,67 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ============================================================================
+// SOVRA Protocol - Status Transition Rules
+// ============================================================================
+
+// ErrIllegalTransition is returned when a status change is not allowed
+var ErrIllegalTransition = errors.New("illegal status transition")
+
+// StateMachine holds the allowed status transitions for one entity type
+// (consultation contracts, withdrawal proposals, settlement transactions).
+// Each entity declares its table next to its status constants, so the rules
+// live in one place instead of ad hoc checks in every method.
+type StateMachine struct {
+	entity      string
+	transitions map[string]map[string]bool
+}
+
+// NewStateMachine creates a state machine from a from -> allowed-targets table
+func NewStateMachine(entity string, transitions map[string][]string) *StateMachine {
+	sm := &StateMachine{
+		entity:      entity,
+		transitions: make(map[string]map[string]bool),
+	}
+
+	for from, targets := range transitions {
+		sm.transitions[from] = make(map[string]bool)
+		for _, to := range targets {
+			sm.transitions[from][to] = true
+		}
+	}
+
+	return sm
+}
+
+// CanTransition returns true if moving from one status to another is allowed
+func (sm *StateMachine) CanTransition(from, to string) bool {
+	return sm.transitions[from][to]
+}
+
+// ValidateTransition returns an error wrapping ErrIllegalTransition unless the move is allowed
+// Callers check it before mutating the entity's status
+func (sm *StateMachine) ValidateTransition(from, to string) error {
+	if !sm.CanTransition(from, to) {
+		return fmt.Errorf("%w: %s cannot move from %s to %s", ErrIllegalTransition, sm.entity, from, to)
+	}
+	return nil
+}
+
+// AllowedTransitions returns the statuses reachable from the given status
+func (sm *StateMachine) AllowedTransitions(from string) []string {
+	targets := make([]string, 0, len(sm.transitions[from]))
+	for to := range sm.transitions[from] {
+		targets = append(targets, to)
+	}
+	return targets
+}
+
+// IsTerminal returns true if no transitions are allowed out of the status
+func (sm *StateMachine) IsTerminal(status string) bool {
+	return len(sm.transitions[status]) == 0
+}
@@ -11,6 +11,9 @@
 package ante
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -30,11 +33,13 @@ type BurnEngineDecorator struct {
 }
 
 // NewBurnEngineDecorator creates a new BurnEngineDecorator with Quadratic-Sovereign-Split
-func NewBurnEngineDecorator(ak AccountKeeper, bk BankKeeper) BurnEngineDecorator {
+// split is the app's configured kernel (levy keeper, rounding, receipt key), shared
+// with the rest of the app rather than a private copy with no levies.
+func NewBurnEngineDecorator(ak AccountKeeper, bk BankKeeper, split *economics.QuadraticSovereignSplit) BurnEngineDecorator {
 	return BurnEngineDecorator{
 		accountKeeper: ak,
 		bankKeeper:    bk,
-		economicsKernel: economics.NewQuadraticSovereignSplit(bk),
+		economicsKernel: split,
 	}
 }
 
@@ -93,13 +98,18 @@ func (bed BurnEngineDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 // distributeFees implements the Quadratic-Sovereign-Split logic
 // FOUR PILLARS: Equal 25% distribution across all four destinations
 func (bed BurnEngineDecorator) distributeFees(ctx sdk.Context, fees sdk.Coins, requesterDID string) error {
-	// Execute Four-Way Split using economics kernel
+	// Deduct the requester jurisdiction's regulatory levy (if any), then
+	// execute Four-Way Split using economics kernel on the remainder
 	// This distributes fees across:
 	// - 25% Citizen Dividend Pool
 	// - 25% Project R&D Vault (time-locked multisig)
 	// - 25% Nation Infrastructure Pool
 	// - 25% Deflation Burn (black hole address)
-	return bed.economicsKernel.ExecuteFourWaySplit(ctx, fees, types.FeeCollectorName)
+	// The distribution receipt is keyed by the verification tx hash.
+	txHash := sha256.Sum256(ctx.TxBytes())
+	originID := hex.EncodeToString(txHash[:])
+
+	return bed.economicsKernel.ExecuteFourWaySplitWithLevy(ctx, fees, types.FeeCollectorName, requesterDID, originID)
 }
 
 // BankKeeper defines the expected bank keeper interface
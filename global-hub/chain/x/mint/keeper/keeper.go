@@ -72,6 +72,12 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
+// GetJurisdictionLevy returns the regulatory levy a country's verification fees pay
+// Implements economics.LevyKeeper, so levies follow governance param changes.
+func (k Keeper) GetJurisdictionLevy(ctx sdk.Context, country string) (types.JurisdictionLevy, bool) {
+	return k.GetParams(ctx).GetJurisdictionLevy(country)
+}
+
 // SetParams sets the mint parameters to the param space
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSpace.SetParamSet(ctx, &params)
@@ -119,6 +125,8 @@ func (k Keeper) MintOnVerification(ctx sdk.Context, recipient sdk.AccAddress) er
 		return err
 	}
 
+	k.addTotalMinted(ctx, mintAmount)
+
 	// Emit event for minting
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -136,6 +144,9 @@ func (k Keeper) MintOnVerification(ctx sdk.Context, recipient sdk.AccAddress) er
 		"new_supply", currentSupply.Add(mintAmount).String(),
 	)
 
+	// Minting may have pushed supply across the burn rate threshold
+	k.TrackBurnRate(ctx)
+
 	return nil
 }
 
@@ -160,18 +171,85 @@ func (k Keeper) GetMintingStats(ctx sdk.Context) types.MintingStats {
 // Returns current supply status including burn rate and remaining mintable supply
 func (k Keeper) GetSupplyStatus(ctx sdk.Context) types.SupplyStatus {
 	circulatingSupply := k.bankKeeper.GetSupply(ctx, "usov").Amount
-	return k.equilibriumController.GetSupplyStatus(circulatingSupply)
+	status := k.equilibriumController.GetSupplyStatus(circulatingSupply)
+	status.CurrentBurnRate = k.GetCurrentBurnRate(ctx)
+	return status
 }
 
 // SOVRA_Sovereign_Kernel: GetCurrentBurnRate
 //
 // Core ledger function for querying current dynamic burn rate
 // Returns 1% (base) or 1.5% (elevated) based on circulating supply
+// In deflation target mode, returns the rate of the last controller step
+// (read-only; the controller advances in TrackBurnRate).
 func (k Keeper) GetCurrentBurnRate(ctx sdk.Context) sdk.Dec {
+	if k.isDeflationTargetMode() {
+		if state, found := k.getDeflationTargetState(ctx); found {
+			return state.LastRate
+		}
+	}
+
 	circulatingSupply := k.bankKeeper.GetSupply(ctx, "usov").Amount
 	return k.equilibriumController.GetCurrentBurnRate(circulatingSupply)
 }
 
+// isDeflationTargetMode reports whether the burn rate is tuned by the deflation target controller
+func (k Keeper) isDeflationTargetMode() bool {
+	params := k.equilibriumController.GetParams()
+	return params.IsEquilibriumEnabled && params.BurnRateMode == types.BurnRateModeTarget
+}
+
+// getDeflationTargetState returns the stored controller state, or false before the first step
+func (k Keeper) getDeflationTargetState(ctx sdk.Context) (types.DeflationTargetState, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DeflationTargetStateKey)
+	if bz == nil {
+		return types.DeflationTargetState{}, false
+	}
+
+	var state types.DeflationTargetState
+	k.cdc.MustUnmarshal(bz, &state)
+	return state, true
+}
+
+// stepDeflationTarget advances the controller at most once per block and returns the new rate
+// Later calls in the same block return the rate already computed for it.
+func (k Keeper) stepDeflationTarget(ctx sdk.Context, circulatingSupply sdk.Int) sdk.Dec {
+	state, found := k.getDeflationTargetState(ctx)
+	if !found {
+		state = types.NewDeflationTargetState()
+	} else if state.LastHeight == ctx.BlockHeight() {
+		return state.LastRate
+	}
+
+	rate, next := k.equilibriumController.StepTargetBurnRate(circulatingSupply, state)
+	next.LastHeight = ctx.BlockHeight()
+
+	bz := k.cdc.MustMarshal(&next)
+	ctx.KVStore(k.storeKey).Set(types.DeflationTargetStateKey, bz)
+
+	return rate
+}
+
+// GetTotalMinted returns the cumulative uSOV minted on verifications
+func (k Keeper) GetTotalMinted(ctx sdk.Context) sdk.Int {
+	bz := ctx.KVStore(k.storeKey).Get(types.TotalMintedKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	total, ok := sdk.NewIntFromString(string(bz))
+	if !ok {
+		return sdk.ZeroInt()
+	}
+	return total
+}
+
+// addTotalMinted adds a minted amount to the cumulative total
+func (k Keeper) addTotalMinted(ctx sdk.Context, amount sdk.Int) {
+	total := k.GetTotalMinted(ctx).Add(amount)
+	ctx.KVStore(k.storeKey).Set(types.TotalMintedKey, []byte(total.String()))
+}
+
 // SOVRA_Sovereign_Kernel: GetBlackHoleBalance
 //
 // Core ledger function for querying black hole address balance
@@ -184,3 +262,84 @@ func (k Keeper) GetBlackHoleBalance(ctx sdk.Context) sdk.Int {
 	return k.bankKeeper.GetBalance(ctx, blackHoleAddr, "usov").Amount
 }
 
+// SOVRA_Sovereign_Kernel: TrackBurnRate
+//
+// Compares the current burn rate with the last observed rate and records a
+// BurnRateChange entry when supply has crossed the threshold. Called after
+// minting; burn paths that shrink supply should call it too. In deflation
+// target mode it also advances the controller (once per block), so every
+// change of the continuous rate is recorded.
+// Before any rate has been observed the base rate is assumed.
+func (k Keeper) TrackBurnRate(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	circulatingSupply := k.bankKeeper.GetSupply(ctx, "usov").Amount
+	newRate := k.equilibriumController.GetCurrentBurnRate(circulatingSupply)
+	if k.isDeflationTargetMode() {
+		newRate = k.stepDeflationTarget(ctx, circulatingSupply)
+	}
+
+	previousRate := k.equilibriumController.GetParams().BaseBurnRate
+	if bz := store.Get(types.LastBurnRateKey); bz != nil {
+		rate, err := sdk.NewDecFromStr(string(bz))
+		if err == nil {
+			previousRate = rate
+		}
+	}
+
+	if newRate.Equal(previousRate) {
+		store.Set(types.LastBurnRateKey, []byte(newRate.String()))
+		return
+	}
+
+	change := types.BurnRateChange{
+		BlockHeight:      ctx.BlockHeight(),
+		Timestamp:        ctx.BlockTime(),
+		PreviousRate:     previousRate,
+		NewRate:          newRate,
+		TriggeringSupply: circulatingSupply,
+	}
+
+	bz := k.cdc.MustMarshal(&change)
+	store.Set(types.BurnRateChangeKey(change.BlockHeight), bz)
+	store.Set(types.LastBurnRateKey, []byte(newRate.String()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnRateChange,
+			sdk.NewAttribute(types.AttributeKeyPreviousRate, previousRate.String()),
+			sdk.NewAttribute(types.AttributeKeyNewRate, newRate.String()),
+			sdk.NewAttribute(types.AttributeKeyTriggeringSupply, circulatingSupply.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", change.BlockHeight)),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"[SOVRA_Sovereign_Kernel] Burn rate changed",
+		"previous_rate", previousRate.String(),
+		"new_rate", newRate.String(),
+		"triggering_supply", circulatingSupply.String(),
+		"height", change.BlockHeight,
+	)
+}
+
+// SOVRA_Sovereign_Kernel: GetBurnRateHistory
+//
+// Core ledger function for querying burn rate transitions
+// Returns every recorded change in chronological order (oldest first)
+func (k Keeper) GetBurnRateHistory(ctx sdk.Context) []types.BurnRateChange {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BurnRateChangePrefix)
+	defer iterator.Close()
+
+	history := []types.BurnRateChange{}
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.BurnRateChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		history = append(history, change)
+	}
+
+	return history
+}
@@ -0,0 +1,37 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Burn Rate History
+//
+// Records every transition of the dynamic burn rate (1% <-> 1.5%) together
+// with the block height and the circulating supply that triggered it.
+
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BurnRateChange records a single burn rate transition
+type BurnRateChange struct {
+	// BlockHeight is the height at which the new rate took effect
+	BlockHeight int64 `json:"block_height"`
+
+	// Timestamp is the block time of the transition
+	Timestamp time.Time `json:"timestamp"`
+
+	// PreviousRate is the burn rate before the transition
+	PreviousRate sdk.Dec `json:"previous_rate"`
+
+	// NewRate is the burn rate after the transition
+	NewRate sdk.Dec `json:"new_rate"`
+
+	// TriggeringSupply is the circulating supply that crossed the threshold
+	TriggeringSupply sdk.Int `json:"triggering_supply"`
+}
+
+// BurnRateChangeKey returns the store key for a transition at the given height
+// Heights are big-endian so prefix iteration returns entries in chronological order.
+func BurnRateChangeKey(blockHeight int64) []byte {
+	return append(append([]byte{}, BurnRateChangePrefix...), sdk.Uint64ToBigEndian(uint64(blockHeight))...)
+}
@@ -0,0 +1,197 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// SOVRA_Sovereign_Kernel - Deflation Target Mode
+//
+// Optional alternative to the 1% / 1.5% threshold step. A PID-style controller
+// moves the burn rate continuously, within configured bounds, to steer
+// circulating supply into a target band. All arithmetic is sdk.Dec, so every
+// validator computes the same rate from the same supply and controller state.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BurnRateMode selects how the dynamic burn rate is computed
+type BurnRateMode string
+
+const (
+	// BurnRateModeStep toggles between the base and elevated rate at SupplyThreshold (default)
+	BurnRateModeStep BurnRateMode = "step"
+
+	// BurnRateModeTarget tunes the rate continuously toward the target supply band
+	BurnRateModeTarget BurnRateMode = "target"
+)
+
+// Deflation target defaults
+const (
+	// TARGET_SUPPLY_LOW / TARGET_SUPPLY_HIGH bound the target band (450M - 550M SOV in uSOV)
+	TARGET_SUPPLY_LOW  = int64(450_000_000_000_000)
+	TARGET_SUPPLY_HIGH = int64(550_000_000_000_000)
+
+	// MIN_BURN_RATE / MAX_BURN_RATE bound the controller output (0.5% - 2%)
+	MIN_BURN_RATE = "0.005"
+	MAX_BURN_RATE = "0.02"
+
+	// Controller gains (error is the distance outside the band as a fraction of max supply)
+	TARGET_PROPORTIONAL_GAIN = "0.1"    // +0.5% burn at 5% of max supply above the band
+	TARGET_INTEGRAL_GAIN     = "0.0001" // Per controller step (one per block)
+	TARGET_DERIVATIVE_GAIN   = "0"
+)
+
+// DeflationTargetParams configures target mode
+type DeflationTargetParams struct {
+	// TargetSupplyLow and TargetSupplyHigh bound the circulating supply band to steer into
+	TargetSupplyLow  sdk.Int `json:"target_supply_low"`
+	TargetSupplyHigh sdk.Int `json:"target_supply_high"`
+
+	// MinBurnRate and MaxBurnRate bound the computed burn rate
+	MinBurnRate sdk.Dec `json:"min_burn_rate"`
+	MaxBurnRate sdk.Dec `json:"max_burn_rate"`
+
+	// ProportionalGain, IntegralGain and DerivativeGain weight the controller terms
+	ProportionalGain sdk.Dec `json:"proportional_gain"`
+	IntegralGain     sdk.Dec `json:"integral_gain"`
+	DerivativeGain   sdk.Dec `json:"derivative_gain"`
+}
+
+// DeflationTargetState is the controller state carried between steps
+type DeflationTargetState struct {
+	// Integral is the accumulated error
+	Integral sdk.Dec `json:"integral"`
+
+	// LastError is the error at the previous step (for the derivative term)
+	LastError sdk.Dec `json:"last_error"`
+
+	// LastRate is the burn rate computed at the previous step
+	LastRate sdk.Dec `json:"last_rate"`
+
+	// LastHeight is the block height of the previous step (the state advances once per block)
+	LastHeight int64 `json:"last_height"`
+}
+
+// DefaultDeflationTargetParams returns default target mode parameters
+func DefaultDeflationTargetParams() DeflationTargetParams {
+	minBurnRate, _ := sdk.NewDecFromStr(MIN_BURN_RATE)
+	maxBurnRate, _ := sdk.NewDecFromStr(MAX_BURN_RATE)
+	kp, _ := sdk.NewDecFromStr(TARGET_PROPORTIONAL_GAIN)
+	ki, _ := sdk.NewDecFromStr(TARGET_INTEGRAL_GAIN)
+	kd, _ := sdk.NewDecFromStr(TARGET_DERIVATIVE_GAIN)
+
+	return DeflationTargetParams{
+		TargetSupplyLow:  sdk.NewInt(TARGET_SUPPLY_LOW),
+		TargetSupplyHigh: sdk.NewInt(TARGET_SUPPLY_HIGH),
+		MinBurnRate:      minBurnRate,
+		MaxBurnRate:      maxBurnRate,
+		ProportionalGain: kp,
+		IntegralGain:     ki,
+		DerivativeGain:   kd,
+	}
+}
+
+// NewDeflationTargetState returns the initial controller state
+func NewDeflationTargetState() DeflationTargetState {
+	return DeflationTargetState{
+		Integral:  sdk.ZeroDec(),
+		LastError: sdk.ZeroDec(),
+		LastRate:  sdk.ZeroDec(),
+	}
+}
+
+// Validate validates target mode parameters against the equilibrium params
+func (dtp DeflationTargetParams) Validate(sep SupplyEquilibriumParams) error {
+	if dtp.TargetSupplyLow.IsNegative() {
+		return fmt.Errorf("target supply low cannot be negative")
+	}
+
+	if dtp.TargetSupplyHigh.LT(dtp.TargetSupplyLow) {
+		return fmt.Errorf("target supply high must be >= target supply low")
+	}
+
+	if dtp.TargetSupplyHigh.GT(sep.MaxTotalSupply) {
+		return fmt.Errorf("target supply band cannot exceed max total supply")
+	}
+
+	if dtp.MinBurnRate.IsNegative() || dtp.MaxBurnRate.GT(sdk.OneDec()) {
+		return fmt.Errorf("burn rate bounds must be between 0 and 1")
+	}
+
+	if dtp.MaxBurnRate.LT(dtp.MinBurnRate) {
+		return fmt.Errorf("max burn rate must be >= min burn rate")
+	}
+
+	if sep.BaseBurnRate.LT(dtp.MinBurnRate) || sep.BaseBurnRate.GT(dtp.MaxBurnRate) {
+		return fmt.Errorf("base burn rate must lie within the target mode burn rate bounds")
+	}
+
+	if dtp.ProportionalGain.IsNegative() || dtp.IntegralGain.IsNegative() || dtp.DerivativeGain.IsNegative() {
+		return fmt.Errorf("controller gains cannot be negative")
+	}
+
+	return nil
+}
+
+// supplyError returns how far supply lies outside the target band, as a fraction of max supply
+// Positive above the band (burn more), negative below it (burn less), zero inside it.
+func (sec *SupplyEquilibriumController) supplyError(circulatingSupply sdk.Int) sdk.Dec {
+	target := sec.params.DeflationTarget
+	maxSupply := sdk.NewDecFromInt(sec.params.MaxTotalSupply)
+
+	switch {
+	case circulatingSupply.GT(target.TargetSupplyHigh):
+		return sdk.NewDecFromInt(circulatingSupply.Sub(target.TargetSupplyHigh)).Quo(maxSupply)
+	case circulatingSupply.LT(target.TargetSupplyLow):
+		return sdk.NewDecFromInt(circulatingSupply.Sub(target.TargetSupplyLow)).Quo(maxSupply)
+	default:
+		return sdk.ZeroDec()
+	}
+}
+
+// StepTargetBurnRate computes the target mode burn rate and the next controller state
+//
+// CONTROLLER LOGIC:
+// 1. Error = distance of supply outside the target band / MaxTotalSupply (0 inside the band)
+// 2. Rate = BaseBurnRate + Kp*error + Ki*(integral + error) + Kd*(error - last error)
+// 3. Rate is clamped to [MinBurnRate, MaxBurnRate]
+// 4. The error is only integrated while the rate is not pinned at the bound it pushes toward (anti-windup)
+// The same supply and state always yield the same rate.
+func (sec *SupplyEquilibriumController) StepTargetBurnRate(circulatingSupply sdk.Int, state DeflationTargetState) (sdk.Dec, DeflationTargetState) {
+	target := sec.params.DeflationTarget
+
+	// 1. Error
+	e := sec.supplyError(circulatingSupply)
+
+	// 2. PID terms
+	integral := state.Integral.Add(e)
+	derivative := e.Sub(state.LastError)
+
+	rate := sec.params.BaseBurnRate.
+		Add(target.ProportionalGain.Mul(e)).
+		Add(target.IntegralGain.Mul(integral)).
+		Add(target.DerivativeGain.Mul(derivative))
+
+	// 3-4. Clamp, and stop integrating into a saturated bound
+	switch {
+	case rate.GT(target.MaxBurnRate):
+		rate = target.MaxBurnRate
+		if e.IsPositive() {
+			integral = state.Integral
+		}
+	case rate.LT(target.MinBurnRate):
+		rate = target.MinBurnRate
+		if e.IsNegative() {
+			integral = state.Integral
+		}
+	}
+
+	next := DeflationTargetState{
+		Integral:   integral,
+		LastError:  e,
+		LastRate:   rate,
+		LastHeight: state.LastHeight,
+	}
+
+	return rate, next
+}
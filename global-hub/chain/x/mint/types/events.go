@@ -3,8 +3,14 @@ package types
 // Minting module event types
 const (
 	EventTypeMintOnVerification = "mint_on_verification"
+	EventTypeBurnRateChange     = "burn_rate_change"
 	
 	AttributeKeyRecipient = "recipient"
 	AttributeKeyAmount    = "amount"
+
+	AttributeKeyPreviousRate     = "previous_rate"
+	AttributeKeyNewRate          = "new_rate"
+	AttributeKeyTriggeringSupply = "triggering_supply"
+	AttributeKeyBlockHeight      = "block_height"
 )
 
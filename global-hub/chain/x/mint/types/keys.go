@@ -14,3 +14,16 @@ const (
 	QuerierRoute = ModuleName
 )
 
+var (
+	// BurnRateChangePrefix is the prefix for storing burn rate transitions by block height
+	BurnRateChangePrefix = []byte{0x01}
+
+	// LastBurnRateKey stores the most recently observed burn rate
+	LastBurnRateKey = []byte{0x02}
+
+	// DeflationTargetStateKey stores the target mode controller state
+	DeflationTargetStateKey = []byte{0x03}
+
+	// TotalMintedKey stores the cumulative uSOV minted on verifications
+	TotalMintedKey = []byte{0x04}
+)
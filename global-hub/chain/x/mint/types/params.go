@@ -7,10 +7,14 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// MaxRegulatoryLevy is the highest levy rate a jurisdiction may configure (10%)
+const MaxRegulatoryLevy = "0.10"
+
 // Parameter store keys
 var (
 	KeyUsageBasedMinting = []byte("UsageBasedMinting")
 	KeyMintPerVerification = []byte("MintPerVerification")
+	KeyJurisdictionLevies = []byte("JurisdictionLevies")
 )
 
 // ParamKeyTable for mint module
@@ -26,13 +30,27 @@ type Params struct {
 	// MintPerVerification is the amount of uSOV to mint per PFF verification
 	// Default: 10 uSOV (0.00001 SOV)
 	MintPerVerification sdk.Int `protobuf:"bytes,2,opt,name=mint_per_verification,json=mintPerVerification,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"mint_per_verification"`
+	
+	// JurisdictionLevies are the per-country regulatory levies deducted from
+	// verification fees before the Four-Way Split (governance-controlled)
+	JurisdictionLevies []JurisdictionLevy `protobuf:"bytes,3,rep,name=jurisdiction_levies,json=jurisdictionLevies,proto3" json:"jurisdiction_levies"`
+}
+
+// JurisdictionLevy is the regulatory levy rate for one DID country
+type JurisdictionLevy struct {
+	// Country is the DID country code (e.g., "nigeria")
+	Country string `protobuf:"bytes,1,opt,name=country,proto3" json:"country"`
+	
+	// Rate is the fraction of each fee taken as levy (e.g., 0.02 = 2%)
+	Rate sdk.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
 }
 
 // NewParams creates a new Params instance
-func NewParams(usageBasedMinting bool, mintPerVerification sdk.Int) Params {
+func NewParams(usageBasedMinting bool, mintPerVerification sdk.Int, jurisdictionLevies []JurisdictionLevy) Params {
 	return Params{
 		UsageBasedMinting:   usageBasedMinting,
 		MintPerVerification: mintPerVerification,
+		JurisdictionLevies:  jurisdictionLevies,
 	}
 }
 
@@ -42,6 +60,7 @@ func DefaultParams() Params {
 	return NewParams(
 		true,                    // Enable usage-based minting
 		sdk.NewInt(10),          // 10 uSOV per verification
+		[]JurisdictionLevy{},    // No regulatory levies until governance sets them
 	)
 }
 
@@ -50,6 +69,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyUsageBasedMinting, &p.UsageBasedMinting, validateUsageBasedMinting),
 		paramtypes.NewParamSetPair(KeyMintPerVerification, &p.MintPerVerification, validateMintPerVerification),
+		paramtypes.NewParamSetPair(KeyJurisdictionLevies, &p.JurisdictionLevies, validateJurisdictionLevies),
 	}
 }
 
@@ -61,15 +81,29 @@ func (p Params) Validate() error {
 	if err := validateMintPerVerification(p.MintPerVerification); err != nil {
 		return err
 	}
+	if err := validateJurisdictionLevies(p.JurisdictionLevies); err != nil {
+		return err
+	}
 	return nil
 }
 
+// GetJurisdictionLevy returns the levy configured for a country
+func (p Params) GetJurisdictionLevy(country string) (JurisdictionLevy, bool) {
+	for _, levy := range p.JurisdictionLevies {
+		if levy.Country == country {
+			return levy, true
+		}
+	}
+	return JurisdictionLevy{}, false
+}
+
 // String implements the Stringer interface
 func (p Params) String() string {
 	return fmt.Sprintf(`Mint Params:
   Usage Based Minting: %t
   Mint Per Verification: %s uSOV
-`, p.UsageBasedMinting, p.MintPerVerification)
+  Jurisdiction Levies: %d
+`, p.UsageBasedMinting, p.MintPerVerification, len(p.JurisdictionLevies))
 }
 
 func validateUsageBasedMinting(i interface{}) error {
@@ -97,3 +131,27 @@ func validateMintPerVerification(i interface{}) error {
 	return nil
 }
 
+func validateJurisdictionLevies(i interface{}) error {
+	levies, ok := i.([]JurisdictionLevy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	maxRate := sdk.MustNewDecFromStr(MaxRegulatoryLevy)
+	seen := make(map[string]bool)
+	for _, levy := range levies {
+		if levy.Country == "" {
+			return fmt.Errorf("jurisdiction levy country cannot be empty")
+		}
+		if seen[levy.Country] {
+			return fmt.Errorf("duplicate jurisdiction levy for country: %s", levy.Country)
+		}
+		seen[levy.Country] = true
+
+		if levy.Rate.IsNil() || levy.Rate.IsNegative() || levy.Rate.GT(maxRate) {
+			return fmt.Errorf("levy rate for %s must be between 0 and %s, got %s", levy.Country, MaxRegulatoryLevy, levy.Rate)
+		}
+	}
+
+	return nil
+}
@@ -55,6 +55,12 @@ type SupplyEquilibriumParams struct {
 
 	// IsEquilibriumEnabled enables/disables supply equilibrium control
 	IsEquilibriumEnabled bool `json:"is_equilibrium_enabled"`
+
+	// BurnRateMode selects the threshold step (default) or the deflation target controller
+	BurnRateMode BurnRateMode `json:"burn_rate_mode"`
+
+	// DeflationTarget configures the controller used in target mode
+	DeflationTarget DeflationTargetParams `json:"deflation_target"`
 }
 
 // DefaultSupplyEquilibriumParams returns default supply equilibrium parameters
@@ -69,6 +75,8 @@ func DefaultSupplyEquilibriumParams() SupplyEquilibriumParams {
 		ElevatedBurnRate:     elevatedBurnRate,
 		BlackHoleAddress:     BLACK_HOLE_ADDRESS,
 		IsEquilibriumEnabled: true,
+		BurnRateMode:         BurnRateModeStep,
+		DeflationTarget:      DefaultDeflationTargetParams(),
 	}
 }
 
@@ -102,6 +110,16 @@ func (sep SupplyEquilibriumParams) Validate() error {
 		return fmt.Errorf("black hole address cannot be empty")
 	}
 
+	switch sep.BurnRateMode {
+	case BurnRateModeStep:
+	case BurnRateModeTarget:
+		if err := sep.DeflationTarget.Validate(sep); err != nil {
+			return fmt.Errorf("invalid deflation target: %w", err)
+		}
+	default:
+		return fmt.Errorf("invalid burn rate mode: %s", sep.BurnRateMode)
+	}
+
 	return nil
 }
 
@@ -117,6 +135,11 @@ func NewSupplyEquilibriumController(params SupplyEquilibriumParams) *SupplyEquil
 	}
 }
 
+// GetParams returns the supply equilibrium parameters
+func (sec *SupplyEquilibriumController) GetParams() SupplyEquilibriumParams {
+	return sec.params
+}
+
 // CanMint checks if minting is allowed based on current supply
 // Returns error if minting would exceed MAX_TOTAL_SUPPLY
 func (sec *SupplyEquilibriumController) CanMint(currentSupply sdk.Int, mintAmount sdk.Int) error {
@@ -141,11 +164,18 @@ func (sec *SupplyEquilibriumController) CanMint(currentSupply sdk.Int, mintAmoun
 // GetCurrentBurnRate returns the current burn rate based on circulating supply
 // Returns BASE_BURN_RATE (1%) if supply < threshold
 // Returns ELEVATED_BURN_RATE (1.5%) if supply >= threshold
+// In target mode, returns the controller output from a fresh state (no integral
+// or derivative history); the keeper carries the state between blocks.
 func (sec *SupplyEquilibriumController) GetCurrentBurnRate(circulatingSupply sdk.Int) sdk.Dec {
 	if !sec.params.IsEquilibriumEnabled {
 		return sec.params.BaseBurnRate
 	}
 
+	if sec.params.BurnRateMode == BurnRateModeTarget {
+		rate, _ := sec.StepTargetBurnRate(circulatingSupply, NewDeflationTargetState())
+		return rate
+	}
+
 	if circulatingSupply.GTE(sec.params.SupplyThreshold) {
 		return sec.params.ElevatedBurnRate
 	}
@@ -0,0 +1,156 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// VLT_Core Security Module - Blacklist Merkle Log
+//
+// The keeper appends every newly blacklisted PFF hash to a Merkle log kept in
+// the module store, alongside the log's Merkle frontier so the root is updated
+// incrementally on append. Citizens and auditors fetch the root and inclusion
+// proofs and verify them with types.VerifyBlacklistInclusion.
+
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sovrn-protocol/sovrn/x/vltcore/types"
+)
+
+// appendToBlacklistLog appends a PFF hash to the blacklist log (no-op if already logged)
+// Returns the hash's leaf index.
+func (k Keeper) appendToBlacklistLog(ctx sdk.Context, pffHash string) uint64 {
+	if index, found := k.getBlacklistLogIndex(ctx, pffHash); found {
+		return index
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	index := k.getBlacklistLogSize(ctx)
+
+	indexBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBz, index)
+
+	store.Set(append(types.BlacklistLogLeafPrefix, indexBz...), []byte(pffHash))
+	store.Set(append(types.BlacklistLogIndexPrefix, []byte(pffHash)...), indexBz)
+
+	frontier := types.BlacklistFrontierAppend(k.getBlacklistFrontier(ctx), index, types.BlacklistLeafHash(pffHash))
+	k.setBlacklistFrontier(ctx, frontier)
+
+	sizeBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(sizeBz, index+1)
+	store.Set(types.BlacklistLogSizeKey, sizeBz)
+
+	return index
+}
+
+// getBlacklistFrontier returns the blacklist log's Merkle frontier, indexed by subtree level
+func (k Keeper) getBlacklistFrontier(ctx sdk.Context) [][]byte {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BlacklistLogFrontierPrefix)
+	defer iterator.Close()
+
+	var frontier [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		level := int(iterator.Key()[len(types.BlacklistLogFrontierPrefix)])
+		for len(frontier) <= level {
+			frontier = append(frontier, nil)
+		}
+		frontier[level] = iterator.Value()
+	}
+
+	return frontier
+}
+
+// setBlacklistFrontier stores the Merkle frontier, deleting levels with no complete subtree
+func (k Keeper) setBlacklistFrontier(ctx sdk.Context, frontier [][]byte) {
+	store := ctx.KVStore(k.storeKey)
+
+	for level, node := range frontier {
+		key := append(append([]byte{}, types.BlacklistLogFrontierPrefix...), byte(level))
+		if node == nil {
+			store.Delete(key)
+			continue
+		}
+		store.Set(key, node)
+	}
+}
+
+// getBlacklistLogSize returns the number of hashes in the blacklist log
+func (k Keeper) getBlacklistLogSize(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlacklistLogSizeKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// getBlacklistLogIndex returns a PFF hash's leaf index in the blacklist log
+func (k Keeper) getBlacklistLogIndex(ctx sdk.Context, pffHash string) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(append(types.BlacklistLogIndexPrefix, []byte(pffHash)...))
+	if bz == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// getBlacklistLeaves returns every leaf hash in log order
+// Leaf keys are big-endian indexes, so prefix iteration yields append order.
+func (k Keeper) getBlacklistLeaves(ctx sdk.Context) [][]byte {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.BlacklistLogLeafPrefix)
+	defer iterator.Close()
+
+	var leaves [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		leaves = append(leaves, types.BlacklistLeafHash(string(iterator.Value())))
+	}
+
+	return leaves
+}
+
+// GetBlacklistRoot returns the Merkle root committing to the complete blacklist
+// Computed from the stored frontier, so it reads O(log n) nodes rather than every leaf.
+func (k Keeper) GetBlacklistRoot(ctx sdk.Context) types.BlacklistLogRoot {
+	size := k.getBlacklistLogSize(ctx)
+
+	return types.BlacklistLogRoot{
+		Root:     hex.EncodeToString(types.BlacklistFrontierRoot(k.getBlacklistFrontier(ctx), size)),
+		TreeSize: size,
+	}
+}
+
+// GetBlacklistInclusionProof returns a proof that a PFF hash is blacklisted, with the root it verifies against
+// Fails with ErrNotBlacklisted for hashes that were never blacklisted.
+func (k Keeper) GetBlacklistInclusionProof(ctx sdk.Context, pffHash string) (*types.BlacklistInclusionProof, types.BlacklistLogRoot, error) {
+	index, found := k.getBlacklistLogIndex(ctx, pffHash)
+	if !found {
+		return nil, types.BlacklistLogRoot{}, fmt.Errorf("%w: %s", types.ErrNotBlacklisted, pffHash)
+	}
+
+	leaves := k.getBlacklistLeaves(ctx)
+	path, err := types.BlacklistAuditPath(leaves, index)
+	if err != nil {
+		return nil, types.BlacklistLogRoot{}, fmt.Errorf("failed to build inclusion proof for %s: %w", pffHash, err)
+	}
+
+	auditPath := make([]string, len(path))
+	for i, node := range path {
+		auditPath[i] = hex.EncodeToString(node)
+	}
+
+	proof := &types.BlacklistInclusionProof{
+		PFFHash:   pffHash,
+		LeafIndex: index,
+		TreeSize:  uint64(len(leaves)),
+		AuditPath: auditPath,
+	}
+	root := types.BlacklistLogRoot{
+		Root:     hex.EncodeToString(types.BlacklistFrontierRoot(k.getBlacklistFrontier(ctx), uint64(len(leaves)))),
+		TreeSize: uint64(len(leaves)),
+	}
+
+	return proof, root, nil
+}
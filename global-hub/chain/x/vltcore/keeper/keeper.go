@@ -3,7 +3,8 @@
 //
 // The VLT_Core keeper implements the core security functions for Vitalized Ledger Technology:
 // 1. Vitality_Anchor: Ensures every block contains valid PFF_Liveness_Proof
-// 2. Consensus_of_Presence: 51% consensus mechanism for deepfake detection
+// 2. Consensus_of_Presence: majority consensus mechanism for deepfake detection
+//    (51% by default, governed through the ConsensusThreshold parameter)
 
 package keeper
 
@@ -20,11 +21,12 @@ import (
 
 // Keeper of the vltcore store
 type Keeper struct {
-	cdc            codec.BinaryCodec
-	storeKey       sdk.StoreKey
-	memKey         sdk.StoreKey
-	stakingKeeper  types.StakingKeeper
-	bankKeeper     types.BankKeeper
+	cdc           codec.BinaryCodec
+	storeKey      sdk.StoreKey
+	memKey        sdk.StoreKey
+	paramSpace    types.ParamSubspace
+	stakingKeeper types.StakingKeeper
+	bankKeeper    types.BankKeeper
 }
 
 // NewKeeper creates a new vltcore Keeper instance
@@ -32,18 +34,47 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey sdk.StoreKey,
 	memKey sdk.StoreKey,
+	paramSpace types.ParamSubspace,
 	stakingKeeper types.StakingKeeper,
 	bankKeeper types.BankKeeper,
 ) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
 		memKey:        memKey,
+		paramSpace:    paramSpace,
 		stakingKeeper: stakingKeeper,
 		bankKeeper:    bankKeeper,
 	}
 }
 
+// GetProofAgePolicy returns the PFF proof age window from the module params
+func (k Keeper) GetProofAgePolicy(ctx sdk.Context) types.ProofAgePolicy {
+	return k.GetParams(ctx).ProofAgePolicy()
+}
+
+// GetParams returns the total set of vltcore parameters
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the vltcore parameters to the param space
+// Governance updates go through a parameter change proposal, which applies the same validation.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid vltcore params: %w", err)
+	}
+
+	k.paramSpace.SetParamSet(ctx, &params)
+	return nil
+}
+
 // Logger returns a module-specific logger
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -59,6 +90,10 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // This is the core innovation of Vitalized Ledger Technology: every block must
 // be anchored to real human vitality, not just computational work.
 //
+// Batched verification flows can carry many proofs in one block. Every valid,
+// non-blacklisted proof is stored and gets its own pff_proof_validated event so
+// minting/crediting can key off each one; at least one is still required.
+//
 // AUTONOMOUS: This function executes automatically during block validation.
 // No human intervention required.
 func (k Keeper) Vitality_Anchor(ctx sdk.Context, txs []sdk.Tx) error {
@@ -67,9 +102,59 @@ func (k Keeper) Vitality_Anchor(ctx sdk.Context, txs []sdk.Tx) error {
 		"num_txs", len(txs),
 	)
 
-	// Check if at least one transaction contains a valid PFF_Liveness_Proof
-	hasValidProof := false
-	var validProof *types.PFFLivenessProof
+	// Collect every valid PFF_Liveness_Proof in the block
+	validProofs := k.collectValidProofs(ctx, txs)
+
+	// Reject block if no valid proof found
+	if len(validProofs) == 0 {
+		k.Logger(ctx).Error("VLT_Core: Vitality_Anchor FAILED - No valid PFF_Liveness_Proof found",
+			"block_height", ctx.BlockHeight(),
+		)
+		return fmt.Errorf("vitality anchor failed: block rejected - no valid PFF_Liveness_Proof found")
+	}
+
+	// Store each proof, mark it as used and emit a per-proof event
+	for i, proof := range validProofs {
+		k.storePFFProof(ctx, proof)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePFFProofValidated,
+				sdk.NewAttribute(types.AttributeKeyPFFHash, proof.PFFHash),
+				sdk.NewAttribute(types.AttributeKeyDID, proof.DID),
+				sdk.NewAttribute(types.AttributeKeyProofIndex, fmt.Sprintf("%d", i)),
+				sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+			),
+		)
+	}
+
+	// Emit success event (anchored to the first valid proof)
+	anchor := validProofs[0]
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVitalityAnchor,
+			sdk.NewAttribute(types.AttributeKeyPFFHash, anchor.PFFHash),
+			sdk.NewAttribute(types.AttributeKeyDID, anchor.DID),
+			sdk.NewAttribute(types.AttributeKeyProofCount, fmt.Sprintf("%d", len(validProofs))),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+
+	k.Logger(ctx).Info("VLT_Core: Vitality_Anchor PASSED",
+		"block_height", ctx.BlockHeight(),
+		"pff_hash", anchor.PFFHash,
+		"did", anchor.DID,
+		"valid_proofs", len(validProofs),
+	)
+
+	return nil
+}
+
+// collectValidProofs returns every valid, non-blacklisted proof in the block, in tx order
+// A PFF hash appearing more than once in the same block is only accepted the first time
+func (k Keeper) collectValidProofs(ctx sdk.Context, txs []sdk.Tx) []*types.PFFLivenessProof {
+	validProofs := make([]*types.PFFLivenessProof, 0)
+	seen := make(map[string]bool)
 
 	for _, tx := range txs {
 		// Extract PFF proof from transaction
@@ -87,6 +172,14 @@ func (k Keeper) Vitality_Anchor(ctx sdk.Context, txs []sdk.Tx) error {
 			continue
 		}
 
+		// Same proof submitted twice in one block (replay within the batch)
+		if seen[proof.PFFHash] {
+			k.Logger(ctx).Debug("VLT_Core: Duplicate PFF proof in block",
+				"pff_hash", proof.PFFHash,
+			)
+			continue
+		}
+
 		// Check if proof is blacklisted
 		if k.IsBlacklisted(ctx, proof.PFFHash) {
 			k.Logger(ctx).Warn("VLT_Core: Blacklisted PFF proof detected",
@@ -106,46 +199,17 @@ func (k Keeper) Vitality_Anchor(ctx sdk.Context, txs []sdk.Tx) error {
 			continue
 		}
 
-		// Valid proof found!
-		hasValidProof = true
-		validProof = proof
-		break
+		seen[proof.PFFHash] = true
+		validProofs = append(validProofs, proof)
 	}
 
-	// Reject block if no valid proof found
-	if !hasValidProof {
-		k.Logger(ctx).Error("VLT_Core: Vitality_Anchor FAILED - No valid PFF_Liveness_Proof found",
-			"block_height", ctx.BlockHeight(),
-		)
-		return fmt.Errorf("vitality anchor failed: block rejected - no valid PFF_Liveness_Proof found")
-	}
-
-	// Store the proof and mark as used
-	k.storePFFProof(ctx, validProof)
-
-	// Emit success event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeVitalityAnchor,
-			sdk.NewAttribute(types.AttributeKeyPFFHash, validProof.PFFHash),
-			sdk.NewAttribute(types.AttributeKeyDID, validProof.DID),
-			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
-		),
-	)
-
-	k.Logger(ctx).Info("VLT_Core: Vitality_Anchor PASSED",
-		"block_height", ctx.BlockHeight(),
-		"pff_hash", validProof.PFFHash,
-		"did", validProof.DID,
-	)
-
-	return nil
+	return validProofs
 }
 
 // validatePFFProof validates a PFF liveness proof
 func (k Keeper) validatePFFProof(ctx sdk.Context, proof *types.PFFLivenessProof) error {
 	// 1. Basic validation
-	if err := proof.Validate(); err != nil {
+	if err := proof.ValidateFields(); err != nil {
 		return fmt.Errorf("proof validation failed: %w", err)
 	}
 
@@ -154,9 +218,10 @@ func (k Keeper) validatePFFProof(ctx sdk.Context, proof *types.PFFLivenessProof)
 		return fmt.Errorf("proof already used: replay attack detected")
 	}
 
-	// 3. Check if proof is expired
-	if proof.IsExpired() {
-		return fmt.Errorf("proof expired: older than 5 minutes")
+	// 3. Check proof age against block time, tolerating gate clock skew
+	// Future-dated proofs beyond the MaxFutureSkew param are rejected explicitly
+	if err := proof.ValidateAge(ctx.BlockTime(), k.GetProofAgePolicy(ctx)); err != nil {
+		return fmt.Errorf("proof age check failed: %w", err)
 	}
 
 	// 4. Verify cryptographic signature (in production, verify against DID public key)
@@ -200,13 +265,14 @@ func (k Keeper) isPFFProofUsed(ctx sdk.Context, pffHash string) bool {
 }
 
 // ============================================================================
-// CONSENSUS OF PRESENCE: 51% Deepfake Detection Mechanism
+// CONSENSUS OF PRESENCE: Majority Deepfake Detection Mechanism
 // ============================================================================
 
-// Consensus_of_Presence implements a 51% consensus mechanism for deepfake detection.
+// Consensus_of_Presence implements a majority consensus mechanism for deepfake detection.
 //
-// If 51% or more of validator nodes flag a PFF scan as a "Potential Deepfake,"
-// the transaction is blacklisted globally.
+// If the governed ConsensusThreshold (51% by default) or more of validator nodes
+// flag a PFF scan as a "Potential Deepfake," the transaction is blacklisted globally.
+// The threshold is read from the module params at tally time.
 //
 // AUTONOMOUS: This function executes automatically when validators submit votes.
 // No human intervention required.
@@ -240,16 +306,18 @@ func (k Keeper) Consensus_of_Presence(ctx sdk.Context, pffHash string, vote type
 
 	// 5. Calculate percentage
 	percentage := (deepfakeVotes * 100) / totalNodes
+	threshold := k.GetParams(ctx).ConsensusThreshold
 
 	k.Logger(ctx).Info("VLT_Core: Consensus_of_Presence vote tally",
 		"pff_hash", pffHash,
 		"deepfake_votes", deepfakeVotes,
 		"total_nodes", totalNodes,
 		"percentage", percentage,
+		"threshold", threshold,
 	)
 
-	// 6. If 51% or more flag as deepfake, blacklist globally
-	if percentage >= 51 {
+	// 6. If the threshold or more flag as deepfake, blacklist globally
+	if uint64(percentage) >= threshold {
 		k.Logger(ctx).Warn("VLT_Core: Consensus_of_Presence THRESHOLD REACHED - Blacklisting PFF hash",
 			"pff_hash", pffHash,
 			"deepfake_votes", deepfakeVotes,
@@ -261,7 +329,8 @@ func (k Keeper) Consensus_of_Presence(ctx sdk.Context, pffHash string, vote type
 		reason := fmt.Sprintf("Consensus: %d%% of validators flagged as deepfake (%d/%d)", percentage, deepfakeVotes, totalNodes)
 		k.addToGlobalBlacklist(ctx, pffHash, reason, deepfakeVotes, totalNodes, "")
 
-		// Emit blacklist event
+		// Emit blacklist event with the new log root so observers can track it
+		logRoot := k.GetBlacklistRoot(ctx)
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeConsensusBlacklist,
@@ -269,6 +338,8 @@ func (k Keeper) Consensus_of_Presence(ctx sdk.Context, pffHash string, vote type
 				sdk.NewAttribute(types.AttributeKeyDeepfakeVotes, fmt.Sprintf("%d", deepfakeVotes)),
 				sdk.NewAttribute(types.AttributeKeyTotalNodes, fmt.Sprintf("%d", totalNodes)),
 				sdk.NewAttribute(types.AttributeKeyReason, reason),
+				sdk.NewAttribute(types.AttributeKeyBlacklistRoot, logRoot.Root),
+				sdk.NewAttribute(types.AttributeKeyBlacklistSize, fmt.Sprintf("%d", logRoot.TreeSize)),
 			),
 		)
 
@@ -343,9 +414,13 @@ func (k Keeper) addToGlobalBlacklist(ctx sdk.Context, pffHash, reason string, de
 	bz := k.cdc.MustMarshal(&entry)
 	store.Set(key, bz)
 
+	// Commit the hash to the public blacklist Merkle log
+	leafIndex := k.appendToBlacklistLog(ctx, pffHash)
+
 	k.Logger(ctx).Info("VLT_Core: PFF hash added to global blacklist",
 		"pff_hash", pffHash,
 		"reason", reason,
+		"log_index", leafIndex,
 	)
 }
 
@@ -0,0 +1,219 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// VLT_Core Security Module - Blacklist Log Types
+//
+// Every blacklisted PFF hash is appended to a public Merkle log. The log root
+// commits to the complete blacklist, and an inclusion proof lets any observer
+// check a hash's blacklist status against a published root without trusting
+// the node that served it. Hashing follows RFC 6962 (domain-separated leaves
+// and interior nodes, unbalanced right edge).
+
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// Merkle hash domain separators
+const (
+	merkleLeafPrefix = 0x00
+	merkleNodePrefix = 0x01
+)
+
+// BlacklistLogRoot is the commitment to the blacklist at a given size
+type BlacklistLogRoot struct {
+	// Root is the hex-encoded Merkle root
+	Root string `json:"root"`
+
+	// TreeSize is the number of blacklisted hashes committed to
+	TreeSize uint64 `json:"tree_size"`
+}
+
+// BlacklistInclusionProof proves a PFF hash is in the blacklist log
+type BlacklistInclusionProof struct {
+	// PFFHash is the blacklisted hash being proven
+	PFFHash string `json:"pff_hash"`
+
+	// LeafIndex is the hash's position in the log (append order)
+	LeafIndex uint64 `json:"leaf_index"`
+
+	// TreeSize is the log size the proof was built against
+	TreeSize uint64 `json:"tree_size"`
+
+	// AuditPath holds the hex-encoded sibling hashes, leaf to root
+	AuditPath []string `json:"audit_path"`
+}
+
+// BlacklistLeafHash returns the Merkle leaf hash of a blacklisted PFF hash
+func BlacklistLeafHash(pffHash string) []byte {
+	h := sha256.New()
+	h.Write([]byte{merkleLeafPrefix})
+	h.Write([]byte(pffHash))
+	return h.Sum(nil)
+}
+
+// blacklistNodeHash returns the hash of an interior Merkle node
+func blacklistNodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{merkleNodePrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// splitPoint returns the largest power of two strictly less than n (n > 1)
+func splitPoint(n uint64) uint64 {
+	k := uint64(1)
+	for k<<1 < n {
+		k <<= 1
+	}
+	return k
+}
+
+// BlacklistMerkleRoot computes the Merkle root over leaf hashes in log order
+// The root of an empty log is the hash of the empty string.
+func BlacklistMerkleRoot(leaves [][]byte) []byte {
+	switch len(leaves) {
+	case 0:
+		empty := sha256.Sum256(nil)
+		return empty[:]
+	case 1:
+		return leaves[0]
+	}
+
+	k := splitPoint(uint64(len(leaves)))
+	return blacklistNodeHash(BlacklistMerkleRoot(leaves[:k]), BlacklistMerkleRoot(leaves[k:]))
+}
+
+// BlacklistFrontierAppend folds a new leaf hash into a log's Merkle frontier
+// frontier[level] is the root of the complete subtree of 2^level leaves that
+// exists when bit level of size is set (nil otherwise). Returns the frontier
+// for size+1; only O(log n) hashes are computed.
+func BlacklistFrontierAppend(frontier [][]byte, size uint64, leaf []byte) [][]byte {
+	carry := leaf
+	level := 0
+	for size&(uint64(1)<<uint(level)) != 0 {
+		carry = blacklistNodeHash(frontier[level], carry)
+		frontier[level] = nil
+		level++
+	}
+
+	for len(frontier) <= level {
+		frontier = append(frontier, nil)
+	}
+	frontier[level] = carry
+
+	return frontier
+}
+
+// BlacklistFrontierRoot computes the Merkle root of a log of the given size from its frontier
+// Equals BlacklistMerkleRoot over the same leaves: complete subtrees are folded right to left.
+func BlacklistFrontierRoot(frontier [][]byte, size uint64) []byte {
+	if size == 0 {
+		empty := sha256.Sum256(nil)
+		return empty[:]
+	}
+
+	var root []byte
+	for level := 0; level < len(frontier); level++ {
+		if size&(uint64(1)<<uint(level)) == 0 {
+			continue
+		}
+
+		if root == nil {
+			root = frontier[level]
+		} else {
+			root = blacklistNodeHash(frontier[level], root)
+		}
+	}
+
+	return root
+}
+
+// BlacklistAuditPath returns the sibling hashes proving leaves[index], leaf to root
+func BlacklistAuditPath(leaves [][]byte, index uint64) ([][]byte, error) {
+	n := uint64(len(leaves))
+	if index >= n {
+		return nil, fmt.Errorf("leaf index %d out of range for tree size %d", index, n)
+	}
+	if n == 1 {
+		return [][]byte{}, nil
+	}
+
+	k := splitPoint(n)
+	if index < k {
+		path, err := BlacklistAuditPath(leaves[:k], index)
+		if err != nil {
+			return nil, err
+		}
+		return append(path, BlacklistMerkleRoot(leaves[k:])), nil
+	}
+
+	path, err := BlacklistAuditPath(leaves[k:], index-k)
+	if err != nil {
+		return nil, err
+	}
+	return append(path, BlacklistMerkleRoot(leaves[:k])), nil
+}
+
+// VerifyBlacklistInclusion checks an inclusion proof against a published root
+//
+// VERIFICATION LOGIC:
+// 1. Leaf index must lie inside the proven tree size
+// 2. Recompute the root from the PFF hash's leaf hash and the audit path
+// 3. The recomputed root must equal the published root at the same tree size
+// Returns an error for a non-member, a tampered path or a mismatched root.
+func VerifyBlacklistInclusion(proof BlacklistInclusionProof, root BlacklistLogRoot) error {
+	// 1. Bounds
+	if proof.TreeSize != root.TreeSize {
+		return fmt.Errorf("proof built for tree size %d, root is for tree size %d", proof.TreeSize, root.TreeSize)
+	}
+	if proof.LeafIndex >= proof.TreeSize {
+		return fmt.Errorf("leaf index %d out of range for tree size %d", proof.LeafIndex, proof.TreeSize)
+	}
+
+	expected, err := hex.DecodeString(root.Root)
+	if err != nil {
+		return fmt.Errorf("invalid root encoding: %w", err)
+	}
+
+	// 2. Walk the audit path (RFC 9162, section 2.1.3.2)
+	fn := proof.LeafIndex
+	sn := proof.TreeSize - 1
+	computed := BlacklistLeafHash(proof.PFFHash)
+
+	for i, encoded := range proof.AuditPath {
+		sibling, err := hex.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("invalid audit path entry %d: %w", i, err)
+		}
+		if sn == 0 {
+			return fmt.Errorf("audit path longer than tree size %d allows", proof.TreeSize)
+		}
+
+		if fn&1 == 1 || fn == sn {
+			computed = blacklistNodeHash(sibling, computed)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			computed = blacklistNodeHash(computed, sibling)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+
+	if sn != 0 {
+		return fmt.Errorf("audit path too short for tree size %d", proof.TreeSize)
+	}
+
+	// 3. Compare roots
+	if !bytes.Equal(computed, expected) {
+		return fmt.Errorf("%w: %s is not included under root %s", ErrBlacklistProofInvalid, proof.PFFHash, root.Root)
+	}
+
+	return nil
+}
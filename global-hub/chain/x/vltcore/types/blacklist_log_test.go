@@ -0,0 +1,59 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+)
+
+func TestBlacklistFrontierRootMatchesFullTree(t *testing.T) {
+	if !bytes.Equal(BlacklistFrontierRoot(nil, 0), BlacklistMerkleRoot(nil)) {
+		t.Fatal("empty frontier root differs from the empty tree root")
+	}
+
+	var frontier, leaves [][]byte
+	for size := uint64(0); size < 70; size++ {
+		leaf := BlacklistLeafHash(fmt.Sprintf("pff-%d", size))
+		frontier = BlacklistFrontierAppend(frontier, size, leaf)
+		leaves = append(leaves, leaf)
+
+		if !bytes.Equal(BlacklistFrontierRoot(frontier, size+1), BlacklistMerkleRoot(leaves)) {
+			t.Fatalf("frontier root differs from the full tree root at size %d", size+1)
+		}
+	}
+}
+
+func TestVerifyBlacklistInclusion(t *testing.T) {
+	var leaves [][]byte
+	for i := 0; i < 11; i++ {
+		leaves = append(leaves, BlacklistLeafHash(fmt.Sprintf("pff-%d", i)))
+	}
+	root := BlacklistLogRoot{Root: hex.EncodeToString(BlacklistMerkleRoot(leaves)), TreeSize: uint64(len(leaves))}
+
+	for index := range leaves {
+		path, err := BlacklistAuditPath(leaves, uint64(index))
+		if err != nil {
+			t.Fatalf("BlacklistAuditPath(%d): %v", index, err)
+		}
+
+		proof := BlacklistInclusionProof{
+			PFFHash:   fmt.Sprintf("pff-%d", index),
+			LeafIndex: uint64(index),
+			TreeSize:  uint64(len(leaves)),
+		}
+		for _, node := range path {
+			proof.AuditPath = append(proof.AuditPath, hex.EncodeToString(node))
+		}
+
+		if err := VerifyBlacklistInclusion(proof, root); err != nil {
+			t.Errorf("leaf %d: %v", index, err)
+		}
+
+		// The same path does not prove a hash that was never blacklisted
+		proof.PFFHash = "pff-unknown"
+		if err := VerifyBlacklistInclusion(proof, root); err == nil {
+			t.Errorf("leaf %d: proof verified for a hash that is not in the log", index)
+		}
+	}
+}
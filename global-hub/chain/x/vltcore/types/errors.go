@@ -18,5 +18,7 @@ var (
 	ErrInvalidConfidence       = sdkerrors.Register(ModuleName, 8, "invalid confidence value")
 	ErrInvalidBlacklistReason  = sdkerrors.Register(ModuleName, 9, "invalid blacklist reason")
 	ErrNoValidPFFProof         = sdkerrors.Register(ModuleName, 10, "no valid PFF liveness proof found in block")
+	ErrNotBlacklisted          = sdkerrors.Register(ModuleName, 11, "PFF hash is not in the blacklist log")
+	ErrBlacklistProofInvalid   = sdkerrors.Register(ModuleName, 12, "blacklist inclusion proof does not verify")
 )
 
@@ -5,9 +5,18 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// ParamSubspace defines the expected Subspace interface for parameters
+type ParamSubspace interface {
+	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
+	HasKeyTable() bool
+	WithKeyTable(table paramtypes.KeyTable) paramtypes.Subspace
+}
+
 // StakingKeeper defines the expected staking keeper interface
 // Used to get validator information for consensus voting
 type StakingKeeper interface {
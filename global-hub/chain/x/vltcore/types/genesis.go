@@ -5,6 +5,9 @@ package types
 
 // GenesisState defines the vltcore module's genesis state
 type GenesisState struct {
+	// Params defines the module parameters (consensus threshold, proof age window)
+	Params Params `json:"params"`
+
 	// Blacklist contains initially blacklisted PFF hashes
 	Blacklist []BlacklistEntry `json:"blacklist"`
 }
@@ -12,12 +15,17 @@ type GenesisState struct {
 // DefaultGenesisState returns the default genesis state
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
+		Params:    DefaultParams(),
 		Blacklist: []BlacklistEntry{},
 	}
 }
 
 // Validate performs basic validation of genesis data
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	// Validate blacklist entries
 	for _, entry := range gs.Blacklist {
 		if len(entry.PFFHash) != 64 {
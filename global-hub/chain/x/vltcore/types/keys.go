@@ -33,6 +33,18 @@ var (
 
 	// ValidatorNodePrefix is the prefix for storing validator node registry
 	ValidatorNodePrefix = []byte{0x04}
+
+	// BlacklistLogLeafPrefix is the prefix for blacklist log leaves, keyed by big-endian leaf index
+	BlacklistLogLeafPrefix = []byte{0x05}
+
+	// BlacklistLogIndexPrefix maps a blacklisted PFF hash to its leaf index
+	BlacklistLogIndexPrefix = []byte{0x06}
+
+	// BlacklistLogSizeKey stores the number of leaves in the blacklist log
+	BlacklistLogSizeKey = []byte{0x07}
+
+	// BlacklistLogFrontierPrefix stores the blacklist log's Merkle frontier, keyed by subtree level
+	BlacklistLogFrontierPrefix = []byte{0x08}
 )
 
 // Event types
@@ -54,5 +66,9 @@ const (
 	AttributeKeyReason        = "reason"
 	AttributeKeyTimestamp     = "timestamp"
 	AttributeKeyDID           = "did"
+	AttributeKeyProofIndex    = "proof_index"
+	AttributeKeyProofCount    = "proof_count"
+	AttributeKeyBlacklistRoot = "blacklist_root"
+	AttributeKeyBlacklistSize = "blacklist_size"
 )
 
@@ -0,0 +1,144 @@
+// TECHNOLOGY_TYPE: VITALIZED_LEDGER_TECHNOLOGY
+// VLT_Core Security Module - Parameters
+//
+// The Consensus_of_Presence threshold is a module parameter so governance can
+// tune it through a parameter change proposal as the validator set evolves.
+// The PFF proof age window and its gate clock skew tolerance are parameters
+// for the same reason: every validator must apply the same window.
+
+package types
+
+import (
+	"fmt"
+	"time"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultConsensusThreshold is the default percentage of validators required to blacklist (51%)
+const DefaultConsensusThreshold = uint64(51)
+
+// Parameter store keys
+var (
+	KeyConsensusThreshold = []byte("ConsensusThreshold")
+	KeyMaxProofAge        = []byte("MaxProofAge")
+	KeyMaxFutureSkew      = []byte("MaxFutureSkew")
+	KeyMaxPastSkew        = []byte("MaxPastSkew")
+)
+
+// ParamKeyTable for vltcore module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params defines the parameters for the vltcore module
+type Params struct {
+	// ConsensusThreshold is the percentage of validators that must flag a PFF
+	// hash as a deepfake before it is blacklisted. Must stay a strict majority.
+	ConsensusThreshold uint64 `protobuf:"varint,1,opt,name=consensus_threshold,json=consensusThreshold,proto3" json:"consensus_threshold,omitempty"`
+
+	// MaxProofAge is the maximum age of a PFF proof at block time
+	MaxProofAge time.Duration `protobuf:"bytes,2,opt,name=max_proof_age,json=maxProofAge,proto3,stdduration" json:"max_proof_age"`
+
+	// MaxFutureSkew is how far ahead of block time a proof timestamp may be (gate clock ahead)
+	MaxFutureSkew time.Duration `protobuf:"bytes,3,opt,name=max_future_skew,json=maxFutureSkew,proto3,stdduration" json:"max_future_skew"`
+
+	// MaxPastSkew is the extra tolerance beyond MaxProofAge (gate clock behind)
+	MaxPastSkew time.Duration `protobuf:"bytes,4,opt,name=max_past_skew,json=maxPastSkew,proto3,stdduration" json:"max_past_skew"`
+}
+
+// NewParams creates a new Params instance
+func NewParams(consensusThreshold uint64, proofAgePolicy ProofAgePolicy) Params {
+	return Params{
+		ConsensusThreshold: consensusThreshold,
+		MaxProofAge:        proofAgePolicy.MaxAge,
+		MaxFutureSkew:      proofAgePolicy.MaxFutureSkew,
+		MaxPastSkew:        proofAgePolicy.MaxPastSkew,
+	}
+}
+
+// DefaultParams returns default vltcore parameters
+func DefaultParams() Params {
+	return NewParams(DefaultConsensusThreshold, DefaultProofAgePolicy())
+}
+
+// ProofAgePolicy returns the PFF proof age window set by the params
+func (p Params) ProofAgePolicy() ProofAgePolicy {
+	return ProofAgePolicy{
+		MaxAge:        p.MaxProofAge,
+		MaxFutureSkew: p.MaxFutureSkew,
+		MaxPastSkew:   p.MaxPastSkew,
+	}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyConsensusThreshold, &p.ConsensusThreshold, validateConsensusThreshold),
+		paramtypes.NewParamSetPair(KeyMaxProofAge, &p.MaxProofAge, validateMaxProofAge),
+		paramtypes.NewParamSetPair(KeyMaxFutureSkew, &p.MaxFutureSkew, validateClockSkew),
+		paramtypes.NewParamSetPair(KeyMaxPastSkew, &p.MaxPastSkew, validateClockSkew),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateConsensusThreshold(p.ConsensusThreshold); err != nil {
+		return err
+	}
+
+	return p.ProofAgePolicy().Validate()
+}
+
+// String implements the Stringer interface
+func (p Params) String() string {
+	return fmt.Sprintf(`VLT_Core Params:
+  Consensus Threshold: %d%%
+  Max Proof Age:       %s
+  Max Future Skew:     %s
+  Max Past Skew:       %s
+`, p.ConsensusThreshold, p.MaxProofAge, p.MaxFutureSkew, p.MaxPastSkew)
+}
+
+func validateConsensusThreshold(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 50 {
+		return fmt.Errorf("consensus threshold must be greater than 50%% to remain a majority: %d", v)
+	}
+
+	if v > 100 {
+		return fmt.Errorf("consensus threshold cannot exceed 100%%: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxProofAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("max proof age must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateClockSkew(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("clock skew tolerance cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -12,6 +12,53 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// Proof age constants
+const (
+	// MaxProofAge is the maximum age of a PFF proof (5 minutes)
+	MaxProofAge = 5 * time.Minute
+
+	// DefaultMaxClockSkew is the default tolerance for gate device clock drift
+	DefaultMaxClockSkew = 30 * time.Second
+)
+
+// ProofAgePolicy defines the accepted age window for PFF proofs
+type ProofAgePolicy struct {
+	// MaxAge is the maximum age of a proof
+	MaxAge time.Duration `json:"max_age"`
+
+	// MaxFutureSkew is how far in the future a proof timestamp may be
+	MaxFutureSkew time.Duration `json:"max_future_skew"`
+
+	// MaxPastSkew is the extra tolerance beyond MaxAge for slow clocks
+	MaxPastSkew time.Duration `json:"max_past_skew"`
+}
+
+// DefaultProofAgePolicy returns the default proof age policy (5 minutes, ±30s skew)
+func DefaultProofAgePolicy() ProofAgePolicy {
+	return ProofAgePolicy{
+		MaxAge:        MaxProofAge,
+		MaxFutureSkew: DefaultMaxClockSkew,
+		MaxPastSkew:   DefaultMaxClockSkew,
+	}
+}
+
+// Validate validates the proof age policy
+func (pap ProofAgePolicy) Validate() error {
+	if pap.MaxAge <= 0 {
+		return fmt.Errorf("max age must be positive")
+	}
+
+	if pap.MaxFutureSkew < 0 || pap.MaxPastSkew < 0 {
+		return fmt.Errorf("clock skew tolerance cannot be negative")
+	}
+
+	if pap.MaxFutureSkew > pap.MaxAge || pap.MaxPastSkew > pap.MaxAge {
+		return fmt.Errorf("clock skew tolerance cannot exceed max age")
+	}
+
+	return nil
+}
+
 // PFFLivenessProof represents a cryptographic proof of human vitality
 // This is the core data structure that anchors blocks to real human presence
 type PFFLivenessProof struct {
@@ -52,6 +99,16 @@ func NewPFFLivenessProof(pffHash, did string, livenessScore uint8, signature []b
 
 // Validate performs basic validation of the PFF liveness proof
 func (p PFFLivenessProof) Validate() error {
+	if err := p.ValidateFields(); err != nil {
+		return err
+	}
+
+	// Validate timestamp against the default age window
+	return p.ValidateAge(time.Now(), DefaultProofAgePolicy())
+}
+
+// ValidateFields validates the proof contents without checking its age
+func (p PFFLivenessProof) ValidateFields() error {
 	// Validate PFF hash format (must be 64-character hex string for SHA-256)
 	if len(p.PFFHash) != 64 {
 		return fmt.Errorf("invalid PFF hash length: expected 64, got %d", len(p.PFFHash))
@@ -76,14 +133,25 @@ func (p PFFLivenessProof) Validate() error {
 		return fmt.Errorf("signature is required")
 	}
 
-	// Validate timestamp is not in the future
-	if p.Timestamp.After(time.Now()) {
-		return fmt.Errorf("timestamp cannot be in the future")
+	return nil
+}
+
+// ValidateAge checks the proof timestamp against the age window at the given time
+//
+// WINDOW:
+// - Future-dated proofs are accepted up to policy.MaxFutureSkew ahead of now
+// - Past proofs are accepted up to policy.MaxAge + policy.MaxPastSkew old
+func (p PFFLivenessProof) ValidateAge(now time.Time, policy ProofAgePolicy) error {
+	age := now.Sub(p.Timestamp)
+
+	// Reject proofs dated beyond the allowed future skew (gate clock ahead)
+	if age < -policy.MaxFutureSkew {
+		return fmt.Errorf("timestamp is %s in the future (max skew %s)", (-age).String(), policy.MaxFutureSkew.String())
 	}
 
-	// Validate timestamp is not too old (max 5 minutes)
-	if time.Since(p.Timestamp) > 5*time.Minute {
-		return fmt.Errorf("proof expired: timestamp is older than 5 minutes")
+	// Reject proofs older than the window plus past skew (gate clock behind)
+	if age > policy.MaxAge+policy.MaxPastSkew {
+		return fmt.Errorf("proof expired: timestamp is %s old (max %s + %s skew)", age.String(), policy.MaxAge.String(), policy.MaxPastSkew.String())
 	}
 
 	return nil
@@ -91,7 +159,7 @@ func (p PFFLivenessProof) Validate() error {
 
 // IsExpired checks if the proof has expired (older than 5 minutes)
 func (p PFFLivenessProof) IsExpired() bool {
-	return time.Since(p.Timestamp) > 5*time.Minute
+	return time.Since(p.Timestamp) > MaxProofAge
 }
 
 // GetProofID returns a unique identifier for this proof